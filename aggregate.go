@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type AggregateArgs struct {
+	Text        string `json:"text" jsonschema:"Delimited rows, one per line; fields may be quoted as in CSV"`
+	Column      int    `json:"column" jsonschema:"1-based index of the column to aggregate"`
+	Delimiter   string `json:"delimiter,omitempty" jsonschema:"Single-character field delimiter (default comma; use \\t for tabs)"`
+	Header      bool   `json:"header,omitempty" jsonschema:"Treat the first row as a header and skip it"`
+	SkipInvalid bool   `json:"skip_invalid,omitempty" jsonschema:"Skip empty or non-numeric cells instead of reporting an error"`
+}
+
+type skippedCell struct {
+	Row   int    `json:"row"`
+	Value string `json:"value"`
+}
+
+func handleAggregate(ctx context.Context, req *mcp.CallToolRequest, args AggregateArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("aggregate called: column %d of %d bytes", args.Column, len(args.Text)))
+
+	delimiter, err := parseDelimiter(args.Delimiter)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid delimiter: %v", err)), nil, nil
+	}
+
+	rows, err := parseDelimited(args.Text, delimiter)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid input: %v", err)), nil, nil
+	}
+
+	first := 0
+	if args.Header {
+		first = 1
+	}
+	if first >= len(rows) {
+		return errorResult("No data rows found"), nil, nil
+	}
+
+	widest := 0
+	for _, row := range rows {
+		widest = max(widest, len(row))
+	}
+	if args.Column < 1 || args.Column > widest {
+		return errorResult(fmt.Sprintf("Column must be between 1 and %d", widest)), nil, nil
+	}
+
+	sum := 0.0
+	low, high := math.Inf(1), math.Inf(-1)
+	running := []float64{}
+	skipped := []skippedCell{}
+	for i := first; i < len(rows); i++ {
+		cell := ""
+		if args.Column <= len(rows[i]) {
+			cell = strings.TrimSpace(rows[i][args.Column-1])
+		}
+
+		value, err := strconv.ParseFloat(cell, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			if !args.SkipInvalid {
+				return errorResult(fmt.Sprintf("Row %d: %q is not a number (set 'skip_invalid' to ignore it)", i+1, cell)), nil, nil
+			}
+			skipped = append(skipped, skippedCell{Row: i + 1, Value: cell})
+			continue
+		}
+
+		sum += value
+		low = math.Min(low, value)
+		high = math.Max(high, value)
+		running = append(running, sum)
+	}
+
+	if len(running) == 0 {
+		return errorResult("The column contains no numeric values"), nil, nil
+	}
+	mean := sum / float64(len(running))
+	if math.IsInf(sum, 0) || math.IsNaN(sum) || math.IsInf(mean, 0) || math.IsNaN(mean) {
+		return errorResult("Result overflows float64"), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("Count: %d\nSum: %g\nMean: %g\nMin: %g\nMax: %g\nSkipped: %d",
+			len(running), sum, mean, low, high, len(skipped))),
+		map[string]any{
+			"count":          len(running),
+			"sum":            sum,
+			"mean":           mean,
+			"min":            low,
+			"max":            high,
+			"running_totals": running,
+			"skipped":        skipped,
+		}, nil
+}
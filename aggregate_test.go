@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestAggregate(t *testing.T) {
+	const table = "item,qty,price\napple,3,1.5\npear,n/a,2\nfig, 4 ,0.25\nkiwi,,3"
+
+	runToolCases(t, handleAggregate, []toolCase[AggregateArgs]{
+		{
+			name: "skip non-numeric",
+			args: AggregateArgs{Text: table, Column: 2, Header: true, SkipInvalid: true},
+			text: "Count: 2\nSum: 7\nMean: 3.5\nMin: 3\nMax: 4\nSkipped: 2",
+			out:  `{"count":2,"sum":7,"mean":3.5,"min":3,"max":4,"running_totals":[3,7],"skipped":[{"row":3,"value":"n/a"},{"row":5,"value":""}]}`,
+		},
+		{
+			name: "all numeric",
+			args: AggregateArgs{Text: table, Column: 3, Header: true},
+			text: "Count: 4\nSum: 6.75\nMean: 1.6875\nMin: 0.25\nMax: 3\nSkipped: 0",
+			out:  `{"count":4,"running_totals":[1.5,3.5,3.75,6.75],"skipped":[]}`,
+		},
+		{
+			name: "negative values",
+			args: AggregateArgs{Text: "-2;5;-10", Column: 1, Delimiter: ";"},
+			out:  `{"count":1,"sum":-2,"min":-2,"max":-2}`,
+		},
+		{
+			name: "short row skipped",
+			args: AggregateArgs{Text: "1,2\n3\n4,5", Column: 2, SkipInvalid: true},
+			out:  `{"running_totals":[2,7],"skipped":[{"row":2,"value":""}]}`,
+		},
+		{name: "non-numeric cell", args: AggregateArgs{Text: table, Column: 2, Header: true}, err: true, text: `Row 3: "n/a" is not a number (set 'skip_invalid' to ignore it)`},
+		{name: "header counted without flag", args: AggregateArgs{Text: table, Column: 3}, err: true, text: `Row 1: "price" is not a number (set 'skip_invalid' to ignore it)`},
+		{name: "infinite cell", args: AggregateArgs{Text: "1\ninf", Column: 1}, err: true, text: `Row 2: "inf" is not a number (set 'skip_invalid' to ignore it)`},
+		{name: "column out of range", args: AggregateArgs{Text: table, Column: 4}, err: true, text: "Column must be between 1 and 3"},
+		{name: "column zero", args: AggregateArgs{Text: table}, err: true, text: "Column must be between 1 and 3"},
+		{name: "header only", args: AggregateArgs{Text: "a,b", Column: 1, Header: true}, err: true, text: "No data rows found"},
+		{name: "nothing numeric", args: AggregateArgs{Text: "x\ny", Column: 1, SkipInvalid: true}, err: true, text: "The column contains no numeric values"},
+		{name: "overflow", args: AggregateArgs{Text: "1e308\n1e308", Column: 1}, err: true, text: "Result overflows float64"},
+	})
+}
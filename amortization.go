@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type AmortizationArgs struct {
+	Principal     float64 `json:"principal" jsonschema:"The amount borrowed"`
+	Rate          float64 `json:"rate" jsonschema:"Annual interest rate as a percentage (e.g. 6 for 6%), compounded monthly"`
+	TermMonths    int     `json:"term_months" jsonschema:"Number of monthly payments (1-1200)"`
+	Currency      string  `json:"currency,omitempty" jsonschema:"Optional currency code or symbol used to format the amounts and set the rounding precision"`
+	ScheduleLimit int     `json:"schedule_limit,omitempty" jsonschema:"Longest term returned month by month; longer terms are summarized by year (default 120)"`
+}
+
+const maxAmortizationMonths = 1200
+
+// maxAmortizationPrincipal keeps the totals of even the highest rate and
+// longest term far from overflowing float64.
+const maxAmortizationPrincipal = 1e12
+
+type amortizationRow struct {
+	Period    int     `json:"period"`
+	Payment   float64 `json:"payment"`
+	Principal float64 `json:"principal"`
+	Interest  float64 `json:"interest"`
+	Balance   float64 `json:"balance"`
+}
+
+// monthlyPayment is the standard annuity formula P·r / (1 - (1+r)^-n), or an
+// even split of the principal when the rate is zero.
+func monthlyPayment(principal, monthlyRate float64, months int) float64 {
+	if monthlyRate == 0 {
+		return principal / float64(months)
+	}
+	return principal * monthlyRate / (1 - math.Pow(1+monthlyRate, -float64(months)))
+}
+
+// amortize builds the monthly schedule. Payment and interest are rounded to
+// the currency's minor unit each period, as a lender would bill them, and the
+// final payment absorbs the accumulated rounding so the balance ends at
+// exactly zero.
+func amortize(principal, monthlyRate float64, months, decimals int) []amortizationRow {
+	payment := roundTo(monthlyPayment(principal, monthlyRate, months), decimals)
+	balance := principal
+	rows := make([]amortizationRow, months)
+	for i := range rows {
+		interest := roundTo(balance*monthlyRate, decimals)
+		paid := payment
+		if i == months-1 {
+			paid = roundTo(balance+interest, decimals)
+		}
+		toPrincipal := roundTo(paid-interest, decimals)
+		balance = roundTo(balance-toPrincipal, decimals)
+		rows[i] = amortizationRow{Period: i + 1, Payment: paid, Principal: toPrincipal, Interest: interest, Balance: balance}
+	}
+	return rows
+}
+
+// summarizeByYear folds a monthly schedule into one row per year of twelve
+// payments, with the balance at the end of each year.
+func summarizeByYear(rows []amortizationRow, decimals int) []amortizationRow {
+	years := []amortizationRow{}
+	for i, row := range rows {
+		if i%12 == 0 {
+			years = append(years, amortizationRow{Period: i/12 + 1})
+		}
+		y := &years[len(years)-1]
+		y.Payment = roundTo(y.Payment+row.Payment, decimals)
+		y.Principal = roundTo(y.Principal+row.Principal, decimals)
+		y.Interest = roundTo(y.Interest+row.Interest, decimals)
+		y.Balance = row.Balance
+	}
+	return years
+}
+
+func handleAmortization(ctx context.Context, req *mcp.CallToolRequest, args AmortizationArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("amortization called: principal=%.2f rate=%.4f months=%d", args.Principal, args.Rate, args.TermMonths))
+
+	if !(args.Principal > 0) || math.IsInf(args.Principal, 0) {
+		return errorResult("Principal must be a positive number"), nil, nil
+	}
+	if args.Principal > maxAmortizationPrincipal {
+		return errorResult(fmt.Sprintf("Principal must be at most %.0f", maxAmortizationPrincipal)), nil, nil
+	}
+	if !(args.Rate >= 0) || args.Rate > 1000 {
+		return errorResult("Rate must be between 0 and 1000 percent"), nil, nil
+	}
+	if args.TermMonths < 1 || args.TermMonths > maxAmortizationMonths {
+		return errorResult(fmt.Sprintf("Term must be between 1 and %d months", maxAmortizationMonths)), nil, nil
+	}
+	limit := args.ScheduleLimit
+	if limit == 0 {
+		limit = 120
+	}
+	if limit < 1 {
+		return errorResult("Schedule limit must be positive"), nil, nil
+	}
+
+	decimals := 2
+	format := func(v float64) string { return fmt.Sprintf("%.2f", v) }
+	result := map[string]any{}
+	if args.Currency != "" {
+		code, _, ok := normalizeCurrency(args.Currency)
+		if !ok {
+			return errorResult(fmt.Sprintf("Unsupported currency: %s", args.Currency)), nil, nil
+		}
+		info := currencies[code]
+		decimals = info.Decimals
+		format = func(v float64) string { return formatMoney(v, info) }
+		result["currency"] = code
+	}
+
+	monthlyRate := args.Rate / 100 / 12
+	rows := amortize(args.Principal, monthlyRate, args.TermMonths, decimals)
+
+	totalPaid, totalInterest := 0.0, 0.0
+	for _, row := range rows {
+		totalPaid += row.Payment
+		totalInterest += row.Interest
+	}
+	totalPaid, totalInterest = roundTo(totalPaid, decimals), roundTo(totalInterest, decimals)
+	payment := rows[0].Payment
+
+	result["monthly_payment"] = payment
+	result["total_paid"] = totalPaid
+	result["total_interest"] = totalInterest
+	result["final_payment"] = rows[len(rows)-1].Payment
+	if args.TermMonths > limit {
+		result["schedule"] = summarizeByYear(rows, decimals)
+		result["schedule_granularity"] = "yearly"
+	} else {
+		result["schedule"] = rows
+		result["schedule_granularity"] = "monthly"
+	}
+	if args.Currency != "" {
+		result["formatted_monthly_payment"] = format(payment)
+		result["formatted_total_interest"] = format(totalInterest)
+	}
+
+	return textResult(fmt.Sprintf("Monthly payment: %s\nTotal paid: %s\nTotal interest: %s",
+		format(payment), format(totalPaid), format(totalInterest))), result, nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAmortization(t *testing.T) {
+	runToolCases(t, handleAmortization, []toolCase[AmortizationArgs]{
+		{
+			name: "short loan",
+			args: AmortizationArgs{Principal: 1000, Rate: 12, TermMonths: 3},
+			text: "Monthly payment: 340.02\nTotal paid: 1020.07\nTotal interest: 20.07",
+			out: `{"monthly_payment":340.02,"final_payment":340.03,"total_paid":1020.07,"total_interest":20.07,
+				"schedule_granularity":"monthly","schedule":[
+				{"period":1,"payment":340.02,"principal":330.02,"interest":10,"balance":669.98},
+				{"period":2,"payment":340.02,"principal":333.32,"interest":6.7,"balance":336.66},
+				{"period":3,"payment":340.03,"principal":336.66,"interest":3.37,"balance":0}]}`,
+		},
+		{
+			name: "thirty year mortgage",
+			args: AmortizationArgs{Principal: 200000, Rate: 6, TermMonths: 360},
+			text: "Monthly payment: 1199.10\nTotal paid: 431677.04\nTotal interest: 231677.04",
+			out:  `{"monthly_payment":1199.1,"final_payment":1200.14,"schedule_granularity":"yearly"}`,
+		},
+		{
+			name: "zero rate with currency",
+			args: AmortizationArgs{Principal: 1200, Rate: 0, TermMonths: 12, Currency: "USD"},
+			text: "Monthly payment: $100.00\nTotal paid: $1200.00\nTotal interest: $0.00",
+			out:  `{"currency":"USD","monthly_payment":100,"total_interest":0,"formatted_monthly_payment":"$100.00","formatted_total_interest":"$0.00"}`,
+		},
+		{
+			name: "currency without minor unit",
+			args: AmortizationArgs{Principal: 100000, Rate: 5, TermMonths: 12, Currency: "JPY"},
+			text: "Monthly payment: ¥8561\nTotal paid: ¥102730\nTotal interest: ¥2730",
+			out:  `{"currency":"JPY","monthly_payment":8561,"final_payment":8559,"formatted_monthly_payment":"¥8561"}`,
+		},
+		{
+			name: "zero principal",
+			args: AmortizationArgs{Principal: 0, Rate: 5, TermMonths: 12},
+			err:  true,
+			text: "Principal must be a positive number",
+		},
+		{
+			name: "principal too large",
+			args: AmortizationArgs{Principal: 1e13, Rate: 5, TermMonths: 12},
+			err:  true,
+			text: "Principal must be at most 1000000000000",
+		},
+		{
+			name: "negative rate",
+			args: AmortizationArgs{Principal: 100, Rate: -1, TermMonths: 12},
+			err:  true,
+			text: "Rate must be between 0 and 1000 percent",
+		},
+		{
+			name: "zero term",
+			args: AmortizationArgs{Principal: 100, Rate: 1, TermMonths: 0},
+			err:  true,
+			text: "Term must be between 1 and 1200 months",
+		},
+		{
+			name: "term too long",
+			args: AmortizationArgs{Principal: 100, Rate: 1, TermMonths: 1201},
+			err:  true,
+			text: "Term must be between 1 and 1200 months",
+		},
+		{
+			name: "negative schedule limit",
+			args: AmortizationArgs{Principal: 100, Rate: 1, TermMonths: 12, ScheduleLimit: -1},
+			err:  true,
+			text: "Schedule limit must be positive",
+		},
+		{
+			name: "unknown currency",
+			args: AmortizationArgs{Principal: 100, Rate: 1, TermMonths: 12, Currency: "XYZ"},
+			err:  true,
+			text: "Unsupported currency: XYZ",
+		},
+	})
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	loans := []struct {
+		principal, rate float64
+		months          int
+	}{
+		{1000, 12, 3},
+		{25000, 7.5, 60},
+		{200000, 6, 360},
+		{350000, 3.25, 180},
+		{5000, 0, 7},
+		{999.99, 29.99, 24},
+	}
+	for _, l := range loans {
+		monthlyRate := l.rate / 100 / 12
+		rows := amortize(l.principal, monthlyRate, l.months, 2)
+		if len(rows) != l.months {
+			t.Fatalf("%v: %d rows, want %d", l, len(rows), l.months)
+		}
+		if final := rows[len(rows)-1].Balance; final != 0 {
+			t.Errorf("%v: final balance = %v, want 0", l, final)
+		}
+
+		var want float64
+		if l.rate == 0 {
+			want = l.principal / float64(l.months)
+		} else {
+			growth := math.Pow(1+monthlyRate, float64(l.months))
+			want = l.principal * monthlyRate * growth / (growth - 1)
+		}
+		if math.Abs(rows[0].Payment-want) > 0.005 {
+			t.Errorf("%v: payment = %v, want %.4f", l, rows[0].Payment, want)
+		}
+
+		repaid := 0.0
+		for _, row := range rows {
+			repaid += row.Principal
+			if math.Abs(row.Payment-row.Principal-row.Interest) > 1e-9 {
+				t.Errorf("%v: period %d payment %v != principal %v + interest %v", l, row.Period, row.Payment, row.Principal, row.Interest)
+			}
+		}
+		if math.Abs(repaid-l.principal) > 1e-6 {
+			t.Errorf("%v: principal repaid = %v, want %v", l, repaid, l.principal)
+		}
+	}
+}
+
+func TestAmortizationYearlySummary(t *testing.T) {
+	rows := amortize(200000, 0.06/12, 360, 2)
+	years := summarizeByYear(rows, 2)
+	if len(years) != 30 {
+		t.Fatalf("got %d years, want 30", len(years))
+	}
+	if years[0].Balance != rows[11].Balance {
+		t.Errorf("first year balance = %v, want %v", years[0].Balance, rows[11].Balance)
+	}
+	if last := years[len(years)-1]; last.Period != 30 || last.Balance != 0 {
+		t.Errorf("last year = %+v, want period 30 ending at zero", last)
+	}
+}
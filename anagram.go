@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type AnagramArgs struct {
+	Mode  string   `json:"mode" jsonschema:"check (compare a and b) or group (group the words list)"`
+	A     string   `json:"a,omitempty" jsonschema:"First string (check mode)"`
+	B     string   `json:"b,omitempty" jsonschema:"Second string (check mode)"`
+	Words []string `json:"words,omitempty" jsonschema:"Words or phrases to group (group mode, at most 10000)"`
+}
+
+// anagramKey returns the sorted multiset of runes in s, lower-cased and with
+// whitespace removed, so two strings are anagrams exactly when their keys
+// match.
+func anagramKey(s string) string {
+	var runes []rune
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			runes = append(runes, unicode.ToLower(r))
+		}
+	}
+	slices.Sort(runes)
+	return string(runes)
+}
+
+// groupAnagrams groups words by anagram key. Groups are ordered by the first
+// appearance of one of their members, and words keep their input order.
+func groupAnagrams(words []string) [][]string {
+	index := map[string]int{}
+	var groups [][]string
+	for _, w := range words {
+		key := anagramKey(w)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], w)
+	}
+	return groups
+}
+
+func handleAnagram(ctx context.Context, req *mcp.CallToolRequest, args AnagramArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("anagram called: mode=%q", args.Mode))
+
+	switch args.Mode {
+	case "check":
+		keyA, keyB := anagramKey(args.A), anagramKey(args.B)
+		if keyA == "" || keyB == "" {
+			return errorResult("Please provide both 'a' and 'b'"), nil, nil
+		}
+		anagrams := keyA == keyB
+		text := fmt.Sprintf("%q and %q are anagrams", args.A, args.B)
+		if !anagrams {
+			text = fmt.Sprintf("%q and %q are not anagrams", args.A, args.B)
+		}
+		return textResult(text), map[string]any{"anagrams": anagrams}, nil
+
+	case "group":
+		if len(args.Words) == 0 {
+			return errorResult("Please provide at least one word in 'words'"), nil, nil
+		}
+		if len(args.Words) > 10000 {
+			return errorResult("At most 10000 words can be grouped at once"), nil, nil
+		}
+		groups := groupAnagrams(args.Words)
+		lines := make([]string, len(groups))
+		for i, g := range groups {
+			lines[i] = strings.Join(g, ", ")
+		}
+		return textResult(strings.Join(lines, "\n")), map[string]any{"groups": groups}, nil
+	}
+
+	return errorResult(fmt.Sprintf("Unsupported mode: %s (use check or group)", args.Mode)), nil, nil
+}
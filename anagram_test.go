@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestAnagram(t *testing.T) {
+	runToolCases(t, handleAnagram, []toolCase[AnagramArgs]{
+		{name: "listen silent", args: AnagramArgs{Mode: "check", A: "listen", B: "silent"}, text: `"listen" and "silent" are anagrams`, out: `{"anagrams":true}`},
+		{name: "case and spaces", args: AnagramArgs{Mode: "check", A: "Dormitory", B: "dirty room"}, out: `{"anagrams":true}`},
+		{name: "unicode", args: AnagramArgs{Mode: "check", A: "Ärger", B: "regär"}, out: `{"anagrams":true}`},
+		{name: "different counts", args: AnagramArgs{Mode: "check", A: "aab", B: "abb"}, text: `"aab" and "abb" are not anagrams`, out: `{"anagrams":false}`},
+		{
+			name: "group",
+			args: AnagramArgs{Mode: "group", Words: []string{"listen", "google", "enlist", "Silent", "banana", "tinsel", "elgoog"}},
+			text: "listen, enlist, Silent, tinsel\ngoogle, elgoog\nbanana",
+			out:  `{"groups":[["listen","enlist","Silent","tinsel"],["google","elgoog"],["banana"]]}`,
+		},
+		{name: "group phrases", args: AnagramArgs{Mode: "group", Words: []string{"a gentleman", "elegant man", "abc"}}, out: `{"groups":[["a gentleman","elegant man"],["abc"]]}`},
+		{name: "missing b", args: AnagramArgs{Mode: "check", A: "abc", B: "  "}, err: true, text: "Please provide both 'a' and 'b'"},
+		{name: "no words", args: AnagramArgs{Mode: "group"}, err: true, text: "Please provide at least one word in 'words'"},
+		{name: "too many words", args: AnagramArgs{Mode: "group", Words: make([]string, 10001)}, err: true, text: "At most 10000 words can be grouped at once"},
+		{name: "unknown mode", args: AnagramArgs{Mode: "solve"}, err: true, text: "Unsupported mode: solve (use check or group)"},
+	})
+}
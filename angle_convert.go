@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type AngleConvertArgs struct {
+	Value     float64 `json:"value" jsonschema:"The angle to convert"`
+	FromUnit  string  `json:"from_unit" jsonschema:"Source unit (degrees, radians, gradians, or turns)"`
+	ToUnit    string  `json:"to_unit" jsonschema:"Target unit (degrees, radians, gradians, or turns)"`
+	Normalize bool    `json:"normalize,omitempty" jsonschema:"Wrap the result into one full turn, e.g. 0 to 360 degrees or 0 to 2π radians"`
+	Precision *int    `json:"precision,omitempty" jsonschema:"Decimal places in the result (0-15, default 6)"`
+}
+
+// angleUnitAliases maps spellings and abbreviations of angle units to their
+// canonical names. Keys are lowercase.
+var angleUnitAliases = map[string]string{
+	"deg":         "degrees",
+	"degree":      "degrees",
+	"degrees":     "degrees",
+	"°":           "degrees",
+	"rad":         "radians",
+	"radian":      "radians",
+	"radians":     "radians",
+	"grad":        "gradians",
+	"gradian":     "gradians",
+	"gradians":    "gradians",
+	"gon":         "gradians",
+	"turn":        "turns",
+	"turns":       "turns",
+	"rev":         "turns",
+	"revolution":  "turns",
+	"revolutions": "turns",
+}
+
+// radiansPerUnit is the size of one unit of each angle in radians, the pivot
+// every conversion goes through.
+var radiansPerUnit = map[string]float64{
+	"degrees":  math.Pi / 180,
+	"radians":  1,
+	"gradians": math.Pi / 200,
+	"turns":    2 * math.Pi,
+}
+
+// fullTurn is one complete revolution in each unit.
+var fullTurn = map[string]float64{
+	"degrees":  360,
+	"radians":  2 * math.Pi,
+	"gradians": 400,
+	"turns":    1,
+}
+
+func normalizeAngleUnit(unit string) (string, bool) {
+	canonical, ok := angleUnitAliases[strings.ToLower(strings.TrimSpace(unit))]
+	return canonical, ok
+}
+
+func handleAngleConvert(ctx context.Context, req *mcp.CallToolRequest, args AngleConvertArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("angle_convert called: %g %s to %s", args.Value, args.FromUnit, args.ToUnit))
+
+	if math.IsNaN(args.Value) || math.IsInf(args.Value, 0) {
+		return errorResult("Value must be a finite number"), nil, nil
+	}
+	fromUnit, ok := normalizeAngleUnit(args.FromUnit)
+	if !ok {
+		return errorResult(fmt.Sprintf("unknown unit: %s", args.FromUnit)), nil, nil
+	}
+	toUnit, ok := normalizeAngleUnit(args.ToUnit)
+	if !ok {
+		return errorResult(fmt.Sprintf("unknown unit: %s", args.ToUnit)), nil, nil
+	}
+
+	precision := 6
+	if args.Precision != nil {
+		precision = *args.Precision
+		if precision < 0 || precision > 15 {
+			return errorResult("Precision must be between 0 and 15"), nil, nil
+		}
+	}
+
+	result := args.Value
+	if fromUnit != toUnit {
+		result = args.Value * radiansPerUnit[fromUnit] / radiansPerUnit[toUnit]
+	}
+	if args.Normalize {
+		turn := fullTurn[toUnit]
+		result = math.Mod(result, turn)
+		if result < 0 {
+			result += turn
+		}
+		// Rounding can turn a value just below a full turn into the turn
+		// itself, which is the same angle as zero.
+		if roundTo(result, precision) == roundTo(turn, precision) {
+			result = 0
+		}
+	}
+	if math.IsInf(result, 0) {
+		return errorResult("Angle conversion resulted in invalid value"), nil, nil
+	}
+	result = roundTo(result, precision)
+
+	return textResult(fmt.Sprintf("%.*f %s", precision, result, toUnit)),
+		map[string]any{"result": result, "unit": toUnit}, nil
+}
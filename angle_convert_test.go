@@ -0,0 +1,26 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAngleConvert(t *testing.T) {
+	runToolCases(t, handleAngleConvert, []toolCase[AngleConvertArgs]{
+		{name: "degrees to radians", args: AngleConvertArgs{Value: 180, FromUnit: "degrees", ToUnit: "radians"}, text: "3.141593 radians", out: `{"result":3.141593,"unit":"radians"}`},
+		{name: "radians to degrees", args: AngleConvertArgs{Value: math.Pi, FromUnit: "rad", ToUnit: "deg"}, text: "180.000000 degrees", out: `{"result":180,"unit":"degrees"}`},
+		{name: "turns to gradians", args: AngleConvertArgs{Value: 1, FromUnit: "turn", ToUnit: "gon", Precision: ptr(0)}, text: "400 gradians"},
+		{name: "gradians to turns", args: AngleConvertArgs{Value: 200, FromUnit: "Gradians", ToUnit: "rev", Precision: ptr(2)}, text: "0.50 turns"},
+		{name: "degree symbol", args: AngleConvertArgs{Value: 90, FromUnit: "°", ToUnit: "turns", Precision: ptr(2)}, out: `{"result":0.25}`},
+		{name: "normalize above a turn", args: AngleConvertArgs{Value: 450, FromUnit: "degrees", ToUnit: "degrees", Normalize: true, Precision: ptr(0)}, text: "90 degrees"},
+		{name: "normalize negative", args: AngleConvertArgs{Value: -90, FromUnit: "degrees", ToUnit: "degrees", Normalize: true, Precision: ptr(0)}, text: "270 degrees"},
+		{name: "normalize full turns", args: AngleConvertArgs{Value: 720, FromUnit: "degrees", ToUnit: "degrees", Normalize: true}, out: `{"result":0}`},
+		{name: "normalize rounds to zero", args: AngleConvertArgs{Value: 359.9999999, FromUnit: "degrees", ToUnit: "degrees", Normalize: true, Precision: ptr(3)}, text: "0.000 degrees"},
+		{name: "normalize radians", args: AngleConvertArgs{Value: 7, FromUnit: "radians", ToUnit: "radians", Normalize: true, Precision: ptr(4)}, out: `{"result":0.7168}`},
+		{name: "not a number", args: AngleConvertArgs{Value: math.NaN(), FromUnit: "degrees", ToUnit: "radians"}, err: true, text: "Value must be a finite number"},
+		{name: "unknown source unit", args: AngleConvertArgs{Value: 1, FromUnit: "mils", ToUnit: "radians"}, err: true, text: "unknown unit: mils"},
+		{name: "unknown target unit", args: AngleConvertArgs{Value: 1, FromUnit: "degrees", ToUnit: "arcmin"}, err: true, text: "unknown unit: arcmin"},
+		{name: "precision out of range", args: AngleConvertArgs{Value: 1, FromUnit: "degrees", ToUnit: "radians", Precision: ptr(16)}, err: true, text: "Precision must be between 0 and 15"},
+		{name: "overflow", args: AngleConvertArgs{Value: 1e308, FromUnit: "turns", ToUnit: "degrees"}, err: true, text: "Angle conversion resulted in invalid value"},
+	})
+}
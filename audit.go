@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type auditEntry struct {
+	Timestamp string `json:"timestamp"`
+	Tool      string `json:"tool"`
+	ArgsHash  string `json:"args_sha256,omitempty"`
+	Success   bool   `json:"success"`
+}
+
+// auditLogger appends one JSON line per tool call to a file. Raw arguments
+// are never written, only a SHA-256 hash of their canonical JSON form, and not
+// even that for the tools in unhashedAuditTools.
+type auditLogger struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func openAuditLog(path string) (*auditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLogger{file: f}, nil
+}
+
+func (a *auditLogger) Close() error {
+	return a.file.Close()
+}
+
+// unhashedAuditTools take arguments from a keyspace small enough that an
+// unsalted hash could be brute-forced back to the value (a nine-digit SSN, a
+// common password, a passphrase seed), so their audit entries carry no hash.
+var unhashedAuditTools = map[string]bool{
+	"national_id":       true,
+	"password_strength": true,
+	"passphrase":        true,
+}
+
+// hashArguments hashes the arguments after re-encoding them, so that
+// semantically identical calls hash the same regardless of key order or
+// whitespace.
+func hashArguments(raw json.RawMessage) string {
+	canonical := []byte(raw)
+	var v any
+	if err := json.Unmarshal(raw, &v); err == nil {
+		if b, err := json.Marshal(v); err == nil {
+			canonical = b
+		}
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])
+}
+
+// record writes entry as a single line. Writes are serialized within the
+// process by a mutex and across processes by an exclusive file lock, and the
+// file is opened in append mode so lines never interleave.
+func (a *auditLogger) record(entry auditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := lockFile(a.file); err != nil {
+		return err
+	}
+	defer unlockFile(a.file)
+
+	_, err = a.file.Write(line)
+	return err
+}
+
+// middleware records every tools/call request once it has completed.
+func (a *auditLogger) middleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		result, err := next(ctx, method, req)
+
+		callReq, ok := req.(*mcp.CallToolRequest)
+		if method != "tools/call" || !ok {
+			return result, err
+		}
+
+		success := err == nil
+		if res, ok := result.(*mcp.CallToolResult); ok && res != nil && res.IsError {
+			success = false
+		}
+
+		entry := auditEntry{
+			Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+			Tool:      callReq.Params.Name,
+			Success:   success,
+		}
+		if !unhashedAuditTools[entry.Tool] {
+			entry.ArgsHash = hashArguments(callReq.Params.Arguments)
+		}
+		if werr := a.record(entry); werr != nil {
+			logMsg("[ERROR]", fmt.Sprintf("Failed to write audit log entry: %v", werr))
+		}
+
+		return result, err
+	}
+}
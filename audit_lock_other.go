@@ -0,0 +1,11 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// On platforms without flock, writes are still serialized within the process
+// by auditLogger's mutex.
+func lockFile(f *os.File) error { return nil }
+
+func unlockFile(f *os.File) error { return nil }
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestAuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	setConfig(t, serverConfig{AuditFile: path})
+	session := connectServer(t, nil)
+
+	calls := []struct {
+		params  *mcp.CallToolParams
+		hashOf  string
+		success bool
+	}{
+		{
+			params:  &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "celsius", "to_unit": "fahrenheit"}},
+			hashOf:  `{ "to_unit": "fahrenheit", "value": 100, "from_unit": "celsius" }`,
+			success: true,
+		},
+		{
+			params:  &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 1, "from_unit": "rankine", "to_unit": "celsius"}},
+			hashOf:  `{"from_unit":"rankine","to_unit":"celsius","value":1}`,
+			success: false,
+		},
+	}
+	for _, c := range calls {
+		callRemote(t, session, c.params)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit log: %v", err)
+	}
+	if bytes.Contains(data, []byte("rankine")) {
+		t.Errorf("audit log contains raw arguments:\n%s", data)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("audit log permissions = %o, want 600", perm)
+	}
+
+	hashPattern := regexp.MustCompile(`^[0-9a-f]{64}$`)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	i := 0
+	for ; scanner.Scan(); i++ {
+		if i >= len(calls) {
+			t.Fatalf("unexpected audit line %q", scanner.Text())
+		}
+		var entry auditEntry
+		dec := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("line %d is not a well-formed entry: %v: %q", i+1, err, scanner.Text())
+		}
+
+		if _, err := time.Parse(time.RFC3339Nano, entry.Timestamp); err != nil {
+			t.Errorf("line %d: timestamp %q: %v", i+1, entry.Timestamp, err)
+		}
+		if entry.Tool != calls[i].params.Name {
+			t.Errorf("line %d: tool = %q, want %q", i+1, entry.Tool, calls[i].params.Name)
+		}
+		if !hashPattern.MatchString(entry.ArgsHash) {
+			t.Errorf("line %d: args_sha256 = %q, want a SHA-256 hex digest", i+1, entry.ArgsHash)
+		}
+		if want := hashArguments(json.RawMessage(calls[i].hashOf)); entry.ArgsHash != want {
+			t.Errorf("line %d: args_sha256 = %s, want %s", i+1, entry.ArgsHash, want)
+		}
+		if entry.Success != calls[i].success {
+			t.Errorf("line %d: success = %t, want %t", i+1, entry.Success, calls[i].success)
+		}
+	}
+	if i != len(calls) {
+		t.Errorf("got %d audit lines, want %d", i, len(calls))
+	}
+}
+
+func TestAuditLogSensitiveTools(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	setConfig(t, serverConfig{AuditFile: path})
+	session := connectServer(t, nil)
+
+	calls := []*mcp.CallToolParams{
+		{Name: "national_id", Arguments: map[string]any{"country": "US", "value": "123456789"}},
+		{Name: "password_strength", Arguments: map[string]any{"password": "password123"}},
+		{Name: "passphrase", Arguments: map[string]any{"seed": 7}},
+	}
+	for _, params := range calls {
+		callRemote(t, session, params)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit log: %v", err)
+	}
+	for _, params := range calls {
+		raw, err := json.Marshal(params.Arguments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hash := hashArguments(raw); bytes.Contains(data, []byte(hash)) {
+			t.Errorf("audit log contains the %s arguments hash %s", params.Name, hash)
+		}
+	}
+	for _, value := range []string{"123456789", "password123"} {
+		if bytes.Contains(data, []byte(value)) {
+			t.Errorf("audit log contains %q:\n%s", value, data)
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	i := 0
+	for ; scanner.Scan(); i++ {
+		var entry map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("line %d: %v", i+1, err)
+		}
+		if i < len(calls) && entry["tool"] != calls[i].Name {
+			t.Errorf("line %d: tool = %v, want %s", i+1, entry["tool"], calls[i].Name)
+		}
+		if _, ok := entry["args_sha256"]; ok {
+			t.Errorf("line %d: %s entry has an arguments hash: %s", i+1, entry["tool"], scanner.Text())
+		}
+	}
+	if i != len(calls) {
+		t.Errorf("got %d audit lines, want %d", i, len(calls))
+	}
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	setConfig(t, serverConfig{})
+	session := connectServer(t, nil)
+
+	callRemote(t, session, &mcp.CallToolParams{Name: "word_count", Arguments: map[string]any{"text": "hello world"}})
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		t.Errorf("disabled audit log created %s", e.Name())
+	}
+}
+
+func TestHashArguments(t *testing.T) {
+	a := hashArguments(json.RawMessage(`{"a":1,"b":[true,null]}`))
+	b := hashArguments(json.RawMessage("{\n  \"b\": [true, null],\n  \"a\": 1\n}"))
+	if a != b {
+		t.Errorf("equivalent arguments hash differently: %s and %s", a, b)
+	}
+	if c := hashArguments(json.RawMessage(`{"a":2,"b":[true,null]}`)); c == a {
+		t.Errorf("different arguments hash the same: %s", c)
+	}
+}
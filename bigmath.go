@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type BigMathArgs struct {
+	Operation string `json:"operation" jsonschema:"Operation: modpow (a^b mod modulus), modinv (inverse of a mod modulus), or gcd (gcd of a and b with Bezout coefficients)"`
+	A         string `json:"a" jsonschema:"First operand as a decimal integer string (the base for modpow)"`
+	B         string `json:"b,omitempty" jsonschema:"Second operand as a decimal integer string (the exponent for modpow, the other value for gcd)"`
+	Modulus   string `json:"modulus,omitempty" jsonschema:"Modulus as a positive decimal integer string (modpow and modinv)"`
+}
+
+// maxBigMathDigits bounds operand size so that a single call cannot tie up
+// the server.
+const maxBigMathDigits = 4096
+
+// parseBigInt parses a decimal integer string for the named operand.
+func parseBigInt(name, s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("'%s' is required", name)
+	}
+	if len(strings.TrimLeft(s, "+-")) > maxBigMathDigits {
+		return nil, fmt.Errorf("'%s' must have at most %d digits", name, maxBigMathDigits)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a decimal integer: %s", name, s)
+	}
+	return n, nil
+}
+
+// parseModulus parses the modulus, which must be positive.
+func parseModulus(s string) (*big.Int, error) {
+	m, err := parseBigInt("modulus", s)
+	if err != nil {
+		return nil, err
+	}
+	if m.Sign() <= 0 {
+		return nil, fmt.Errorf("Modulus must be a positive integer")
+	}
+	return m, nil
+}
+
+func handleBigMath(ctx context.Context, req *mcp.CallToolRequest, args BigMathArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("bigmath called: %s", args.Operation))
+
+	a, err := parseBigInt("a", args.A)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+
+	switch args.Operation {
+	case "modpow":
+		exp, err := parseBigInt("b", args.B)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		m, err := parseModulus(args.Modulus)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		// Exp treats a negative exponent as a power of the modular inverse
+		// and returns nil when that inverse does not exist.
+		result := new(big.Int).Exp(a, exp, m)
+		if result == nil {
+			return errorResult(fmt.Sprintf("%s is not invertible modulo %s, so a negative exponent is undefined", a, m)), nil, nil
+		}
+		// A negative base can leave a negative remainder; report [0, m).
+		result.Mod(result, m)
+		return textResult(result.String()), map[string]any{"result": result.String()}, nil
+
+	case "modinv":
+		m, err := parseModulus(args.Modulus)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		inverse := new(big.Int).ModInverse(new(big.Int).Mod(a, m), m)
+		if inverse == nil {
+			return errorResult(fmt.Sprintf("%s has no inverse modulo %s (they are not coprime)", a, m)), nil, nil
+		}
+		return textResult(inverse.String()), map[string]any{"result": inverse.String()}, nil
+
+	case "gcd":
+		b, err := parseBigInt("b", args.B)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		// GCD requires non-negative inputs when computing coefficients, so
+		// work on magnitudes and fix the signs of x and y afterwards.
+		x, y := new(big.Int), new(big.Int)
+		g := new(big.Int).GCD(x, y, new(big.Int).Abs(a), new(big.Int).Abs(b))
+		if a.Sign() < 0 {
+			x.Neg(x)
+		}
+		if b.Sign() < 0 {
+			y.Neg(y)
+		}
+		return textResult(g.String()), map[string]any{
+			"result": g.String(),
+			"x":      x.String(),
+			"y":      y.String(),
+		}, nil
+	}
+
+	return errorResult(fmt.Sprintf("Unsupported operation: %s (use modpow, modinv, or gcd)", args.Operation)), nil, nil
+}
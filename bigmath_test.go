@@ -0,0 +1,76 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+// mersenne127 is the prime 2^127 - 1.
+const mersenne127 = "170141183460469231731687303715884105727"
+
+func TestBigMath(t *testing.T) {
+	runToolCases(t, handleBigMath, []toolCase[BigMathArgs]{
+		{
+			name: "modpow beyond int64",
+			args: BigMathArgs{Operation: "modpow", A: "12345678901234567890", B: "65537", Modulus: mersenne127},
+			text: "127352203508635771842305703701533661349",
+			out:  `{"result":"127352203508635771842305703701533661349"}`,
+		},
+		{name: "modpow small", args: BigMathArgs{Operation: "modpow", A: "2", B: "100", Modulus: "618970019642690137449562111"}, text: "2048"},
+		{name: "modpow negative base", args: BigMathArgs{Operation: "modpow", A: "-2", B: "3", Modulus: "5"}, text: "2"},
+		{
+			name: "modpow negative exponent",
+			args: BigMathArgs{Operation: "modpow", A: "98765432109876543210", B: "-3", Modulus: mersenne127},
+			text: "109013603329355498458564142697774890277",
+		},
+		{name: "modpow exponent not invertible", args: BigMathArgs{Operation: "modpow", A: "2", B: "-1", Modulus: "4"}, err: true, text: "2 is not invertible modulo 4, so a negative exponent is undefined"},
+		{
+			name: "modinv beyond int64",
+			args: BigMathArgs{Operation: "modinv", A: "12345678901234567890", Modulus: mersenne127},
+			text: "95987530177320089548629399254220539361",
+			out:  `{"result":"95987530177320089548629399254220539361"}`,
+		},
+		{name: "modinv negative", args: BigMathArgs{Operation: "modinv", A: "-3", Modulus: "7"}, text: "2"},
+		{name: "modinv not coprime", args: BigMathArgs{Operation: "modinv", A: "18446744073709551616", Modulus: "36893488147419103232"}, err: true, text: "18446744073709551616 has no inverse modulo 36893488147419103232 (they are not coprime)"},
+		{
+			name: "gcd beyond int64",
+			args: BigMathArgs{Operation: "gcd", A: "55340232221128654848", B: "92233720368547758080"},
+			text: "18446744073709551616",
+			out:  `{"result":"18446744073709551616","x":"2","y":"-1"}`,
+		},
+		{name: "gcd negative", args: BigMathArgs{Operation: "gcd", A: "-12", B: "18"}, text: "6", out: `{"result":"6","x":"1","y":"1"}`},
+		{name: "zero modulus", args: BigMathArgs{Operation: "modpow", A: "2", B: "3", Modulus: "0"}, err: true, text: "Modulus must be a positive integer"},
+		{name: "missing modulus", args: BigMathArgs{Operation: "modinv", A: "2"}, err: true, text: "'modulus' is required"},
+		{name: "not an integer", args: BigMathArgs{Operation: "gcd", A: "1.5", B: "3"}, err: true, text: "'a' is not a decimal integer: 1.5"},
+		{name: "hex rejected", args: BigMathArgs{Operation: "gcd", A: "4", B: "0x10"}, err: true, text: "'b' is not a decimal integer: 0x10"},
+		{name: "unknown operation", args: BigMathArgs{Operation: "pow", A: "2"}, err: true, text: "Unsupported operation: pow (use modpow, modinv, or gcd)"},
+	})
+}
+
+func TestBigMathBezout(t *testing.T) {
+	for _, pair := range [][2]string{
+		{"12345678901234567890", "98765432109876543210"},
+		{"-340282366920938463463374607431768211456", "1000000000000000000000007"},
+		{"0", "-99999999999999999999"},
+	} {
+		_, out := callTool(t, handleBigMath, BigMathArgs{Operation: "gcd", A: pair[0], B: pair[1]})
+		fields := out.(map[string]any)
+		parse := func(s string) *big.Int {
+			n, ok := new(big.Int).SetString(s, 10)
+			if !ok {
+				t.Fatalf("%q is not an integer", s)
+			}
+			return n
+		}
+		a, b := parse(pair[0]), parse(pair[1])
+		g, x, y := parse(fields["result"].(string)), parse(fields["x"].(string)), parse(fields["y"].(string))
+
+		if want := new(big.Int).GCD(nil, nil, new(big.Int).Abs(a), new(big.Int).Abs(b)); g.Cmp(want) != 0 {
+			t.Errorf("gcd(%s, %s) = %s, want %s", a, b, g, want)
+		}
+		sum := new(big.Int).Add(new(big.Int).Mul(a, x), new(big.Int).Mul(b, y))
+		if sum.Cmp(g) != 0 {
+			t.Errorf("%s*%s + %s*%s = %s, want %s", a, x, b, y, sum, g)
+		}
+	}
+}
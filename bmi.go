@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type BMIArgs struct {
+	Weight            float64 `json:"weight" jsonschema:"Body weight"`
+	WeightUnit        string  `json:"weight_unit,omitempty" jsonschema:"Unit of weight (kg, g, lb, st, or oz). Defaults to kg"`
+	Height            float64 `json:"height" jsonschema:"Body height"`
+	HeightUnit        string  `json:"height_unit,omitempty" jsonschema:"Unit of height (cm, m, in, or ft). Defaults to cm"`
+	IncludeIdealRange bool    `json:"include_ideal_range,omitempty" jsonschema:"Also return the weight range for a normal BMI (18.5 to 24.9) at this height, in weight_unit"`
+}
+
+// weightUnitAliases maps spellings of weight units to their canonical names.
+var weightUnitAliases = map[string]string{
+	"kg":        "kg",
+	"kgs":       "kg",
+	"kilogram":  "kg",
+	"kilograms": "kg",
+	"g":         "g",
+	"gram":      "g",
+	"grams":     "g",
+	"lb":        "lb",
+	"lbs":       "lb",
+	"pound":     "lb",
+	"pounds":    "lb",
+	"st":        "st",
+	"stone":     "st",
+	"stones":    "st",
+	"oz":        "oz",
+	"ounce":     "oz",
+	"ounces":    "oz",
+}
+
+var kilogramsPerWeightUnit = map[string]float64{
+	"kg": 1,
+	"g":  0.001,
+	"lb": 0.45359237,
+	"st": 6.35029318,
+	"oz": 0.028349523125,
+}
+
+// convertWeight converts a weight between units, going through kilograms.
+func convertWeight(value float64, from, to string) (float64, error) {
+	fromScale, ok := kilogramsPerWeightUnit[from]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit: %s", from)
+	}
+	toScale, ok := kilogramsPerWeightUnit[to]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit: %s", to)
+	}
+	return value * fromScale / toScale, nil
+}
+
+// bodyHeightUnits are the length units accepted for height.
+var bodyHeightUnits = map[string]bool{"cm": true, "m": true, "in": true, "ft": true}
+
+// Weights and heights outside these bounds are not those of a person, so
+// the BMI of such inputs would be meaningless.
+const (
+	minBMIKilograms = 1
+	maxBMIKilograms = 700
+	minBMIMeters    = 0.3
+	maxBMIMeters    = 3.0
+)
+
+// bmiCategory follows the WHO adult classification.
+func bmiCategory(bmi float64) string {
+	switch {
+	case bmi < 18.5:
+		return "underweight"
+	case bmi < 25:
+		return "normal"
+	case bmi < 30:
+		return "overweight"
+	case bmi < 35:
+		return "obese (class I)"
+	case bmi < 40:
+		return "obese (class II)"
+	default:
+		return "obese (class III)"
+	}
+}
+
+func handleBMI(ctx context.Context, req *mcp.CallToolRequest, args BMIArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("bmi called: weight=%g %s height=%g %s", args.Weight, args.WeightUnit, args.Height, args.HeightUnit))
+
+	if !(args.Weight > 0) || math.IsInf(args.Weight, 0) || !(args.Height > 0) || math.IsInf(args.Height, 0) {
+		return errorResult("Weight and height must be positive numbers"), nil, nil
+	}
+
+	weightUnit := "kg"
+	if args.WeightUnit != "" {
+		var ok bool
+		if weightUnit, ok = weightUnitAliases[strings.ToLower(strings.TrimSpace(args.WeightUnit))]; !ok {
+			return errorResult(fmt.Sprintf("unknown unit: %s", args.WeightUnit)), nil, nil
+		}
+	}
+	heightUnit := "cm"
+	if args.HeightUnit != "" {
+		heightUnit = lengthUnitAliases[strings.ToLower(strings.TrimSpace(args.HeightUnit))]
+		if !bodyHeightUnits[heightUnit] {
+			return errorResult(fmt.Sprintf("Unsupported height unit: %s", args.HeightUnit)), nil, nil
+		}
+	}
+
+	kg, _ := convertWeight(args.Weight, weightUnit, "kg")
+	meters, _ := convertLength(args.Height, heightUnit, "m")
+	if kg < minBMIKilograms || kg > maxBMIKilograms {
+		return errorResult(fmt.Sprintf("Weight must be between %d and %d kg", minBMIKilograms, maxBMIKilograms)), nil, nil
+	}
+	if meters < minBMIMeters || meters > maxBMIMeters {
+		return errorResult(fmt.Sprintf("Height must be between %g and %g m", minBMIMeters, maxBMIMeters)), nil, nil
+	}
+	bmi := kg / (meters * meters)
+	category := bmiCategory(bmi)
+
+	out := map[string]any{
+		"bmi":      roundTo(bmi, 1),
+		"category": category,
+	}
+	text := fmt.Sprintf("BMI: %.1f (%s)", bmi, category)
+
+	if args.IncludeIdealRange {
+		low, _ := convertWeight(18.5*meters*meters, "kg", weightUnit)
+		high, _ := convertWeight(24.9*meters*meters, "kg", weightUnit)
+		low, high = roundTo(low, 1), roundTo(high, 1)
+		out["ideal_weight_range"] = map[string]any{"min": low, "max": high, "unit": weightUnit}
+		text += fmt.Sprintf("\nNormal weight range: %.1f-%.1f %s", low, high, weightUnit)
+	}
+
+	return textResult(text), out, nil
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBMI(t *testing.T) {
+	runToolCases(t, handleBMI, []toolCase[BMIArgs]{
+		{
+			name: "metric",
+			args: BMIArgs{Weight: 70, Height: 175},
+			text: "BMI: 22.9 (normal)",
+			out:  `{"bmi":22.9,"category":"normal"}`,
+		},
+		{
+			name: "imperial",
+			args: BMIArgs{Weight: 180, WeightUnit: "lbs", Height: 70, HeightUnit: "in"},
+			text: "BMI: 25.8 (overweight)",
+			out:  `{"bmi":25.8,"category":"overweight"}`,
+		},
+		{
+			name: "stone",
+			args: BMIArgs{Weight: 11, WeightUnit: "st", Height: 175},
+			out:  `{"bmi":22.8}`,
+		},
+		{
+			name: "underweight",
+			args: BMIArgs{Weight: 45, Height: 175},
+			out:  `{"bmi":14.7,"category":"underweight"}`,
+		},
+		{
+			name: "obese",
+			args: BMIArgs{Weight: 130, Height: 175},
+			text: "BMI: 42.4 (obese (class III))",
+		},
+		{
+			name: "ideal range in kilograms",
+			args: BMIArgs{Weight: 70, Height: 1.75, HeightUnit: "m", IncludeIdealRange: true},
+			text: "BMI: 22.9 (normal)\nNormal weight range: 56.7-76.3 kg",
+			out:  `{"ideal_weight_range":{"min":56.7,"max":76.3,"unit":"kg"}}`,
+		},
+		{
+			name: "ideal range in pounds",
+			args: BMIArgs{Weight: 180, WeightUnit: "Pounds", Height: 70, HeightUnit: "in", IncludeIdealRange: true},
+			text: "BMI: 25.8 (overweight)\nNormal weight range: 128.9-173.5 lb",
+			out:  `{"ideal_weight_range":{"min":128.9,"max":173.5,"unit":"lb"}}`,
+		},
+		{
+			name: "zero weight",
+			args: BMIArgs{Weight: 0, Height: 175},
+			err:  true,
+			text: "Weight and height must be positive numbers",
+		},
+		{
+			name: "negative height",
+			args: BMIArgs{Weight: 70, Height: -175},
+			err:  true,
+			text: "Weight and height must be positive numbers",
+		},
+		{
+			name: "infinite weight",
+			args: BMIArgs{Weight: math.Inf(1), Height: 175},
+			err:  true,
+			text: "Weight and height must be positive numbers",
+		},
+		{
+			name: "unknown weight unit",
+			args: BMIArgs{Weight: 70, WeightUnit: "ton", Height: 175},
+			err:  true,
+			text: "unknown unit: ton",
+		},
+		{
+			name: "unsupported height unit",
+			args: BMIArgs{Weight: 70, Height: 175, HeightUnit: "km"},
+			err:  true,
+			text: "Unsupported height unit: km",
+		},
+		{
+			name: "implausible weight",
+			args: BMIArgs{Weight: 800, Height: 175},
+			err:  true,
+			text: "Weight must be between 1 and 700 kg",
+		},
+		{
+			name: "implausible height",
+			args: BMIArgs{Weight: 70, Height: 20},
+			err:  true,
+			text: "Height must be between 0.3 and 3 m",
+		},
+	})
+}
+
+func TestBMIMetricMatchesImperial(t *testing.T) {
+	pairs := []struct {
+		name             string
+		metric, imperial BMIArgs
+	}{
+		{
+			name:     "pounds and inches",
+			metric:   BMIArgs{Weight: 81.6466266, Height: 177.8},
+			imperial: BMIArgs{Weight: 180, WeightUnit: "lb", Height: 70, HeightUnit: "in"},
+		},
+		{
+			name:     "pounds and feet",
+			metric:   BMIArgs{Weight: 68.0388555, WeightUnit: "kg", Height: 1.8288, HeightUnit: "m"},
+			imperial: BMIArgs{Weight: 150, WeightUnit: "lb", Height: 6, HeightUnit: "ft"},
+		},
+	}
+	for _, p := range pairs {
+		t.Run(p.name, func(t *testing.T) {
+			metric, metricOut := callTool(t, handleBMI, p.metric)
+			imperial, imperialOut := callTool(t, handleBMI, p.imperial)
+			if resultText(metric) != resultText(imperial) {
+				t.Errorf("metric text %q, imperial text %q", resultText(metric), resultText(imperial))
+			}
+			if m, i := numberField(t, metricOut, "bmi"), numberField(t, imperialOut, "bmi"); m != i {
+				t.Errorf("metric bmi %v, imperial bmi %v", m, i)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type BusinessDaysArgs struct {
+	Start         string   `json:"start" jsonschema:"First date of the range (RFC3339 or YYYY-MM-DD)"`
+	End           string   `json:"end" jsonschema:"Last date of the range (RFC3339 or YYYY-MM-DD), counted inclusively"`
+	Holidays      []string `json:"holidays,omitempty" jsonschema:"Dates to exclude in addition to weekends (RFC3339 or YYYY-MM-DD)"`
+	AllowNegative bool     `json:"allow_negative,omitempty" jsonschema:"Return a negative count when end is before start instead of an error"`
+}
+
+// maxBusinessDaysSpan bounds the range business_days will walk.
+const maxBusinessDaysSpan = 100 * 366
+
+// parseDate accepts an RFC3339 timestamp or a plain YYYY-MM-DD date and
+// returns it as a calendar date at midnight UTC. The time of day and offset of
+// an RFC3339 value are discarded after taking its local date.
+func parseDate(s string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q (expected RFC3339 or YYYY-MM-DD)", s)
+		}
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC), nil
+}
+
+func handleBusinessDays(ctx context.Context, req *mcp.CallToolRequest, args BusinessDaysArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("business_days called: %s to %s, %d holidays", args.Start, args.End, len(args.Holidays)))
+
+	start, err := parseDate(args.Start)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid start: %v", err)), nil, nil
+	}
+	end, err := parseDate(args.End)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid end: %v", err)), nil, nil
+	}
+
+	from, to := start, end
+	sign := 1
+	if end.Before(start) {
+		if !args.AllowNegative {
+			return errorResult("End date is before start date (set 'allow_negative' to count backwards)"), nil, nil
+		}
+		start, end = end, start
+		sign = -1
+	}
+	if end.Sub(start).Hours()/24 > maxBusinessDaysSpan {
+		return errorResult("Date range must not exceed 100 years"), nil, nil
+	}
+
+	holidays := make(map[time.Time]bool, len(args.Holidays))
+	for _, h := range args.Holidays {
+		d, err := parseDate(h)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid holiday: %v", err)), nil, nil
+		}
+		holidays[d] = true
+	}
+
+	var working, weekends, holidayCount int
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		switch {
+		case d.Weekday() == time.Saturday || d.Weekday() == time.Sunday:
+			weekends++
+		case holidays[d]:
+			holidayCount++
+		default:
+			working++
+		}
+	}
+
+	count := sign * working
+	return textResult(fmt.Sprintf("%d business days (%d weekend days and %d holidays excluded)", count, weekends, holidayCount)),
+		map[string]any{
+			"business_days":     count,
+			"excluded_days":     weekends + holidayCount,
+			"excluded_weekends": weekends,
+			"excluded_holidays": holidayCount,
+			"start":             from.Format("2006-01-02"),
+			"end":               to.Format("2006-01-02"),
+		}, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestBusinessDays(t *testing.T) {
+	runToolCases(t, handleBusinessDays, []toolCase[BusinessDaysArgs]{
+		{
+			name: "spans a weekend",
+			args: BusinessDaysArgs{Start: "2026-10-09", End: "2026-10-13"},
+			text: "3 business days (2 weekend days and 0 holidays excluded)",
+			out:  `{"business_days":3,"excluded_days":2,"excluded_weekends":2,"excluded_holidays":0,"start":"2026-10-09","end":"2026-10-13"}`,
+		},
+		{
+			name: "supplied holiday",
+			args: BusinessDaysArgs{Start: "2026-10-09", End: "2026-10-13", Holidays: []string{"2026-10-12"}},
+			text: "2 business days (2 weekend days and 1 holidays excluded)",
+			out:  `{"business_days":2,"excluded_days":3,"excluded_weekends":2,"excluded_holidays":1}`,
+		},
+		{
+			name: "holiday on a weekend",
+			args: BusinessDaysArgs{Start: "2026-10-09", End: "2026-10-13", Holidays: []string{"2026-10-10", "2026-12-25"}},
+			out:  `{"business_days":3,"excluded_weekends":2,"excluded_holidays":0}`,
+		},
+		{
+			name: "RFC3339 uses the local date",
+			args: BusinessDaysArgs{Start: "2026-10-12T23:30:00-05:00", End: "2026-10-12T08:00:00+09:00"},
+			text: "1 business days (0 weekend days and 0 holidays excluded)",
+			out:  `{"business_days":1,"start":"2026-10-12","end":"2026-10-12"}`,
+		},
+		{
+			name: "negative",
+			args: BusinessDaysArgs{Start: "2026-10-13", End: "2026-10-09", AllowNegative: true},
+			out:  `{"business_days":-3,"excluded_days":2,"start":"2026-10-13","end":"2026-10-09"}`,
+		},
+		{name: "end before start", args: BusinessDaysArgs{Start: "2026-10-13", End: "2026-10-09"}, err: true, text: "End date is before start date (set 'allow_negative' to count backwards)"},
+		{name: "bad start", args: BusinessDaysArgs{Start: "10/09/2026", End: "2026-10-13"}, err: true, text: `Invalid start: invalid date "10/09/2026" (expected RFC3339 or YYYY-MM-DD)`},
+		{name: "bad holiday", args: BusinessDaysArgs{Start: "2026-10-09", End: "2026-10-13", Holidays: []string{"2026-13-01"}}, err: true, text: `Invalid holiday: invalid date "2026-13-01" (expected RFC3339 or YYYY-MM-DD)`},
+		{name: "too long", args: BusinessDaysArgs{Start: "1900-01-01", End: "2026-01-01"}, err: true, text: "Date range must not exceed 100 years"},
+	})
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type BusinessHoursArgs struct {
+	Start           string   `json:"start" jsonschema:"Start of the span (RFC3339, or a local date and time such as 2024-01-15T09:30 in timezone)"`
+	End             string   `json:"end" jsonschema:"End of the span, in the same formats as start"`
+	DayStart        string   `json:"day_start,omitempty" jsonschema:"Opening time each business day as HH:MM (default 09:00)"`
+	DayEnd          string   `json:"day_end,omitempty" jsonschema:"Closing time each business day as HH:MM, after day_start; 24:00 means midnight (default 17:00)"`
+	Timezone        string   `json:"timezone,omitempty" jsonschema:"IANA time zone the business hours apply in, e.g. Europe/Berlin (default UTC)"`
+	IncludeWeekends bool     `json:"include_weekends,omitempty" jsonschema:"Count Saturdays and Sundays as business days"`
+	Holidays        []string `json:"holidays,omitempty" jsonschema:"Dates to exclude (YYYY-MM-DD)"`
+}
+
+// localTimestampLayouts are tried, in order, for timestamps without a UTC
+// offset, which are read in the business-hours time zone.
+var localTimestampLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func parseTimestampIn(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.In(loc), nil
+	}
+	for _, layout := range localTimestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q (expected RFC3339 or YYYY-MM-DD[THH:MM[:SS]])", s)
+}
+
+// parseClock parses HH:MM into minutes after midnight, allowing 24:00.
+func parseClock(s string) (int, error) {
+	h, m, ok := strings.Cut(s, ":")
+	hours, errH := strconv.Atoi(h)
+	minutes, errM := strconv.Atoi(m)
+	if !ok || len(m) != 2 || errH != nil || errM != nil || hours < 0 || minutes < 0 || minutes > 59 ||
+		hours > 24 || hours == 24 && minutes != 0 {
+		return 0, fmt.Errorf("invalid time %q (expected HH:MM)", s)
+	}
+	return hours*60 + minutes, nil
+}
+
+// humanizeDuration renders d as hours, minutes, and seconds, omitting zero
+// parts, e.g. "26h 30m". Days are left out because a business day is not
+// 24 hours.
+func humanizeDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+	total := int64(d / time.Second)
+	parts := []string{}
+	for _, unit := range []struct {
+		suffix  string
+		seconds int64
+	}{{"h", 3600}, {"m", 60}, {"s", 1}} {
+		if n := total / unit.seconds; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, unit.suffix))
+			total %= unit.seconds
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func handleBusinessHours(ctx context.Context, req *mcp.CallToolRequest, args BusinessHoursArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("business_hours called: %s to %s in %q", args.Start, args.End, args.Timezone))
+
+	loc := time.UTC
+	if args.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(args.Timezone); err != nil {
+			return errorResult(fmt.Sprintf("Unknown timezone: %s", args.Timezone)), nil, nil
+		}
+	}
+
+	start, err := parseTimestampIn(args.Start, loc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid start: %v", err)), nil, nil
+	}
+	end, err := parseTimestampIn(args.End, loc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid end: %v", err)), nil, nil
+	}
+	if end.Before(start) {
+		return errorResult("End is before start"), nil, nil
+	}
+	if end.Sub(start).Hours()/24 > maxBusinessDaysSpan {
+		return errorResult("Span must not exceed 100 years"), nil, nil
+	}
+
+	dayStartText, dayEndText := args.DayStart, args.DayEnd
+	if dayStartText == "" {
+		dayStartText = "09:00"
+	}
+	if dayEndText == "" {
+		dayEndText = "17:00"
+	}
+	open, err := parseClock(dayStartText)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid day_start: %v", err)), nil, nil
+	}
+	closing, err := parseClock(dayEndText)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid day_end: %v", err)), nil, nil
+	}
+	if closing <= open {
+		return errorResult("day_end must be after day_start; overnight windows are not supported"), nil, nil
+	}
+
+	holidays := make(map[time.Time]bool, len(args.Holidays))
+	for _, h := range args.Holidays {
+		d, err := parseDate(h)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid holiday: %v", err)), nil, nil
+		}
+		holidays[d] = true
+	}
+
+	// Each calendar day's window is built from its own date in loc, so a
+	// window on a daylight saving change day is an hour shorter or longer.
+	var elapsed time.Duration
+	businessDays := 0
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); !day.After(end); day = day.AddDate(0, 0, 1) {
+		dy, dm, dd := day.Date()
+		if !args.IncludeWeekends && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+			continue
+		}
+		if holidays[time.Date(dy, dm, dd, 0, 0, 0, 0, time.UTC)] {
+			continue
+		}
+
+		windowStart := time.Date(dy, dm, dd, open/60, open%60, 0, 0, loc)
+		windowEnd := time.Date(dy, dm, dd, closing/60, closing%60, 0, 0, loc)
+		from, to := windowStart, windowEnd
+		if start.After(from) {
+			from = start
+		}
+		if end.Before(to) {
+			to = end
+		}
+		if to.After(from) {
+			elapsed += to.Sub(from)
+			businessDays++
+		}
+	}
+
+	seconds := int64(elapsed / time.Second)
+	human := humanizeDuration(elapsed)
+	return textResult(fmt.Sprintf("Business time: %s (%d seconds)", human, seconds)),
+		map[string]any{
+			"seconds":       seconds,
+			"humanized":     human,
+			"hours":         elapsed.Hours(),
+			"business_days": businessDays,
+		}, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessHours(t *testing.T) {
+	// 2024-01-15 is a Monday.
+	runToolCases(t, handleBusinessHours, []toolCase[BusinessHoursArgs]{
+		{
+			name: "same day",
+			args: BusinessHoursArgs{Start: "2024-01-15T10:00", End: "2024-01-15T15:30"},
+			text: "Business time: 5h 30m (19800 seconds)",
+			out:  `{"seconds":19800,"humanized":"5h 30m","hours":5.5,"business_days":1}`,
+		},
+		{
+			name: "over a weekend",
+			args: BusinessHoursArgs{Start: "2024-01-19T15:00", End: "2024-01-22T11:00"},
+			text: "Business time: 4h (14400 seconds)",
+			out:  `{"seconds":14400,"business_days":2}`,
+		},
+		{
+			name: "weekends included",
+			args: BusinessHoursArgs{Start: "2024-01-19T15:00", End: "2024-01-22T11:00", IncludeWeekends: true},
+			out:  `{"seconds":72000,"humanized":"20h","business_days":4}`,
+		},
+		{
+			name: "partial days clipped to the window",
+			args: BusinessHoursArgs{Start: "2024-01-15T07:00", End: "2024-01-17T12:15:30"},
+			out:  `{"humanized":"19h 15m 30s","business_days":3}`,
+		},
+		{
+			name: "outside business hours",
+			args: BusinessHoursArgs{Start: "2024-01-15T17:30", End: "2024-01-16T08:59"},
+			text: "Business time: 0s (0 seconds)",
+			out:  `{"seconds":0,"business_days":0}`,
+		},
+		{
+			name: "holiday",
+			args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-17", Holidays: []string{"2024-01-16"}},
+			out:  `{"seconds":28800,"business_days":1}`,
+		},
+		{
+			name: "custom window",
+			args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-16", DayStart: "08:30", DayEnd: "24:00"},
+			out:  `{"humanized":"15h 30m"}`,
+		},
+		{
+			name: "timezone",
+			args: BusinessHoursArgs{Start: "2024-01-15T07:00:00Z", End: "2024-01-15T17:00:00Z", Timezone: "Europe/Berlin"},
+			out:  `{"humanized":"8h"}`,
+		},
+		{
+			name: "short daylight saving day",
+			args: BusinessHoursArgs{Start: "2024-03-10", End: "2024-03-11", Timezone: "America/New_York", DayStart: "00:00", DayEnd: "24:00", IncludeWeekends: true},
+			out:  `{"humanized":"23h"}`,
+		},
+		{name: "unknown timezone", args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-16", Timezone: "Mars/Olympus"}, err: true, text: "Unknown timezone: Mars/Olympus"},
+		{name: "bad start", args: BusinessHoursArgs{Start: "15/01/2024", End: "2024-01-16"}, err: true, text: `Invalid start: invalid timestamp "15/01/2024" (expected RFC3339 or YYYY-MM-DD[THH:MM[:SS]])`},
+		{name: "bad end", args: BusinessHoursArgs{Start: "2024-01-15", End: "soon"}, err: true, text: `Invalid end: invalid timestamp "soon" (expected RFC3339 or YYYY-MM-DD[THH:MM[:SS]])`},
+		{name: "end before start", args: BusinessHoursArgs{Start: "2024-01-16", End: "2024-01-15"}, err: true, text: "End is before start"},
+		{name: "span too long", args: BusinessHoursArgs{Start: "1900-01-01", End: "2024-01-01"}, err: true, text: "Span must not exceed 100 years"},
+		{name: "bad day_start", args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-16", DayStart: "9:5"}, err: true, text: `Invalid day_start: invalid time "9:5" (expected HH:MM)`},
+		{name: "bad day_end", args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-16", DayEnd: "24:30"}, err: true, text: `Invalid day_end: invalid time "24:30" (expected HH:MM)`},
+		{name: "overnight window", args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-16", DayStart: "22:00", DayEnd: "06:00"}, err: true, text: "day_end must be after day_start; overnight windows are not supported"},
+		{name: "bad holiday", args: BusinessHoursArgs{Start: "2024-01-15", End: "2024-01-16", Holidays: []string{"Jan 1"}}, err: true, text: `Invalid holiday: invalid date "Jan 1" (expected RFC3339 or YYYY-MM-DD)`},
+	})
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{59 * time.Second, "59s"},
+		{time.Hour + 5*time.Second, "1h 5s"},
+		{26*time.Hour + 30*time.Minute, "26h 30m"},
+		{1500 * time.Millisecond, "1s"},
+	}
+	for _, tt := range tests {
+		if got := humanizeDuration(tt.d); got != tt.want {
+			t.Errorf("humanizeDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CalcArgs struct {
+	Expression string `json:"expression" jsonschema:"Arithmetic expression using numbers, + - * / ^ (or **), and parentheses"`
+	IncludeAST bool   `json:"include_ast,omitempty" jsonschema:"Also return the parsed syntax tree"`
+}
+
+const (
+	maxCalcLength = 1000
+	maxCalcDepth  = 100
+)
+
+// calcNode is a node of a parsed expression: a number, a unary minus or plus
+// with an operand, or a binary operator with left and right operands.
+type calcNode struct {
+	Type    string    `json:"type"`
+	Op      string    `json:"op,omitempty"`
+	Value   *float64  `json:"value,omitempty"`
+	Operand *calcNode `json:"operand,omitempty"`
+	Left    *calcNode `json:"left,omitempty"`
+	Right   *calcNode `json:"right,omitempty"`
+	column  int
+}
+
+// calcParser is a recursive descent parser for
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = ("+" | "-") unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | "(" expr ")"
+//
+// so exponentiation binds tighter than unary minus (-2^2 is -4) and groups
+// to the right (2^3^2 is 2^9). Columns in errors are 1-based.
+type calcParser struct {
+	input []rune
+	pos   int
+	depth int
+}
+
+func (p *calcParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// peek returns the next operator token without consuming it, treating "**"
+// as "^".
+func (p *calcParser) peek() (string, int) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return "", 0
+	}
+	if p.input[p.pos] == '*' && p.pos+1 < len(p.input) && p.input[p.pos+1] == '*' {
+		return "^", 2
+	}
+	return string(p.input[p.pos]), 1
+}
+
+func (p *calcParser) errorf(format string, args ...any) error {
+	return fmt.Errorf(format+" at column %d", append(args, p.pos+1)...)
+}
+
+func (p *calcParser) describeNext() string {
+	if p.pos >= len(p.input) {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", p.input[p.pos])
+}
+
+func (p *calcParser) enter() error {
+	p.depth++
+	if p.depth > maxCalcDepth {
+		return p.errorf("Expression is nested more than %d levels deep", maxCalcDepth)
+	}
+	return nil
+}
+
+func (p *calcParser) parseBinary(next func() (*calcNode, error), ops string) (*calcNode, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, width := p.peek()
+		if len(tok) != 1 || tok == "^" || !strings.Contains(ops, tok) {
+			return left, nil
+		}
+		column := p.pos + 1
+		p.pos += width
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+		left = &calcNode{Type: "binary", Op: tok, Left: left, Right: right, column: column}
+	}
+}
+
+func (p *calcParser) parseExpr() (*calcNode, error) {
+	return p.parseBinary(p.parseTerm, "+-")
+}
+
+func (p *calcParser) parseTerm() (*calcNode, error) {
+	return p.parseBinary(p.parseUnary, "*/")
+}
+
+func (p *calcParser) parseUnary() (*calcNode, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	if tok, width := p.peek(); tok == "-" || tok == "+" {
+		column := p.pos + 1
+		p.pos += width
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &calcNode{Type: "unary", Op: tok, Operand: operand, column: column}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *calcParser) parsePower() (*calcNode, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	tok, width := p.peek()
+	if tok != "^" {
+		return base, nil
+	}
+	column := p.pos + 1
+	p.pos += width
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &calcNode{Type: "binary", Op: "^", Left: base, Right: exponent, column: column}, nil
+}
+
+func (p *calcParser) parsePrimary() (*calcNode, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return nil, p.errorf("Expected a number or '(' but found end of expression")
+	}
+
+	if p.input[p.pos] == '(' {
+		p.pos++
+		inner, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.pos >= len(p.input) || p.input[p.pos] != ')' {
+			return nil, p.errorf("Expected ')' but found %s", p.describeNext())
+		}
+		p.pos++
+		return inner, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && (unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+		p.pos++
+	}
+	// An exponent such as 1e-3 is part of the number.
+	if p.pos > start && p.pos < len(p.input) && (p.input[p.pos] == 'e' || p.input[p.pos] == 'E') {
+		end := p.pos + 1
+		if end < len(p.input) && (p.input[end] == '+' || p.input[end] == '-') {
+			end++
+		}
+		if end < len(p.input) && unicode.IsDigit(p.input[end]) {
+			p.pos = end
+			for p.pos < len(p.input) && unicode.IsDigit(p.input[p.pos]) {
+				p.pos++
+			}
+		}
+	}
+	if p.pos == start {
+		return nil, p.errorf("Expected a number or '(' but found %s", p.describeNext())
+	}
+
+	literal := string(p.input[start:p.pos])
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil || math.IsInf(value, 0) {
+		p.pos = start
+		return nil, p.errorf("Invalid number %q", literal)
+	}
+	return &calcNode{Type: "number", Value: &value, column: start + 1}, nil
+}
+
+// parseCalc parses a whole expression, rejecting trailing input.
+func parseCalc(expression string) (*calcNode, error) {
+	input := []rune(expression)
+	if len(input) > maxCalcLength {
+		return nil, fmt.Errorf("Expression is longer than %d characters", maxCalcLength)
+	}
+	p := &calcParser{input: input}
+	node, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return nil, p.errorf("Unexpected %s", p.describeNext())
+	}
+	return node, nil
+}
+
+// evalCalc evaluates a parsed expression, reporting division by zero and
+// non-finite intermediate results at the operator that produced them.
+func evalCalc(node *calcNode) (float64, error) {
+	switch node.Type {
+	case "number":
+		return *node.Value, nil
+	case "unary":
+		v, err := evalCalc(node.Operand)
+		if err != nil || node.Op == "+" {
+			return v, err
+		}
+		return -v, nil
+	}
+
+	left, err := evalCalc(node.Left)
+	if err != nil {
+		return 0, err
+	}
+	right, err := evalCalc(node.Right)
+	if err != nil {
+		return 0, err
+	}
+
+	var result float64
+	switch node.Op {
+	case "+":
+		result = left + right
+	case "-":
+		result = left - right
+	case "*":
+		result = left * right
+	case "/":
+		if right == 0 {
+			return 0, fmt.Errorf("Division by zero at column %d", node.column)
+		}
+		result = left / right
+	case "^":
+		result = math.Pow(left, right)
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, fmt.Errorf("Result of %q at column %d is not a finite number", node.Op, node.column)
+	}
+	return result, nil
+}
+
+func handleCalc(ctx context.Context, req *mcp.CallToolRequest, args CalcArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("calc called with expression: %s", args.Expression))
+
+	ast, err := parseCalc(args.Expression)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	result, err := evalCalc(ast)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+
+	out := map[string]any{"result": result}
+	if args.IncludeAST {
+		out["ast"] = ast
+	}
+	return textResult(strconv.FormatFloat(result, 'g', -1, 64)), out, nil
+}
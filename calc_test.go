@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCalc(t *testing.T) {
+	runToolCases(t, handleCalc, []toolCase[CalcArgs]{
+		{name: "precedence", args: CalcArgs{Expression: "3 + 4 * (2 - 1)"}, text: "7", out: `{"result":7}`},
+		{name: "multiplication before addition", args: CalcArgs{Expression: "2 + 3 * 4 - 6 / 2"}, text: "11"},
+		{name: "parentheses", args: CalcArgs{Expression: "(3 + 4) * 2"}, text: "14", out: `{"result":14}`},
+		{name: "nested parentheses", args: CalcArgs{Expression: "((1 + 2) * (3 + 4)) / 7"}, text: "3"},
+		{name: "left associative", args: CalcArgs{Expression: "10 / 4 - 1 - 1"}, text: "0.5"},
+		{name: "power right associative", args: CalcArgs{Expression: "2 ^ 3 ^ 2"}, text: "512"},
+		{name: "power before unary minus", args: CalcArgs{Expression: "-2^2"}, text: "-4"},
+		{name: "double star with negative exponent", args: CalcArgs{Expression: "2**-1"}, text: "0.5"},
+		{name: "scientific notation", args: CalcArgs{Expression: "1e-3 * 2"}, text: "0.002"},
+		{
+			name: "ast",
+			args: CalcArgs{Expression: "1 - -2", IncludeAST: true},
+			text: "3",
+			out:  `{"result":3,"ast":{"type":"binary","op":"-","left":{"type":"number","value":1},"right":{"type":"unary","op":"-","operand":{"type":"number","value":2}}}}`,
+		},
+		{name: "divide by zero", args: CalcArgs{Expression: "1 / (2 - 2)"}, err: true, text: "Division by zero at column 3"},
+		{name: "missing operand", args: CalcArgs{Expression: "2 +"}, err: true, text: "Expected a number or '(' but found end of expression at column 4"},
+		{name: "unclosed parenthesis", args: CalcArgs{Expression: "(1 + 2"}, err: true, text: "Expected ')' but found end of expression at column 7"},
+		{name: "extra parenthesis", args: CalcArgs{Expression: "1 + 2)"}, err: true, text: "Unexpected ')' at column 6"},
+		{name: "unknown operator", args: CalcArgs{Expression: "3 $ 4"}, err: true, text: "Unexpected '$' at column 3"},
+		{name: "bad number", args: CalcArgs{Expression: "1.2.3"}, err: true, text: `Invalid number "1.2.3" at column 1`},
+		{name: "empty", args: CalcArgs{}, err: true, text: "Expected a number or '(' but found end of expression at column 1"},
+		{name: "not a real number", args: CalcArgs{Expression: "(-8)^0.5"}, err: true, text: `Result of "^" at column 5 is not a finite number`},
+		{name: "overflow", args: CalcArgs{Expression: "10^400"}, err: true, text: `Result of "^" at column 3 is not a finite number`},
+		{name: "too long", args: CalcArgs{Expression: strings.Repeat("1+", 500) + "1"}, err: true, text: "Expression is longer than 1000 characters"},
+		{name: "nested within limit", args: CalcArgs{Expression: strings.Repeat("(", 50) + "1" + strings.Repeat(")", 50)}, text: "1"},
+		{name: "nested too deep", args: CalcArgs{Expression: strings.Repeat("(", 200) + "1" + strings.Repeat(")", 200)}, err: true, contains: []string{"Expression is nested more than 100 levels deep"}},
+		{name: "unary too deep", args: CalcArgs{Expression: strings.Repeat("-", 200) + "1"}, err: true, contains: []string{"Expression is nested more than 100 levels deep"}},
+	})
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CheckDigitArgs struct {
+	Code   string `json:"code" jsonschema:"Digits without the check digit (compute mode) or the full code (verify mode)"`
+	Mode   string `json:"mode,omitempty" jsonschema:"compute (default) to calculate the check digit, or verify to validate a full code"`
+	Format string `json:"format,omitempty" jsonschema:"Barcode format (ean13 or upca). Inferred from the length when omitted"`
+}
+
+// barcodeFormats maps each supported format to its full length including the
+// check digit.
+var barcodeFormats = map[string]int{
+	"ean13": 13,
+	"upca":  12,
+}
+
+// gs1CheckDigit computes the GS1 mod-10 check digit shared by EAN and UPC
+// codes: weights alternate 3, 1, 3, ... starting from the rightmost data digit.
+func gs1CheckDigit(digits string) int {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			sum += d * 3
+		} else {
+			sum += d
+		}
+	}
+	return (10 - sum%10) % 10
+}
+
+func handleCheckDigit(ctx context.Context, req *mcp.CallToolRequest, args CheckDigitArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("check_digit called: code=%s mode=%q format=%q", args.Code, args.Mode, args.Format))
+
+	code := strings.NewReplacer(" ", "", "-", "").Replace(args.Code)
+	if code == "" {
+		return errorResult("Code must not be empty"), nil, nil
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return errorResult(fmt.Sprintf("Code must contain only digits, found %q", c)), nil, nil
+		}
+	}
+
+	mode := args.Mode
+	if mode == "" {
+		mode = "compute"
+	}
+	if mode != "compute" && mode != "verify" {
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+
+	// In compute mode the input is missing its check digit.
+	missing := 0
+	if mode == "compute" {
+		missing = 1
+	}
+	fullLength := len(code) + missing
+
+	format := strings.ToLower(strings.ReplaceAll(args.Format, "-", ""))
+	if format == "" {
+		for name, length := range barcodeFormats {
+			if length == fullLength {
+				format = name
+			}
+		}
+		if format == "" {
+			return errorResult(fmt.Sprintf("Invalid length %d: expected %d digits for EAN-13 or %d for UPC-A in %s mode",
+				len(code), barcodeFormats["ean13"]-missing, barcodeFormats["upca"]-missing, mode)), nil, nil
+		}
+	} else if length, ok := barcodeFormats[format]; !ok {
+		return errorResult(fmt.Sprintf("Unsupported format: %s", args.Format)), nil, nil
+	} else if length != fullLength {
+		return errorResult(fmt.Sprintf("Invalid length %d for %s in %s mode: expected %d digits",
+			len(code), format, mode, length-missing)), nil, nil
+	}
+
+	if mode == "compute" {
+		digit := gs1CheckDigit(code)
+		full := fmt.Sprintf("%s%d", code, digit)
+		return textResult(full), map[string]any{
+			"check_digit": digit,
+			"code":        full,
+			"format":      format,
+		}, nil
+	}
+
+	expected := gs1CheckDigit(code[:len(code)-1])
+	actual := int(code[len(code)-1] - '0')
+	valid := expected == actual
+
+	text := fmt.Sprintf("Valid %s code", format)
+	if !valid {
+		text = fmt.Sprintf("Invalid %s code: check digit is %d, expected %d", format, actual, expected)
+	}
+
+	return textResult(text), map[string]any{
+		"valid":       valid,
+		"check_digit": actual,
+		"expected":    expected,
+		"code":        code,
+		"format":      format,
+	}, nil
+}
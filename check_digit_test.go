@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestCheckDigit(t *testing.T) {
+	runToolCases(t, handleCheckDigit, []toolCase[CheckDigitArgs]{
+		{
+			name: "compute ean13",
+			args: CheckDigitArgs{Code: "400638133393"},
+			text: "4006381333931",
+			out:  `{"check_digit":1,"code":"4006381333931","format":"ean13"}`,
+		},
+		{
+			name: "compute upca with separators",
+			args: CheckDigitArgs{Code: "0 36000-29145"},
+			text: "036000291452",
+			out:  `{"check_digit":2,"code":"036000291452","format":"upca"}`,
+		},
+		{
+			name: "verify valid ean13",
+			args: CheckDigitArgs{Code: "4006381333931", Mode: "verify"},
+			text: "Valid ean13 code",
+			out:  `{"valid":true,"check_digit":1,"expected":1}`,
+		},
+		{
+			name: "verify wrong digit",
+			args: CheckDigitArgs{Code: "4006381333932", Mode: "verify"},
+			text: "Invalid ean13 code: check digit is 2, expected 1",
+			out:  `{"valid":false,"check_digit":2,"expected":1}`,
+		},
+		{
+			name: "invalid length",
+			args: CheckDigitArgs{Code: "12345"},
+			err:  true,
+			text: "Invalid length 5: expected 12 digits for EAN-13 or 11 for UPC-A in compute mode",
+		},
+		{
+			name: "length mismatches format",
+			args: CheckDigitArgs{Code: "400638133393", Format: "UPC-A"},
+			err:  true,
+			text: "Invalid length 12 for upca in compute mode: expected 11 digits",
+		},
+		{name: "non-digit", args: CheckDigitArgs{Code: "40063813339x"}, err: true, text: `Code must contain only digits, found 'x'`},
+		{name: "empty", args: CheckDigitArgs{Code: " - "}, err: true, text: "Code must not be empty"},
+		{name: "unknown mode", args: CheckDigitArgs{Code: "400638133393", Mode: "repair"}, err: true, text: "Unsupported mode: repair"},
+		{name: "unknown format", args: CheckDigitArgs{Code: "400638133393", Format: "isbn"}, err: true, text: "Unsupported format: isbn"},
+	})
+}
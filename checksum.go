@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"hash/adler32"
+	"hash/crc32"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ChecksumArgs struct {
+	Algorithm string `json:"algorithm" jsonschema:"Checksum algorithm (crc32, crc32c, crc16, crc16-ccitt, crc16-modbus, adler32, fletcher16, or fletcher32)"`
+	Text      string `json:"text,omitempty" jsonschema:"Text whose UTF-8 bytes are checksummed"`
+	Hex       string `json:"hex,omitempty" jsonschema:"Bytes to checksum as hex digits; whitespace is ignored"`
+}
+
+// crc16 computes a 16-bit CRC bit by bit. Reflected variants shift right with
+// the reversed polynomial; the others shift left with the normal one.
+func crc16(data []byte, poly, init uint16, reflected bool) uint16 {
+	crc := init
+	for _, b := range data {
+		if reflected {
+			crc ^= uint16(b)
+			for range 8 {
+				if crc&1 != 0 {
+					crc = crc>>1 ^ poly
+				} else {
+					crc >>= 1
+				}
+			}
+			continue
+		}
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ poly
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func fletcher16(data []byte) uint16 {
+	var a, b uint16
+	for _, c := range data {
+		a = (a + uint16(c)) % 255
+		b = (b + a) % 255
+	}
+	return b<<8 | a
+}
+
+// fletcher32 sums little-endian 16-bit words, padding an odd final byte with
+// zero.
+func fletcher32(data []byte) uint32 {
+	var a, b uint32
+	for i := 0; i < len(data); i += 2 {
+		word := uint32(data[i])
+		if i+1 < len(data) {
+			word |= uint32(data[i+1]) << 8
+		}
+		a = (a + word) % 65535
+		b = (b + a) % 65535
+	}
+	return b<<16 | a
+}
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksumAlgorithms maps each algorithm name to its implementation and the
+// width of its result in bits. The CRC-16 variants follow the catalogue
+// names ARC, CCITT-FALSE, and MODBUS.
+var checksumAlgorithms = map[string]struct {
+	bits int
+	sum  func([]byte) uint32
+}{
+	"crc32":        {32, crc32.ChecksumIEEE},
+	"crc32c":       {32, func(d []byte) uint32 { return crc32.Checksum(d, castagnoliTable) }},
+	"crc16":        {16, func(d []byte) uint32 { return uint32(crc16(d, 0xa001, 0, true)) }},
+	"crc16-ccitt":  {16, func(d []byte) uint32 { return uint32(crc16(d, 0x1021, 0xffff, false)) }},
+	"crc16-modbus": {16, func(d []byte) uint32 { return uint32(crc16(d, 0xa001, 0xffff, true)) }},
+	"adler32":      {32, adler32.Checksum},
+	"fletcher16":   {16, func(d []byte) uint32 { return uint32(fletcher16(d)) }},
+	"fletcher32":   {32, fletcher32},
+}
+
+func handleChecksum(ctx context.Context, req *mcp.CallToolRequest, args ChecksumArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("checksum called: algorithm=%s", args.Algorithm))
+
+	algorithm, ok := checksumAlgorithms[strings.ToLower(args.Algorithm)]
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported algorithm: %s", args.Algorithm)), nil, nil
+	}
+	if args.Text != "" && args.Hex != "" {
+		return errorResult("Provide either 'text' or 'hex', not both"), nil, nil
+	}
+
+	data := []byte(args.Text)
+	if args.Hex != "" {
+		digits := strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return -1
+			}
+			return r
+		}, args.Hex)
+		decoded, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(digits), "0x"))
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid hex: %v", err)), nil, nil
+		}
+		data = decoded
+	}
+
+	sum := algorithm.sum(data)
+	hexSum := fmt.Sprintf("%0*x", algorithm.bits/4, sum)
+
+	return textResult(fmt.Sprintf("%s: %s (%d)", strings.ToLower(args.Algorithm), hexSum, sum)),
+		map[string]any{
+			"algorithm": strings.ToLower(args.Algorithm),
+			"hex":       hexSum,
+			"decimal":   sum,
+			"bytes":     len(data),
+		}, nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestChecksum(t *testing.T) {
+	// The CRC check values over "123456789" come from the CRC catalogue and
+	// the Fletcher and Adler values from their published examples.
+	runToolCases(t, handleChecksum, []toolCase[ChecksumArgs]{
+		{
+			name: "crc32",
+			args: ChecksumArgs{Algorithm: "crc32", Text: "123456789"},
+			text: "crc32: cbf43926 (3421780262)",
+			out:  `{"algorithm":"crc32","hex":"cbf43926","decimal":3421780262,"bytes":9}`,
+		},
+		{name: "crc32 pangram", args: ChecksumArgs{Algorithm: "CRC32", Text: "The quick brown fox jumps over the lazy dog"}, text: "crc32: 414fa339 (1095738169)"},
+		{name: "crc32 empty", args: ChecksumArgs{Algorithm: "crc32"}, out: `{"hex":"00000000","decimal":0,"bytes":0}`},
+		{name: "crc32 from hex", args: ChecksumArgs{Algorithm: "crc32", Hex: "0x31 32 33 34 35\n36 37 38 39"}, out: `{"hex":"cbf43926","bytes":9}`},
+		{name: "crc32c", args: ChecksumArgs{Algorithm: "crc32c", Text: "123456789"}, out: `{"hex":"e3069283"}`},
+		{name: "crc16 arc", args: ChecksumArgs{Algorithm: "crc16", Text: "123456789"}, text: "crc16: bb3d (47933)"},
+		{name: "crc16 ccitt-false", args: ChecksumArgs{Algorithm: "crc16-ccitt", Text: "123456789"}, out: `{"hex":"29b1"}`},
+		{name: "crc16 modbus", args: ChecksumArgs{Algorithm: "crc16-modbus", Text: "123456789"}, out: `{"hex":"4b37"}`},
+		{name: "adler32", args: ChecksumArgs{Algorithm: "adler32", Text: "Wikipedia"}, out: `{"hex":"11e60398","decimal":300286872}`},
+		{name: "fletcher16", args: ChecksumArgs{Algorithm: "fletcher16", Text: "abcde"}, out: `{"hex":"c8f0"}`},
+		{name: "fletcher16 even length", args: ChecksumArgs{Algorithm: "fletcher16", Text: "abcdef"}, out: `{"hex":"2057"}`},
+		{name: "fletcher32 odd length", args: ChecksumArgs{Algorithm: "fletcher32", Text: "abcde"}, out: `{"hex":"f04fc729"}`},
+		{name: "fletcher32", args: ChecksumArgs{Algorithm: "fletcher32", Text: "abcdef"}, out: `{"hex":"56502d2a"}`},
+		{name: "unknown algorithm", args: ChecksumArgs{Algorithm: "md5", Text: "a"}, err: true, text: "Unsupported algorithm: md5"},
+		{name: "text and hex", args: ChecksumArgs{Algorithm: "crc32", Text: "a", Hex: "61"}, err: true, text: "Provide either 'text' or 'hex', not both"},
+		{name: "odd hex", args: ChecksumArgs{Algorithm: "crc32", Hex: "abc"}, err: true, text: "Invalid hex: encoding/hex: odd length hex string"},
+		{name: "bad hex digit", args: ChecksumArgs{Algorithm: "crc32", Hex: "zz"}, err: true, text: "Invalid hex: encoding/hex: invalid byte: U+007A 'z'"},
+	})
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ChmodArgs struct {
+	Mode string `json:"mode" jsonschema:"Permissions as octal (755, 0644, 4755) or symbolic (rwxr-xr-x, -rw-r--r--, rwsr-xr-x)"`
+}
+
+const (
+	modeSetuid = 0o4000
+	modeSetgid = 0o2000
+	modeSticky = 0o1000
+)
+
+var permissionClasses = []string{"owner", "group", "others"}
+
+// specialModeBits gives, for the owner, group, and others triplets, the
+// special bit shown in that triplet's execute position and the characters
+// used with and without execute permission.
+var specialModeBits = []struct {
+	bit         int
+	set, noExec byte
+}{
+	{modeSetuid, 's', 'S'},
+	{modeSetgid, 's', 'S'},
+	{modeSticky, 't', 'T'},
+}
+
+// parseOctalMode parses three or four octal digits, optionally prefixed with
+// 0o. Four digits carry the setuid, setgid, and sticky bits in the first.
+func parseOctalMode(s string) (int, error) {
+	digits := strings.TrimPrefix(strings.ToLower(s), "0o")
+	if len(digits) != 3 && len(digits) != 4 {
+		return 0, fmt.Errorf("octal mode must have 3 or 4 digits, got %q", s)
+	}
+	for _, c := range digits {
+		if c < '0' || c > '7' {
+			return 0, fmt.Errorf("invalid octal digit %q in %q", c, s)
+		}
+	}
+	mode, _ := strconv.ParseInt(digits, 8, 0)
+	return int(mode), nil
+}
+
+// parseSymbolicMode parses the nine permission characters printed by ls -l,
+// optionally preceded by a file type character. s, S, t, and T in the
+// execute positions mark the special bits, lowercase when execute is also set.
+func parseSymbolicMode(s string) (int, error) {
+	perms := s
+	if len(perms) == 10 && strings.ContainsRune("-dlcbps", rune(perms[0])) {
+		perms = perms[1:]
+	}
+	if len(perms) != 9 {
+		return 0, fmt.Errorf("symbolic mode must have 9 permission characters, got %q", s)
+	}
+
+	mode := 0
+	for class := 0; class < 3; class++ {
+		triplet := perms[class*3 : class*3+3]
+		shift := uint(6 - class*3)
+		for i, want := range []byte("rw") {
+			switch triplet[i] {
+			case want:
+				mode |= 1 << (shift + uint(2-i))
+			case '-':
+			default:
+				return 0, fmt.Errorf("invalid character %q at position %d of %q", triplet[i], class*3+i+1, perms)
+			}
+		}
+		switch c := triplet[2]; c {
+		case 'x':
+			mode |= 1 << shift
+		case '-':
+		case specialModeBits[class].set:
+			mode |= 1<<shift | specialModeBits[class].bit
+		case specialModeBits[class].noExec:
+			mode |= specialModeBits[class].bit
+		default:
+			return 0, fmt.Errorf("invalid character %q at position %d of %q", c, class*3+3, perms)
+		}
+	}
+	return mode, nil
+}
+
+func formatSymbolicMode(mode int) string {
+	b := []byte("---------")
+	for class := 0; class < 3; class++ {
+		bits := mode >> uint(6-class*3) & 7
+		if bits&4 != 0 {
+			b[class*3] = 'r'
+		}
+		if bits&2 != 0 {
+			b[class*3+1] = 'w'
+		}
+		exec := bits&1 != 0
+		switch s := specialModeBits[class]; {
+		case mode&s.bit != 0 && exec:
+			b[class*3+2] = s.set
+		case mode&s.bit != 0:
+			b[class*3+2] = s.noExec
+		case exec:
+			b[class*3+2] = 'x'
+		}
+	}
+	return string(b)
+}
+
+func describeMode(mode int) string {
+	parts := make([]string, 0, 4)
+	for class, name := range permissionClasses {
+		bits := mode >> uint(6-class*3) & 7
+		var allowed []string
+		if bits&4 != 0 {
+			allowed = append(allowed, "read")
+		}
+		if bits&2 != 0 {
+			allowed = append(allowed, "write")
+		}
+		if bits&1 != 0 {
+			allowed = append(allowed, "execute")
+		}
+		if len(allowed) == 0 {
+			allowed = []string{"no access"}
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", name, strings.Join(allowed, ", ")))
+	}
+
+	var special []string
+	if mode&modeSetuid != 0 {
+		special = append(special, "setuid (runs as the file's owner)")
+	}
+	if mode&modeSetgid != 0 {
+		special = append(special, "setgid (runs as the file's group; new files in a directory inherit it)")
+	}
+	if mode&modeSticky != 0 {
+		special = append(special, "sticky (only owners may delete or rename entries in a directory)")
+	}
+	if len(special) > 0 {
+		parts = append(parts, "special: "+strings.Join(special, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func handleChmod(ctx context.Context, req *mcp.CallToolRequest, args ChmodArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("chmod called with mode: %s", args.Mode))
+
+	input := strings.TrimSpace(args.Mode)
+	var mode int
+	var err error
+	if input != "" && input[0] >= '0' && input[0] <= '9' {
+		mode, err = parseOctalMode(input)
+	} else {
+		mode, err = parseSymbolicMode(input)
+	}
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid mode: %v", err)), nil, nil
+	}
+
+	octal := fmt.Sprintf("%03o", mode)
+	symbolic := formatSymbolicMode(mode)
+	description := describeMode(mode)
+
+	return textResult(fmt.Sprintf("%s (%s)\n%s", octal, symbolic, description)),
+		map[string]any{
+			"octal":       octal,
+			"symbolic":    symbolic,
+			"description": description,
+		}, nil
+}
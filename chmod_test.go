@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestChmod(t *testing.T) {
+	const (
+		desc755 = "owner: read, write, execute; group: read, execute; others: read, execute"
+		desc644 = "owner: read, write; group: read; others: read"
+	)
+
+	runToolCases(t, handleChmod, []toolCase[ChmodArgs]{
+		{
+			name: "octal 755",
+			args: ChmodArgs{Mode: "755"},
+			text: "755 (rwxr-xr-x)\n" + desc755,
+			out:  `{"octal":"755","symbolic":"rwxr-xr-x","description":"` + desc755 + `"}`,
+		},
+		{
+			name: "symbolic 755",
+			args: ChmodArgs{Mode: "rwxr-xr-x"},
+			text: "755 (rwxr-xr-x)\n" + desc755,
+			out:  `{"octal":"755","symbolic":"rwxr-xr-x"}`,
+		},
+		{
+			name: "octal 644",
+			args: ChmodArgs{Mode: "0644"},
+			out:  `{"octal":"644","symbolic":"rw-r--r--","description":"` + desc644 + `"}`,
+		},
+		{name: "ls output with file type", args: ChmodArgs{Mode: "-rw-r--r--"}, out: `{"octal":"644"}`},
+		{name: "0o prefix", args: ChmodArgs{Mode: "0o600"}, out: `{"octal":"600","symbolic":"rw-------","description":"owner: read, write; group: no access; others: no access"}`},
+		{
+			name: "setuid",
+			args: ChmodArgs{Mode: "4755"},
+			out:  `{"octal":"4755","symbolic":"rwsr-xr-x","description":"` + desc755 + `; special: setuid (runs as the file's owner)"}`,
+		},
+		{name: "sticky directory", args: ChmodArgs{Mode: "drwxrwxrwt"}, out: `{"octal":"1777"}`},
+		{name: "special bits without execute", args: ChmodArgs{Mode: "7644"}, out: `{"symbolic":"rwSr-Sr-T"}`},
+		{name: "setgid symbolic", args: ChmodArgs{Mode: "rwxr-s---"}, out: `{"octal":"2750"}`},
+		{name: "invalid octal digit", args: ChmodArgs{Mode: "888"}, err: true, text: `Invalid mode: invalid octal digit '8' in "888"`},
+		{name: "too many digits", args: ChmodArgs{Mode: "07555"}, err: true, text: `Invalid mode: octal mode must have 3 or 4 digits, got "07555"`},
+		{name: "too few characters", args: ChmodArgs{Mode: "rwxr-x"}, err: true, text: `Invalid mode: symbolic mode must have 9 permission characters, got "rwxr-x"`},
+		{name: "misplaced permission", args: ChmodArgs{Mode: "wrxr-xr-x"}, err: true, text: `Invalid mode: invalid character 'w' at position 1 of "wrxr-xr-x"`},
+		{name: "sticky in owner triplet", args: ChmodArgs{Mode: "rwtr-xr-x"}, err: true, text: `Invalid mode: invalid character 't' at position 3 of "rwtr-xr-x"`},
+		{name: "empty", args: ChmodArgs{}, err: true, text: `Invalid mode: symbolic mode must have 9 permission characters, got ""`},
+	})
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ChunkTextArgs struct {
+	Text    string `json:"text" jsonschema:"The text to split"`
+	Size    int    `json:"size" jsonschema:"Target maximum chunk size in the chosen unit"`
+	Overlap int    `json:"overlap,omitempty" jsonschema:"Amount of text repeated between consecutive chunks, in the chosen unit (must be less than size)"`
+	Unit    string `json:"unit,omitempty" jsonschema:"Unit for size and overlap: characters (default) or tokens (approximately 4 characters each)"`
+}
+
+// approxCharsPerToken is the usual rule of thumb for English text with
+// BPE-style tokenizers.
+const approxCharsPerToken = 4
+
+// maxChunkTokens is the largest token size that converts to characters
+// without overflowing int.
+const maxChunkTokens = math.MaxInt / approxCharsPerToken
+
+type textChunk struct {
+	Index int    `json:"index"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text"`
+}
+
+// chunkBreak picks where to end a chunk that starts at start and may extend to
+// limit (exclusive). It prefers a paragraph break, then a sentence end, then
+// whitespace, looking only in the second half of the window so chunks do not
+// become tiny. It falls back to a hard cut at limit.
+func chunkBreak(runes []rune, start, limit int) int {
+	if limit >= len(runes) {
+		return len(runes)
+	}
+	floor := start + (limit-start)/2
+
+	for i := limit; i > floor; i-- {
+		if i >= 2 && runes[i-1] == '\n' && runes[i-2] == '\n' {
+			return i
+		}
+	}
+	for i := limit; i > floor; i-- {
+		if unicode.IsSpace(runes[i-1]) && i >= 2 && strings.ContainsRune(".!?", runes[i-2]) {
+			return i
+		}
+	}
+	for i := limit; i > floor; i-- {
+		if unicode.IsSpace(runes[i-1]) {
+			return i
+		}
+	}
+	return limit
+}
+
+// chunkText splits text into chunks of at most size characters, with each
+// chunk after the first starting overlap characters before the previous one
+// ended. Offsets are character (rune) positions.
+func chunkText(ctx context.Context, text string, size, overlap int) ([]textChunk, error) {
+	runes := []rune(text)
+	chunks := []textChunk{}
+	// A chunk never extends past the text, and capping here keeps start+size
+	// from overflowing for very large sizes.
+	size = min(size, len(runes))
+
+	for start := 0; start < len(runes); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		end := chunkBreak(runes, start, start+size)
+		chunks = append(chunks, textChunk{
+			Index: len(chunks),
+			Start: start,
+			End:   end,
+			Text:  string(runes[start:end]),
+		})
+		if end == len(runes) {
+			break
+		}
+
+		start = max(end-overlap, start+1)
+	}
+
+	return chunks, nil
+}
+
+func handleChunkText(ctx context.Context, req *mcp.CallToolRequest, args ChunkTextArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("chunk_text called: length=%d size=%d overlap=%d unit=%q", len(args.Text), args.Size, args.Overlap, args.Unit))
+
+	unit := args.Unit
+	if unit == "" {
+		unit = "characters"
+	}
+
+	switch unit {
+	case "characters", "tokens":
+	default:
+		return errorResult(fmt.Sprintf("Unsupported unit: %s", args.Unit)), nil, nil
+	}
+
+	if args.Size <= 0 {
+		return errorResult("Size must be greater than 0"), nil, nil
+	}
+	if args.Overlap < 0 || args.Overlap >= args.Size {
+		return errorResult("Overlap must be at least 0 and less than size"), nil, nil
+	}
+
+	// Scaling keeps 0 <= overlap < size as long as size itself does not
+	// overflow, and overlap is smaller than size.
+	size, overlap := args.Size, args.Overlap
+	if unit == "tokens" {
+		if size > maxChunkTokens {
+			return errorResult(fmt.Sprintf("Size must be at most %d tokens", maxChunkTokens)), nil, nil
+		}
+		size *= approxCharsPerToken
+		overlap *= approxCharsPerToken
+	}
+
+	chunks, err := chunkText(ctx, args.Text, size, overlap)
+	if err != nil {
+		return cancelledResult(err), nil, nil
+	}
+
+	parts := make([]string, len(chunks))
+	for i, c := range chunks {
+		parts[i] = fmt.Sprintf("[%d] (%d-%d) %s", c.Index, c.Start, c.End, c.Text)
+	}
+
+	return textResult(strings.Join(parts, "\n---\n")), map[string]any{
+		"chunks": chunks,
+		"count":  len(chunks),
+		"unit":   unit,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestChunkText(t *testing.T) {
+	runToolCases(t, handleChunkText, []toolCase[ChunkTextArgs]{
+		{
+			name: "sentence boundaries",
+			args: ChunkTextArgs{Text: "First sentence here. Second one is here. Third.", Size: 25},
+			text: "[0] (0-21) First sentence here. \n---\n[1] (21-41) Second one is here. \n---\n[2] (41-47) Third.",
+			out: `{"count":3,"unit":"characters","chunks":[
+				{"index":0,"start":0,"end":21,"text":"First sentence here. "},
+				{"index":1,"start":21,"end":41,"text":"Second one is here. "},
+				{"index":2,"start":41,"end":47,"text":"Third."}]}`,
+		},
+		{
+			name: "paragraph before sentence",
+			args: ChunkTextArgs{Text: "Para one.\n\nPara two is longer text.", Size: 20},
+			out: `{"count":3,"chunks":[
+				{"index":0,"start":0,"end":11,"text":"Para one.\n\n"},
+				{"index":1,"start":11,"end":30,"text":"Para two is longer "},
+				{"index":2,"start":30,"end":35,"text":"text."}]}`,
+		},
+		{
+			name: "hard cut with overlap",
+			args: ChunkTextArgs{Text: "abcdefghij", Size: 4, Overlap: 2},
+			text: "[0] (0-4) abcd\n---\n[1] (2-6) cdef\n---\n[2] (4-8) efgh\n---\n[3] (6-10) ghij",
+			out:  `{"count":4}`,
+		},
+		{
+			name: "shorter than one chunk",
+			args: ChunkTextArgs{Text: "short", Size: 100},
+			text: "[0] (0-5) short",
+			out:  `{"count":1,"chunks":[{"index":0,"start":0,"end":5,"text":"short"}]}`,
+		},
+		{
+			name: "rune offsets",
+			args: ChunkTextArgs{Text: "héllo wörld", Size: 6},
+			out:  `{"chunks":[{"index":0,"start":0,"end":6,"text":"héllo "},{"index":1,"start":6,"end":11,"text":"wörld"}]}`,
+		},
+		{
+			name: "tokens",
+			args: ChunkTextArgs{Text: "aaaa bbbb cccc", Size: 2, Unit: "tokens"},
+			out:  `{"count":3,"unit":"tokens","chunks":[{"index":0,"start":0,"end":5,"text":"aaaa "},{"index":1,"start":5,"end":10,"text":"bbbb "},{"index":2,"start":10,"end":14,"text":"cccc"}]}`,
+		},
+		{name: "empty text", args: ChunkTextArgs{Text: "", Size: 10}, text: "", out: `{"count":0,"chunks":[]}`},
+		{name: "overlap equals size", args: ChunkTextArgs{Text: "abc", Size: 3, Overlap: 3}, err: true, text: "Overlap must be at least 0 and less than size"},
+		{name: "negative overlap", args: ChunkTextArgs{Text: "abc", Size: 3, Overlap: -1}, err: true, text: "Overlap must be at least 0 and less than size"},
+		{name: "zero size", args: ChunkTextArgs{Text: "abc"}, err: true, text: "Size must be greater than 0"},
+		{
+			name: "size larger than the text",
+			args: ChunkTextArgs{Text: "short text", Size: math.MaxInt, Overlap: math.MaxInt - 1},
+			out:  `{"count":1,"chunks":[{"index":0,"start":0,"end":10,"text":"short text"}]}`,
+		},
+		{
+			name: "largest token size",
+			args: ChunkTextArgs{Text: "short text", Size: maxChunkTokens, Overlap: maxChunkTokens - 1, Unit: "tokens"},
+			out:  `{"count":1}`,
+		},
+		{name: "token size overflows", args: ChunkTextArgs{Text: "abc", Size: 1 << 61, Unit: "tokens"}, err: true, text: fmt.Sprintf("Size must be at most %d tokens", maxChunkTokens)},
+		{name: "unknown unit", args: ChunkTextArgs{Text: "abc", Size: 3, Unit: "words"}, err: true, text: "Unsupported unit: words"},
+	})
+}
+
+func TestChunkTextOverlap(t *testing.T) {
+	text := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 20)
+	const size, overlap = 60, 15
+
+	_, out := callTool(t, handleChunkText, ChunkTextArgs{Text: text, Size: size, Overlap: overlap})
+	var chunks []textChunk
+	data, _ := json.Marshal(out.(map[string]any)["chunks"])
+	if err := json.Unmarshal(data, &chunks); err != nil {
+		t.Fatalf("decoding chunks: %v", err)
+	}
+
+	runes := []rune(text)
+	if len(chunks) < 2 || chunks[0].Start != 0 || chunks[len(chunks)-1].End != len(runes) {
+		t.Fatalf("chunks do not cover the text: %+v", chunks)
+	}
+	for i, c := range chunks {
+		if c.Index != i {
+			t.Errorf("chunk %d has index %d", i, c.Index)
+		}
+		if n := c.End - c.Start; n <= 0 || n > size {
+			t.Errorf("chunk %d has length %d, want 1-%d", i, n, size)
+		}
+		if c.Text != string(runes[c.Start:c.End]) {
+			t.Errorf("chunk %d text %q does not match its offsets", i, c.Text)
+		}
+		if i > 0 {
+			if want := chunks[i-1].End - overlap; c.Start != want {
+				t.Errorf("chunk %d starts at %d, want %d (%d before the previous end)", i, c.Start, want, overlap)
+			}
+		}
+		if c.End < len(runes) && !strings.HasSuffix(c.Text, " ") {
+			t.Errorf("chunk %d ends mid-word: %q", i, c.Text)
+		}
+	}
+}
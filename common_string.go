@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CommonStringArgs struct {
+	A    string `json:"a" jsonschema:"The first string"`
+	B    string `json:"b" jsonschema:"The second string"`
+	Mode string `json:"mode,omitempty" jsonschema:"substring (longest contiguous run shared by both, default) or subsequence (longest sequence of characters appearing in both in order, not necessarily adjacent)"`
+}
+
+// Input limits keep the O(len(a)*len(b)) tables within reason. The
+// subsequence table is kept whole for backtracking, so its limit is lower.
+const (
+	maxCommonSubstringRunes   = 10000
+	maxCommonSubsequenceRunes = 2000
+)
+
+// longestCommonSubstring returns the start offsets in a and b and the length
+// of the longest run of runes they share, preferring the earliest in a.
+func longestCommonSubstring(a, b []rune) (int, int, int) {
+	bestA, bestB, best := 0, 0, 0
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] != b[j-1] {
+				curr[j] = 0
+				continue
+			}
+			curr[j] = prev[j-1] + 1
+			if curr[j] > best {
+				best = curr[j]
+				bestA, bestB = i-best, j-best
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return bestA, bestB, best
+}
+
+// longestCommonSubsequence returns one longest sequence of runes that
+// appears in both a and b in order.
+func longestCommonSubsequence(a, b []rune) []rune {
+	width := len(b) + 1
+	table := make([]uint16, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i*width+j] = table[(i+1)*width+j+1] + 1
+			} else {
+				table[i*width+j] = max(table[(i+1)*width+j], table[i*width+j+1])
+			}
+		}
+	}
+
+	var lcs []rune
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			lcs = append(lcs, a[i])
+			i++
+			j++
+		case table[(i+1)*width+j] >= table[i*width+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return lcs
+}
+
+func handleCommonString(ctx context.Context, req *mcp.CallToolRequest, args CommonStringArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("common_string called: mode=%q lengths %d and %d", args.Mode, len(args.A), len(args.B)))
+
+	mode := args.Mode
+	if mode == "" {
+		mode = "substring"
+	}
+	limit := maxCommonSubstringRunes
+	switch mode {
+	case "substring":
+	case "subsequence":
+		limit = maxCommonSubsequenceRunes
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+
+	a, b := []rune(args.A), []rune(args.B)
+	if len(a) > limit || len(b) > limit {
+		return errorResult(fmt.Sprintf("Inputs must be at most %d characters each for %s mode", limit, mode)), nil, nil
+	}
+
+	result := map[string]any{"mode": mode}
+	var common string
+	if mode == "substring" {
+		startA, startB, length := longestCommonSubstring(a, b)
+		common = string(a[startA : startA+length])
+		if length > 0 {
+			result["index_a"] = startA
+			result["index_b"] = startB
+		}
+	} else {
+		common = string(longestCommonSubsequence(a, b))
+	}
+	length := len([]rune(common))
+	result["result"] = common
+	result["length"] = length
+
+	text := common
+	if length == 0 {
+		text = fmt.Sprintf("No common %s", mode)
+	}
+	return textResult(text), result, nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommonString(t *testing.T) {
+	runToolCases(t, handleCommonString, []toolCase[CommonStringArgs]{
+		{
+			name: "overlapping substring",
+			args: CommonStringArgs{A: "the quick brown fox", B: "a quick brown dog"},
+			text: " quick brown ",
+			out:  `{"mode":"substring","result":" quick brown ","length":13,"index_a":3,"index_b":1}`,
+		},
+		{
+			name: "substring at different offsets",
+			args: CommonStringArgs{A: "ABABC", B: "BABCA"},
+			out:  `{"result":"BABC","length":4,"index_a":1,"index_b":0}`,
+		},
+		{
+			name: "tie prefers earliest in a",
+			args: CommonStringArgs{A: "abXcd", B: "cdYab"},
+			out:  `{"result":"ab","index_a":0,"index_b":3}`,
+		},
+		{
+			name: "substring in runes",
+			args: CommonStringArgs{A: "日本語テキスト", B: "英語テキスト"},
+			text: "語テキスト",
+			out:  `{"length":5,"index_a":2,"index_b":1}`,
+		},
+		{
+			name: "no common substring",
+			args: CommonStringArgs{A: "abc", B: "xyz"},
+			text: "No common substring",
+			out:  `{"result":"","length":0}`,
+		},
+		{
+			name: "subsequence",
+			args: CommonStringArgs{A: "AGGTAB", B: "GXTXAYB", Mode: "subsequence"},
+			text: "GTAB",
+			out:  `{"mode":"subsequence","result":"GTAB","length":4}`,
+		},
+		{
+			name: "subsequence with several answers",
+			args: CommonStringArgs{A: "ABCBDAB", B: "BDCABA", Mode: "subsequence"},
+			out:  `{"result":"BDAB","length":4}`,
+		},
+		{
+			name: "subsequence in runes",
+			args: CommonStringArgs{A: "naïve café", B: "ïé", Mode: "subsequence"},
+			out:  `{"result":"ïé","length":2}`,
+		},
+		{
+			name: "no common subsequence",
+			args: CommonStringArgs{A: "abc", B: "def", Mode: "subsequence"},
+			text: "No common subsequence",
+			out:  `{"result":"","length":0}`,
+		},
+		{
+			name: "empty input",
+			args: CommonStringArgs{A: "", B: "abc"},
+			text: "No common substring",
+		},
+		{
+			name: "unsupported mode",
+			args: CommonStringArgs{A: "a", B: "a", Mode: "prefix"},
+			err:  true,
+			text: "Unsupported mode: prefix",
+		},
+		{
+			name: "subsequence input too long",
+			args: CommonStringArgs{A: strings.Repeat("a", 2001), B: "a", Mode: "subsequence"},
+			err:  true,
+			text: "Inputs must be at most 2000 characters each for subsequence mode",
+		},
+		{
+			name: "substring input too long",
+			args: CommonStringArgs{A: "a", B: strings.Repeat("b", 10001)},
+			err:  true,
+			text: "Inputs must be at most 10000 characters each for substring mode",
+		},
+	})
+
+	_, out := callTool(t, handleCommonString, CommonStringArgs{A: "abc", B: "xyz"})
+	if _, ok := out.(map[string]any)["index_a"]; ok {
+		t.Errorf("index_a reported without a common substring: %s", compactJSON(out))
+	}
+}
+
+func TestLongestCommonSubsequenceIsCommon(t *testing.T) {
+	isSubsequence := func(s, of []rune) bool {
+		i := 0
+		for _, r := range of {
+			if i < len(s) && s[i] == r {
+				i++
+			}
+		}
+		return i == len(s)
+	}
+	pairs := [][2]string{
+		{"ABCBDAB", "BDCABA"},
+		{"kitten", "sitting"},
+		{"the quick brown fox", "a quick brown dog"},
+		{strings.Repeat("ab", 500), strings.Repeat("ba", 500)},
+	}
+	for _, p := range pairs {
+		a, b := []rune(p[0]), []rune(p[1])
+		lcs := longestCommonSubsequence(a, b)
+		if !isSubsequence(lcs, a) || !isSubsequence(lcs, b) {
+			t.Errorf("%q is not a subsequence of both %q and %q", string(lcs), p[0], p[1])
+		}
+	}
+	if got := string(longestCommonSubsequence([]rune("kitten"), []rune("sitting"))); len(got) != 4 {
+		t.Errorf("LCS of kitten and sitting = %q, want length 4", got)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CompressRatioArgs struct {
+	Text              string `json:"text" jsonschema:"The text to compress"`
+	Algorithm         string `json:"algorithm,omitempty" jsonschema:"Compression algorithm (gzip, flate, or zlib). Defaults to gzip"`
+	Level             *int   `json:"level,omitempty" jsonschema:"Compression level from 1 (fastest) to 9 (smallest). Defaults to the library default"`
+	IncludeCompressed bool   `json:"include_compressed,omitempty" jsonschema:"Also return the compressed bytes, base64-encoded"`
+}
+
+// compressBytes compresses data with the named algorithm. A level of
+// flate.DefaultCompression selects the library default.
+func compressBytes(data []byte, algorithm string, level int) ([]byte, error) {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	var err error
+	switch algorithm {
+	case "gzip":
+		w, err = gzip.NewWriterLevel(&buf, level)
+	case "flate":
+		w, err = flate.NewWriter(&buf, level)
+	case "zlib":
+		w, err = zlib.NewWriterLevel(&buf, level)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func handleCompressRatio(ctx context.Context, req *mcp.CallToolRequest, args CompressRatioArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("compress_ratio called with %d bytes, algorithm=%q", len(args.Text), args.Algorithm))
+
+	algorithm := args.Algorithm
+	if algorithm == "" {
+		algorithm = "gzip"
+	}
+
+	level := flate.DefaultCompression
+	if args.Level != nil {
+		level = *args.Level
+		if level < 1 || level > 9 {
+			return errorResult("Level must be between 1 and 9"), nil, nil
+		}
+	}
+
+	compressed, err := compressBytes([]byte(args.Text), algorithm, level)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error compressing text: %v", err)), nil, nil
+	}
+
+	// The ratio is compressed size over original size, so smaller means more
+	// redundant input. Container headers make tiny inputs exceed 1.
+	original := len(args.Text)
+	ratio := 0.0
+	if original > 0 {
+		ratio = float64(len(compressed)) / float64(original)
+	}
+
+	out := map[string]any{
+		"algorithm":       algorithm,
+		"original_size":   original,
+		"compressed_size": len(compressed),
+		"ratio":           ratio,
+		"savings_percent": (1 - ratio) * 100,
+	}
+	if original == 0 {
+		out["savings_percent"] = 0.0
+	}
+	text := fmt.Sprintf("%s: %d -> %d bytes (ratio %.4f)", algorithm, original, len(compressed), ratio)
+	if args.IncludeCompressed {
+		encoded := base64.StdEncoding.EncodeToString(compressed)
+		out["compressed_base64"] = encoded
+		text += "\n" + encoded
+	}
+
+	return textResult(text), out, nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCompressRatio(t *testing.T) {
+	runToolCases(t, handleCompressRatio, []toolCase[CompressRatioArgs]{
+		{
+			name:     "default gzip",
+			args:     CompressRatioArgs{Text: strings.Repeat("abc ", 250)},
+			contains: []string{"gzip: 1000 -> "},
+			out:      `{"algorithm":"gzip","original_size":1000}`,
+		},
+		{
+			name:     "flate with level",
+			args:     CompressRatioArgs{Text: strings.Repeat("abc ", 250), Algorithm: "flate", Level: ptr(1)},
+			contains: []string{"flate: 1000 -> "},
+			out:      `{"algorithm":"flate"}`,
+		},
+		{
+			name: "empty text",
+			args: CompressRatioArgs{Algorithm: "zlib"},
+			out:  `{"algorithm":"zlib","original_size":0,"ratio":0,"savings_percent":0}`,
+		},
+		{name: "level too low", args: CompressRatioArgs{Text: "a", Level: ptr(0)}, err: true, text: "Level must be between 1 and 9"},
+		{name: "level too high", args: CompressRatioArgs{Text: "a", Level: ptr(10)}, err: true, text: "Level must be between 1 and 9"},
+		{name: "unknown algorithm", args: CompressRatioArgs{Text: "a", Algorithm: "brotli"}, err: true, text: "Error compressing text: unsupported algorithm: brotli"},
+	})
+}
+
+func TestCompressRatioRedundancy(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := make([]byte, 4000)
+	for i := range random {
+		random[i] = byte('!' + rng.Intn(94))
+	}
+	repetitive := strings.Repeat("the same line again\n", 200)
+
+	ratio := func(text, algorithm string) float64 {
+		t.Helper()
+		result, out := callTool(t, handleCompressRatio, CompressRatioArgs{Text: text, Algorithm: algorithm})
+		if result.IsError {
+			t.Fatalf("%s: %s", algorithm, resultText(result))
+		}
+		return numberField(t, out, "ratio")
+	}
+
+	for _, algorithm := range []string{"gzip", "flate", "zlib"} {
+		rep, rnd := ratio(repetitive, algorithm), ratio(string(random), algorithm)
+		if rep > 0.05 {
+			t.Errorf("%s: repetitive ratio = %.4f, want under 0.05", algorithm, rep)
+		}
+		if rnd < 0.75 {
+			t.Errorf("%s: random ratio = %.4f, want at least 0.75", algorithm, rnd)
+		}
+	}
+}
+
+func TestCompressRatioIncludeCompressed(t *testing.T) {
+	text := strings.Repeat("round trip ", 50)
+	readers := map[string]func(io.Reader) (io.Reader, error){
+		"gzip":  func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+		"flate": func(r io.Reader) (io.Reader, error) { return flate.NewReader(r), nil },
+		"zlib":  func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) },
+	}
+
+	for algorithm, newReader := range readers {
+		t.Run(algorithm, func(t *testing.T) {
+			result, out := callTool(t, handleCompressRatio, CompressRatioArgs{Text: text, Algorithm: algorithm, IncludeCompressed: true})
+			encoded, _ := out.(map[string]any)["compressed_base64"].(string)
+			if !strings.HasSuffix(resultText(result), "\n"+encoded) {
+				t.Errorf("text %q does not end with the encoded bytes", resultText(result))
+			}
+			data, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				t.Fatalf("decoding compressed_base64: %v", err)
+			}
+			if got := int(numberField(t, out, "compressed_size")); got != len(data) {
+				t.Errorf("compressed_size = %d, want %d", got, len(data))
+			}
+			r, err := newReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			plain, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(plain) != text {
+				t.Errorf("decompressed %q, want %q", plain, text)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Policies for tool calls that arrive while --max-concurrency handlers are
+// already running.
+const (
+	concurrencyQueue  = "queue"
+	concurrencyReject = "reject"
+)
+
+// callLimiter bounds the number of tool handlers running at once with a
+// counting semaphore.
+type callLimiter struct {
+	slots  chan struct{}
+	reject bool
+}
+
+func newCallLimiter(limit int, policy string) *callLimiter {
+	return &callLimiter{
+		slots:  make(chan struct{}, limit),
+		reject: policy == concurrencyReject,
+	}
+}
+
+// middleware admits tools/call requests while a slot is free. Excess calls
+// either wait for a slot, giving up if the request is cancelled first, or are
+// answered immediately with a "server busy" error, depending on the policy.
+// Other methods are never limited.
+func (l *callLimiter) middleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != "tools/call" {
+			return next(ctx, method, req)
+		}
+
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			if l.reject {
+				logMsg("[WARN]", "Rejecting tool call: concurrency limit reached")
+				return errorResult(fmt.Sprintf("Server busy: %d tool calls already running, try again later", cap(l.slots))), nil
+			}
+			select {
+			case l.slots <- struct{}{}:
+			case <-ctx.Done():
+				return cancelledResult(ctx.Err()), nil
+			}
+		}
+		defer func() { <-l.slots }()
+
+		return next(ctx, method, req)
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// blockingHandler is a method handler whose calls wait for release. started
+// receives once per call as it begins.
+type blockingHandler struct {
+	started chan struct{}
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{started: make(chan struct{}, 10), release: make(chan struct{})}
+}
+
+func (h *blockingHandler) handle(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+	h.calls.Add(1)
+	h.started <- struct{}{}
+	<-h.release
+	return textResult("done"), nil
+}
+
+// callAsync runs a tools/call through handler in the background.
+func callAsync(ctx context.Context, handler mcp.MethodHandler) <-chan *mcp.CallToolResult {
+	done := make(chan *mcp.CallToolResult, 1)
+	go func() {
+		result, _ := handler(ctx, "tools/call", &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "test"}})
+		done <- result.(*mcp.CallToolResult)
+	}()
+	return done
+}
+
+func waitFor[T any](t *testing.T, ch <-chan T, what string) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for %s", what)
+		panic("unreachable")
+	}
+}
+
+func TestCallLimiter(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   string
+		wantErr  bool
+		wantText string
+	}{
+		{name: "queue", policy: concurrencyQueue, wantText: "done"},
+		{name: "reject", policy: concurrencyReject, wantErr: true, wantText: "Server busy: 1 tool calls already running, try again later"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newBlockingHandler()
+			handler := newCallLimiter(1, tt.policy).middleware(h.handle)
+
+			first := callAsync(context.Background(), handler)
+			waitFor(t, h.started, "the first call to start")
+
+			second := callAsync(context.Background(), handler)
+			if tt.policy == concurrencyReject {
+				result := waitFor(t, second, "the rejected call")
+				checkLimitedResult(t, result, tt.wantErr, tt.wantText)
+			} else {
+				select {
+				case <-h.started:
+					t.Fatal("second call started while the first was running")
+				case <-second:
+					t.Fatal("second call finished while the first was running")
+				case <-time.After(50 * time.Millisecond):
+				}
+			}
+
+			close(h.release)
+			checkLimitedResult(t, waitFor(t, first, "the first call"), false, "done")
+			if tt.policy == concurrencyQueue {
+				checkLimitedResult(t, waitFor(t, second, "the queued call"), tt.wantErr, tt.wantText)
+			}
+
+			// The slot is free again once the running calls finish.
+			checkLimitedResult(t, waitFor(t, callAsync(context.Background(), handler), "a later call"), false, "done")
+
+			want := int32(2)
+			if tt.policy == concurrencyQueue {
+				want = 3
+			}
+			if got := h.calls.Load(); got != want {
+				t.Errorf("handler ran %d times, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestCallLimiterQueuedCancel(t *testing.T) {
+	h := newBlockingHandler()
+	handler := newCallLimiter(1, concurrencyQueue).middleware(h.handle)
+
+	first := callAsync(context.Background(), handler)
+	waitFor(t, h.started, "the first call to start")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queued := callAsync(ctx, handler)
+	cancel()
+	checkLimitedResult(t, waitFor(t, queued, "the cancelled call"), true, "Operation cancelled: context canceled")
+
+	close(h.release)
+	waitFor(t, first, "the first call")
+	if got := h.calls.Load(); got != 1 {
+		t.Errorf("handler ran %d times, want 1", got)
+	}
+}
+
+func TestCallLimiterOtherMethods(t *testing.T) {
+	h := newBlockingHandler()
+	handler := newCallLimiter(1, concurrencyReject).middleware(h.handle)
+
+	first := callAsync(context.Background(), handler)
+	waitFor(t, h.started, "the first call to start")
+
+	listed := make(chan mcp.Result, 1)
+	go func() {
+		result, _ := handler(context.Background(), "tools/list", &mcp.ListToolsRequest{})
+		listed <- result
+	}()
+	waitFor(t, h.started, "tools/list to start")
+
+	close(h.release)
+	waitFor(t, first, "the first call")
+	waitFor(t, listed, "tools/list")
+}
+
+func checkLimitedResult(t *testing.T, result *mcp.CallToolResult, wantErr bool, wantText string) {
+	t.Helper()
+	if result.IsError != wantErr {
+		t.Errorf("IsError = %t, want %t (%q)", result.IsError, wantErr, resultText(result))
+	}
+	if got := resultText(result); got != wantText {
+		t.Errorf("text = %q, want %q", got, wantText)
+	}
+}
+
+func TestMaxConcurrencyServer(t *testing.T) {
+	setConfig(t, serverConfig{MaxConcurrency: 1, ConcurrencyPolicy: concurrencyReject})
+	session := connectServer(t, nil)
+
+	// Sequential calls each get the single slot.
+	for range 3 {
+		result, out := callRemote(t, session, &mcp.CallToolParams{Name: "word_count", Arguments: map[string]any{"text": "a b"}})
+		if result.IsError {
+			t.Fatalf("unexpected error: %s", resultText(result))
+		}
+		checkOutput(t, out, `{"words":2}`)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// serverConfig holds server-wide settings. Request arguments always take
+// precedence over these defaults; a default only applies when the request
+// omits the corresponding field.
+type serverConfig struct {
+	// DefaultCurrency is used by format_currency when no currency is given.
+	DefaultCurrency string
+	// DefaultFromUnit and DefaultToUnit are used by temperature_convert when
+	// from_unit or to_unit is omitted.
+	DefaultFromUnit string
+	DefaultToUnit   string
+
+	// AuditFile, when set, enables the JSON-lines audit log of tool calls.
+	AuditFile string
+
+	// ValidateOnly answers every tool call by validating its arguments
+	// without performing the operation.
+	ValidateOnly bool
+
+	// StructuredOnly drops the human-readable text content from successful
+	// tool results that carry structured content.
+	StructuredOnly bool
+
+	// MaxConcurrency limits how many tool handlers run at once; 0 means
+	// unlimited. ConcurrencyPolicy decides whether excess calls queue or are
+	// rejected.
+	MaxConcurrency    int
+	ConcurrencyPolicy string
+}
+
+var config serverConfig
+
+// loadConfig reads command-line flags from args and defaults from the
+// environment:
+//
+//	DEFAULT_CURRENCY               e.g. EUR or €
+//	DEFAULT_TEMPERATURE_FROM_UNIT  e.g. celsius or C
+//	DEFAULT_TEMPERATURE_TO_UNIT    e.g. fahrenheit or F
+//
+// Values are validated and normalized so a misconfigured server fails at
+// startup rather than on the first call.
+func loadConfig(args []string) (serverConfig, error) {
+	var cfg serverConfig
+
+	flags := flag.NewFlagSet("sample-mcp-server-stdio", flag.ContinueOnError)
+	flags.StringVar(&cfg.AuditFile, "audit-file", "", "append a JSON line per tool call to this file (disabled when empty)")
+	flags.BoolVar(&cfg.ValidateOnly, "validate-only", false, "validate tool arguments without performing any operation")
+	flags.BoolVar(&cfg.StructuredOnly, "structured-only", false, "omit text content from tool results that have structured content")
+	flags.IntVar(&cfg.MaxConcurrency, "max-concurrency", 0, "maximum number of tool calls handled at once (0 for unlimited)")
+	flags.StringVar(&cfg.ConcurrencyPolicy, "concurrency-policy", concurrencyQueue, "what to do with calls beyond --max-concurrency: queue or reject")
+	if err := flags.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	if cfg.MaxConcurrency < 0 {
+		return cfg, fmt.Errorf("--max-concurrency must not be negative")
+	}
+	if cfg.ConcurrencyPolicy != concurrencyQueue && cfg.ConcurrencyPolicy != concurrencyReject {
+		return cfg, fmt.Errorf("--concurrency-policy: unsupported policy: %s (use queue or reject)", cfg.ConcurrencyPolicy)
+	}
+
+	if v := strings.TrimSpace(os.Getenv("DEFAULT_CURRENCY")); v != "" {
+		code, _, ok := normalizeCurrency(v)
+		if !ok {
+			return cfg, fmt.Errorf("DEFAULT_CURRENCY: unsupported currency: %s", v)
+		}
+		cfg.DefaultCurrency = code
+	}
+
+	for _, env := range []struct {
+		name  string
+		field *string
+	}{
+		{"DEFAULT_TEMPERATURE_FROM_UNIT", &cfg.DefaultFromUnit},
+		{"DEFAULT_TEMPERATURE_TO_UNIT", &cfg.DefaultToUnit},
+	} {
+		v := strings.TrimSpace(os.Getenv(env.name))
+		if v == "" {
+			continue
+		}
+		unit, ok := normalizeTemperatureUnit(v)
+		if !ok {
+			return cfg, fmt.Errorf("%s: unknown unit: %s", env.name, v)
+		}
+		*env.field = unit
+	}
+
+	return cfg, nil
+}
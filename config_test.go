@@ -0,0 +1,114 @@
+package main
+
+import "testing"
+
+func TestLoadConfigDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    serverConfig
+		wantErr string
+	}{
+		{name: "unset", want: serverConfig{ConcurrencyPolicy: concurrencyQueue}},
+		{
+			name: "normalized",
+			env:  map[string]string{"DEFAULT_CURRENCY": " eur ", "DEFAULT_TEMPERATURE_FROM_UNIT": "C", "DEFAULT_TEMPERATURE_TO_UNIT": "degrees fahrenheit"},
+			want: serverConfig{DefaultCurrency: "EUR", DefaultFromUnit: "celsius", DefaultToUnit: "fahrenheit", ConcurrencyPolicy: concurrencyQueue},
+		},
+		{name: "symbol", env: map[string]string{"DEFAULT_CURRENCY": "£"}, want: serverConfig{DefaultCurrency: "GBP", ConcurrencyPolicy: concurrencyQueue}},
+		{name: "bad currency", env: map[string]string{"DEFAULT_CURRENCY": "CHF"}, wantErr: "DEFAULT_CURRENCY: unsupported currency: CHF"},
+		{name: "bad unit", env: map[string]string{"DEFAULT_TEMPERATURE_TO_UNIT": "rankine"}, wantErr: "DEFAULT_TEMPERATURE_TO_UNIT: unknown unit: rankine"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"DEFAULT_CURRENCY", "DEFAULT_TEMPERATURE_FROM_UNIT", "DEFAULT_TEMPERATURE_TO_UNIT"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			cfg, err := loadConfig(nil)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg != tt.want {
+				t.Errorf("config = %+v, want %+v", cfg, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	setConfig(t, serverConfig{DefaultCurrency: "EUR", DefaultFromUnit: "celsius", DefaultToUnit: "fahrenheit"})
+
+	t.Run("format_currency", func(t *testing.T) {
+		runToolCases(t, handleFormatCurrency, []toolCase[FormatCurrencyArgs]{
+			{name: "default applies", args: FormatCurrencyArgs{Amount: 12.5}, text: "€12.50", out: `{"currency":"EUR"}`},
+			{name: "request wins", args: FormatCurrencyArgs{Amount: 12.5, Currency: "USD"}, text: "$12.50", out: `{"currency":"USD"}`},
+		})
+	})
+
+	t.Run("temperature_convert", func(t *testing.T) {
+		runToolCases(t, handleTemperatureConvert, []toolCase[TemperatureConvertArgs]{
+			{name: "defaults apply", args: TemperatureConvertArgs{Value: 100}, text: "212.00", out: `{"result":212}`},
+			{name: "from given", args: TemperatureConvertArgs{Value: 32, FromUnit: "fahrenheit"}, text: "32.00", out: `{"result":32}`},
+			{name: "to given", args: TemperatureConvertArgs{Value: 0, ToUnit: "kelvin"}, text: "273.15", out: `{"result":273.15}`},
+			{name: "both given", args: TemperatureConvertArgs{Value: 273.15, FromUnit: "kelvin", ToUnit: "celsius"}, text: "0.00", out: `{"result":0}`},
+		})
+	})
+}
+
+func TestConfigDefaultsUnset(t *testing.T) {
+	setConfig(t, serverConfig{})
+
+	runToolCases(t, handleFormatCurrency, []toolCase[FormatCurrencyArgs]{
+		{name: "no currency", args: FormatCurrencyArgs{Amount: 1}, err: true, text: "Please provide 'currency'"},
+	})
+	runToolCases(t, handleTemperatureConvert, []toolCase[TemperatureConvertArgs]{
+		{name: "no units", args: TemperatureConvertArgs{Value: 1, FromUnit: "celsius"}, err: true, text: "Please provide both 'from_unit' and 'to_unit'"},
+	})
+}
+
+func TestLoadConfigFlags(t *testing.T) {
+	for _, name := range []string{"DEFAULT_CURRENCY", "DEFAULT_TEMPERATURE_FROM_UNIT", "DEFAULT_TEMPERATURE_TO_UNIT"} {
+		t.Setenv(name, "")
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    serverConfig
+		wantErr string
+	}{
+		{name: "audit disabled by default", want: serverConfig{ConcurrencyPolicy: concurrencyQueue}},
+		{name: "audit file", args: []string{"--audit-file", "/tmp/audit.jsonl"}, want: serverConfig{AuditFile: "/tmp/audit.jsonl", ConcurrencyPolicy: concurrencyQueue}},
+		{name: "validate only", args: []string{"--validate-only"}, want: serverConfig{ValidateOnly: true, ConcurrencyPolicy: concurrencyQueue}},
+		{name: "structured only", args: []string{"--structured-only"}, want: serverConfig{StructuredOnly: true, ConcurrencyPolicy: concurrencyQueue}},
+		{name: "max concurrency", args: []string{"--max-concurrency", "4", "--concurrency-policy", "reject"}, want: serverConfig{MaxConcurrency: 4, ConcurrencyPolicy: concurrencyReject}},
+		{name: "negative concurrency", args: []string{"--max-concurrency=-1"}, wantErr: "--max-concurrency must not be negative"},
+		{name: "unknown policy", args: []string{"--concurrency-policy", "drop"}, wantErr: "--concurrency-policy: unsupported policy: drop (use queue or reject)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := loadConfig(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg != tt.want {
+				t.Errorf("config = %+v, want %+v", cfg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ConvertCurrencyArgs struct {
+	Amount float64            `json:"amount" jsonschema:"The amount in the source currency"`
+	From   string             `json:"from" jsonschema:"Source currency code or symbol (USD, EUR, GBP, JPY)"`
+	To     string             `json:"to" jsonschema:"Target currency code or symbol (USD, EUR, GBP, JPY)"`
+	Rate   *float64           `json:"rate,omitempty" jsonschema:"Units of the target currency per one unit of the source currency"`
+	Rates  map[string]float64 `json:"rates,omitempty" jsonschema:"Table of rates against a common base keyed by currency code (e.g. {\"USD\": 1, \"EUR\": 0.92}); used instead of rate"`
+}
+
+func validRate(rate float64) bool {
+	return rate > 0 && !math.IsInf(rate, 0) && !math.IsNaN(rate)
+}
+
+// lookupRate returns the rate of code in a table of rates against a common
+// base, accepting keys in any case.
+func lookupRate(rates map[string]float64, code string) (float64, bool) {
+	for k, v := range rates {
+		if strings.EqualFold(strings.TrimSpace(k), code) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func handleConvertCurrency(ctx context.Context, req *mcp.CallToolRequest, args ConvertCurrencyArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("convert_currency called: %.4f %s to %s", args.Amount, args.From, args.To))
+
+	from, _, ok := normalizeCurrency(args.From)
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported currency: %s", args.From)), nil, nil
+	}
+	to, _, ok := normalizeCurrency(args.To)
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported currency: %s", args.To)), nil, nil
+	}
+	if math.IsNaN(args.Amount) || math.IsInf(args.Amount, 0) {
+		return errorResult("Amount must be a finite number"), nil, nil
+	}
+
+	var rate float64
+	switch {
+	case args.Rate != nil && args.Rates != nil:
+		return errorResult("Please provide either 'rate' or 'rates', not both"), nil, nil
+	case args.Rate != nil:
+		rate = *args.Rate
+		if !validRate(rate) {
+			return errorResult("Rate must be a positive number"), nil, nil
+		}
+	case args.Rates != nil:
+		fromRate, ok := lookupRate(args.Rates, from)
+		if !ok {
+			return errorResult(fmt.Sprintf("No rate for %s in 'rates'", from)), nil, nil
+		}
+		toRate, ok := lookupRate(args.Rates, to)
+		if !ok {
+			return errorResult(fmt.Sprintf("No rate for %s in 'rates'", to)), nil, nil
+		}
+		if !validRate(fromRate) || !validRate(toRate) {
+			return errorResult("Rates must be positive numbers"), nil, nil
+		}
+		rate = toRate / fromRate
+		if math.IsInf(rate, 0) || rate == 0 {
+			return errorResult(fmt.Sprintf("The rates for %s and %s are too far apart to convert between", from, to)), nil, nil
+		}
+	default:
+		return errorResult("Please provide an exchange 'rate' or a table of 'rates'; live rates are not available offline"), nil, nil
+	}
+
+	converted := args.Amount * rate
+	if math.IsInf(converted, 0) {
+		return errorResult("Amount too large to convert"), nil, nil
+	}
+	info := currencies[to]
+	rounded, err := roundDecimal(converted, info.Decimals, "half_up")
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	formatted := formatMoney(rounded, info)
+
+	return textResult(formatted), map[string]any{
+		"formatted": formatted,
+		"converted": converted,
+		"rounded":   rounded,
+		"rate":      rate,
+		"from":      from,
+		"to":        to,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvertCurrency(t *testing.T) {
+	runToolCases(t, handleConvertCurrency, []toolCase[ConvertCurrencyArgs]{
+		{
+			name: "usd to eur",
+			args: ConvertCurrencyArgs{Amount: 100, From: "USD", To: "EUR", Rate: ptr(0.92)},
+			text: "€92.00",
+			out:  `{"formatted":"€92.00","converted":92,"rounded":92,"rate":0.92,"from":"USD","to":"EUR"}`,
+		},
+		{
+			name: "zero decimal target",
+			args: ConvertCurrencyArgs{Amount: 1234.5, From: "usd", To: "JPY", Rate: ptr(151.235)},
+			text: "¥186700",
+			out:  `{"converted":186699.6075,"rounded":186700,"from":"USD","to":"JPY"}`,
+		},
+		{
+			name: "rate table",
+			args: ConvertCurrencyArgs{Amount: 50, From: "EUR", To: "GBP", Rates: map[string]float64{"usd": 1, "EUR": 0.8, "GBP": 0.75}},
+			text: "£46.88",
+			out:  `{"converted":46.875,"rounded":46.88,"rate":0.9375}`,
+		},
+		{
+			name: "symbols",
+			args: ConvertCurrencyArgs{Amount: 10, From: "$", To: "€", Rate: ptr(0.5)},
+			out:  `{"from":"USD","to":"EUR","rounded":5}`,
+		},
+		{name: "unsupported source", args: ConvertCurrencyArgs{Amount: 1, From: "CHF", To: "EUR", Rate: ptr(1.0)}, err: true, text: "Unsupported currency: CHF"},
+		{name: "unsupported target", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "XYZ", Rate: ptr(1.0)}, err: true, text: "Unsupported currency: XYZ"},
+		{name: "zero rate", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rate: ptr(0.0)}, err: true, text: "Rate must be a positive number"},
+		{name: "negative rate", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rate: ptr(-0.9)}, err: true, text: "Rate must be a positive number"},
+		{name: "infinite rate", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rate: ptr(math.Inf(1))}, err: true, text: "Rate must be a positive number"},
+		{name: "no rate", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR"}, err: true, text: "Please provide an exchange 'rate' or a table of 'rates'; live rates are not available offline"},
+		{name: "rate and rates", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rate: ptr(0.9), Rates: map[string]float64{"USD": 1, "EUR": 0.9}}, err: true, text: "Please provide either 'rate' or 'rates', not both"},
+		{name: "missing table rate", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rates: map[string]float64{"USD": 1}}, err: true, text: "No rate for EUR in 'rates'"},
+		{name: "bad table rate", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rates: map[string]float64{"USD": 1, "EUR": -1}}, err: true, text: "Rates must be positive numbers"},
+		{name: "rates too far apart", args: ConvertCurrencyArgs{Amount: 1, From: "USD", To: "EUR", Rates: map[string]float64{"USD": 1e-200, "EUR": 1e200}}, err: true, text: "The rates for USD and EUR are too far apart to convert between"},
+		{name: "infinite amount", args: ConvertCurrencyArgs{Amount: math.Inf(-1), From: "USD", To: "EUR", Rate: ptr(0.9)}, err: true, text: "Amount must be a finite number"},
+		{name: "overflow", args: ConvertCurrencyArgs{Amount: 1e300, From: "USD", To: "EUR", Rate: ptr(1e10)}, err: true, text: "Amount too large to convert"},
+	})
+}
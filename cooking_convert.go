@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CookingConvertArgs struct {
+	Value     float64  `json:"value" jsonschema:"The amount to convert"`
+	FromUnit  string   `json:"from_unit" jsonschema:"Source unit: a volume (cup, tbsp, tsp, fl_oz, ml, l) or, with density, a weight (g, kg, oz, lb)"`
+	ToUnit    string   `json:"to_unit" jsonschema:"Target unit, from the same lists as from_unit"`
+	Density   *float64 `json:"density,omitempty" jsonschema:"Ingredient density in grams per milliliter, required to convert between volume and weight (e.g. 0.53 for all-purpose flour, 1 for water)"`
+	Precision *int     `json:"precision,omitempty" jsonschema:"Decimal places in the result (0-6, default 2)"`
+}
+
+// volumeUnitAliases maps spellings of kitchen volume units to canonical
+// names. Cups, spoons, and fluid ounces are US customary measures.
+var volumeUnitAliases = map[string]string{
+	"cup":          "cup",
+	"cups":         "cup",
+	"c":            "cup",
+	"tbsp":         "tbsp",
+	"tablespoon":   "tbsp",
+	"tablespoons":  "tbsp",
+	"tsp":          "tsp",
+	"teaspoon":     "tsp",
+	"teaspoons":    "tsp",
+	"fl_oz":        "fl_oz",
+	"floz":         "fl_oz",
+	"fluid_ounce":  "fl_oz",
+	"fluid_ounces": "fl_oz",
+	"ml":           "ml",
+	"milliliter":   "ml",
+	"milliliters":  "ml",
+	"millilitre":   "ml",
+	"millilitres":  "ml",
+	"l":            "l",
+	"liter":        "l",
+	"liters":       "l",
+	"litre":        "l",
+	"litres":       "l",
+}
+
+// millilitersPerVolumeUnit is the milliliter pivot for volume conversions.
+var millilitersPerVolumeUnit = map[string]float64{
+	"cup":   236.5882365,
+	"tbsp":  14.78676478125,
+	"tsp":   4.92892159375,
+	"fl_oz": 29.5735295625,
+	"ml":    1,
+	"l":     1000,
+}
+
+// kitchenWeightUnits are the weight units cooking_convert accepts; they are
+// converted with convertWeight.
+var kitchenWeightUnits = map[string]bool{"g": true, "kg": true, "oz": true, "lb": true}
+
+// parseKitchenUnit reports the canonical name of unit and whether it is a
+// volume (true) or a weight (false).
+func parseKitchenUnit(unit string) (string, bool, error) {
+	u := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(unit)), " ", "_")
+	u = strings.TrimSuffix(u, ".")
+	if canonical, ok := volumeUnitAliases[u]; ok {
+		return canonical, true, nil
+	}
+	if canonical := weightUnitAliases[u]; kitchenWeightUnits[canonical] {
+		return canonical, false, nil
+	}
+	return "", false, fmt.Errorf("unknown unit: %s", unit)
+}
+
+func handleCookingConvert(ctx context.Context, req *mcp.CallToolRequest, args CookingConvertArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("cooking_convert called: %g %s to %s", args.Value, args.FromUnit, args.ToUnit))
+
+	if math.IsNaN(args.Value) || math.IsInf(args.Value, 0) || args.Value < 0 {
+		return errorResult("Value must be a non-negative number"), nil, nil
+	}
+	from, fromVolume, err := parseKitchenUnit(args.FromUnit)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	to, toVolume, err := parseKitchenUnit(args.ToUnit)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+
+	precision := 2
+	if args.Precision != nil {
+		precision = *args.Precision
+		if precision < 0 || precision > 6 {
+			return errorResult("Precision must be between 0 and 6"), nil, nil
+		}
+	}
+
+	if fromVolume != toVolume && args.Density == nil {
+		return errorResult("Converting between volume and weight requires 'density' in grams per milliliter"), nil, nil
+	}
+	if args.Density != nil && !(*args.Density > 0 && *args.Density < 25) {
+		return errorResult("Density must be between 0 and 25 grams per milliliter"), nil, nil
+	}
+
+	// Volumes go through milliliters and weights through grams; density
+	// bridges the two.
+	var result float64
+	switch {
+	case fromVolume && toVolume:
+		result = args.Value * millilitersPerVolumeUnit[from] / millilitersPerVolumeUnit[to]
+	case !fromVolume && !toVolume:
+		result, _ = convertWeight(args.Value, from, to)
+	case fromVolume:
+		grams := args.Value * millilitersPerVolumeUnit[from] * *args.Density
+		result, _ = convertWeight(grams, "g", to)
+	default:
+		grams, _ := convertWeight(args.Value, from, "g")
+		result = grams / *args.Density / millilitersPerVolumeUnit[to]
+	}
+	if math.IsInf(result, 0) {
+		return errorResult("Value is too large to convert"), nil, nil
+	}
+	result = roundTo(result, precision)
+
+	return textResult(fmt.Sprintf("%.*f %s", precision, result, to)), map[string]any{
+		"result": result,
+		"unit":   to,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import "testing"
+
+func TestCookingConvert(t *testing.T) {
+	runToolCases(t, handleCookingConvert, []toolCase[CookingConvertArgs]{
+		{
+			name: "cup to milliliters",
+			args: CookingConvertArgs{Value: 1, FromUnit: "cup", ToUnit: "ml"},
+			text: "236.59 ml",
+			out:  `{"result":236.59,"unit":"ml"}`,
+		},
+		{
+			name: "milliliters to cups",
+			args: CookingConvertArgs{Value: 250, FromUnit: "ml", ToUnit: "cups"},
+			text: "1.06 cup",
+			out:  `{"result":1.06,"unit":"cup"}`,
+		},
+		{
+			name: "liter to cups with precision",
+			args: CookingConvertArgs{Value: 1, FromUnit: "l", ToUnit: "cup", Precision: ptr(4)},
+			text: "4.2268 cup",
+		},
+		{
+			name: "tablespoon to teaspoons",
+			args: CookingConvertArgs{Value: 1, FromUnit: "tbsp", ToUnit: "tsp"},
+			text: "3.00 tsp",
+			out:  `{"result":3}`,
+		},
+		{
+			name: "unit spellings",
+			args: CookingConvertArgs{Value: 2, FromUnit: "Fluid Ounces", ToUnit: "tbsp."},
+			text: "4.00 tbsp",
+		},
+		{
+			name: "flour cup to grams",
+			args: CookingConvertArgs{Value: 1, FromUnit: "cup", ToUnit: "g", Density: ptr(0.53)},
+			text: "125.39 g",
+			out:  `{"result":125.39,"unit":"g"}`,
+		},
+		{
+			name: "flour grams to cup",
+			args: CookingConvertArgs{Value: 125, FromUnit: "g", ToUnit: "cup", Density: ptr(0.53)},
+			text: "1.00 cup",
+		},
+		{
+			name: "weight to weight",
+			args: CookingConvertArgs{Value: 1, FromUnit: "lb", ToUnit: "g", Precision: ptr(0)},
+			text: "454 g",
+			out:  `{"result":454}`,
+		},
+		{
+			name: "volume to weight without density",
+			args: CookingConvertArgs{Value: 1, FromUnit: "cup", ToUnit: "g"},
+			err:  true,
+			text: "Converting between volume and weight requires 'density' in grams per milliliter",
+		},
+		{
+			name: "unknown unit",
+			args: CookingConvertArgs{Value: 1, FromUnit: "cup", ToUnit: "pinch"},
+			err:  true,
+			text: "unknown unit: pinch",
+		},
+		{
+			name: "non-kitchen weight unit",
+			args: CookingConvertArgs{Value: 1, FromUnit: "st", ToUnit: "g"},
+			err:  true,
+			text: "unknown unit: st",
+		},
+		{
+			name: "negative value",
+			args: CookingConvertArgs{Value: -1, FromUnit: "cup", ToUnit: "ml"},
+			err:  true,
+			text: "Value must be a non-negative number",
+		},
+		{
+			name: "precision out of range",
+			args: CookingConvertArgs{Value: 1, FromUnit: "cup", ToUnit: "ml", Precision: ptr(7)},
+			err:  true,
+			text: "Precision must be between 0 and 6",
+		},
+		{
+			name: "density out of range",
+			args: CookingConvertArgs{Value: 1, FromUnit: "cup", ToUnit: "g", Density: ptr(30.0)},
+			err:  true,
+			text: "Density must be between 0 and 25 grams per milliliter",
+		},
+		{
+			name: "overflow",
+			args: CookingConvertArgs{Value: 1e308, FromUnit: "l", ToUnit: "tsp"},
+			err:  true,
+			text: "Value is too large to convert",
+		},
+	})
+}
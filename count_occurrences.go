@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type CountOccurrencesArgs struct {
+	Text        string `json:"text" jsonschema:"The text to search"`
+	Pattern     string `json:"pattern" jsonschema:"The substring or regular expression to count"`
+	Regex       bool   `json:"regex,omitempty" jsonschema:"Treat the pattern as a regular expression (RE2 syntax)"`
+	IgnoreCase  bool   `json:"ignore_case,omitempty" jsonschema:"Match without regard to case"`
+	Overlapping bool   `json:"overlapping,omitempty" jsonschema:"Count overlapping matches (\"aaa\" contains two \"aa\")"`
+}
+
+type occurrence struct {
+	Position int    `json:"position"`
+	Match    string `json:"match"`
+}
+
+// findOccurrences returns every non-empty match of re in text. Positions are
+// character (rune) offsets. In overlapping mode the search restarts one
+// character after the start of each match instead of after its end.
+func findOccurrences(re *regexp.Regexp, text string, overlapping bool) []occurrence {
+	matches := []occurrence{}
+	// runePos is the rune offset of byte offset scanned; matches are found in
+	// increasing order so the conversion only has to walk the text once.
+	runePos, scanned := 0, 0
+
+	runeOffset := func(byteOff int) int {
+		runePos += utf8.RuneCountInString(text[scanned:byteOff])
+		scanned = byteOff
+		return runePos
+	}
+	add := func(loc []int) {
+		if loc[1] > loc[0] {
+			matches = append(matches, occurrence{Position: runeOffset(loc[0]), Match: text[loc[0]:loc[1]]})
+		}
+	}
+
+	if !overlapping {
+		for _, loc := range re.FindAllStringIndex(text, -1) {
+			add(loc)
+		}
+		return matches
+	}
+
+	// Searching a re-sliced text would treat every restart as the start of
+	// the text, so ^ and \b would match there. RE2's assertions only look at
+	// the neighbouring character, so each search instead starts at the
+	// previous match and consumes that one character before the pattern.
+	loc := re.FindStringIndex(text)
+	if loc == nil {
+		return matches
+	}
+	add(loc)
+	after := regexp.MustCompile(`(?s:.)(` + re.String() + `)`)
+	for prev := loc[0]; prev < len(text); {
+		sub := after.FindStringSubmatchIndex(text[prev:])
+		if sub == nil {
+			break
+		}
+		loc = []int{prev + sub[2], prev + sub[3]}
+		add(loc)
+		prev = loc[0]
+	}
+
+	return matches
+}
+
+func handleCountOccurrences(ctx context.Context, req *mcp.CallToolRequest, args CountOccurrencesArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("count_occurrences called: pattern=%q regex=%t ignore_case=%t overlapping=%t",
+		args.Pattern, args.Regex, args.IgnoreCase, args.Overlapping))
+
+	if args.Pattern == "" {
+		return errorResult("Pattern must not be empty"), nil, nil
+	}
+
+	expr := args.Pattern
+	if !args.Regex {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if args.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid regular expression: %v", err)), nil, nil
+	}
+
+	matches := findOccurrences(re, args.Text, args.Overlapping)
+
+	return textResult(fmt.Sprintf("%d", len(matches))), map[string]any{
+		"count":   len(matches),
+		"matches": matches,
+	}, nil
+}
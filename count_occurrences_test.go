@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestCountOccurrences(t *testing.T) {
+	runToolCases(t, handleCountOccurrences, []toolCase[CountOccurrencesArgs]{
+		{name: "literal", args: CountOccurrencesArgs{Text: "the cat and the hat", Pattern: "the"}, text: "2", out: `{"count":2,"matches":[{"position":0,"match":"the"},{"position":12,"match":"the"}]}`},
+		{name: "literal metacharacters", args: CountOccurrencesArgs{Text: "a.b a.b axb", Pattern: "a.b"}, text: "2"},
+		{name: "non-overlapping", args: CountOccurrencesArgs{Text: "aaa", Pattern: "aa"}, text: "1", out: `{"matches":[{"position":0,"match":"aa"}]}`},
+		{name: "overlapping", args: CountOccurrencesArgs{Text: "aaa", Pattern: "aa", Overlapping: true}, text: "2", out: `{"matches":[{"position":0,"match":"aa"},{"position":1,"match":"aa"}]}`},
+		{name: "case-insensitive", args: CountOccurrencesArgs{Text: "Go go GO gone", Pattern: "go", IgnoreCase: true}, text: "4"},
+		{name: "case-sensitive", args: CountOccurrencesArgs{Text: "Go go GO gone", Pattern: "go"}, text: "2"},
+		{name: "regex", args: CountOccurrencesArgs{Text: "a1 b22 c333", Pattern: `\d+`, Regex: true}, text: "3", out: `{"matches":[{"position":1,"match":"1"},{"position":4,"match":"22"},{"position":8,"match":"333"}]}`},
+		{name: "rune positions", args: CountOccurrencesArgs{Text: "héllo héllo", Pattern: "llo"}, out: `{"matches":[{"position":2,"match":"llo"},{"position":8,"match":"llo"}]}`},
+		{name: "empty matches skipped", args: CountOccurrencesArgs{Text: "abc", Pattern: "x*", Regex: true}, text: "0", out: `{"count":0,"matches":[]}`},
+		{name: "start anchor", args: CountOccurrencesArgs{Text: "aaa", Pattern: "^a", Regex: true}, text: "1"},
+		{name: "start anchor overlapping", args: CountOccurrencesArgs{Text: "aaa", Pattern: "^a", Regex: true, Overlapping: true}, text: "1"},
+		{name: "word boundary", args: CountOccurrencesArgs{Text: "abab", Pattern: `\bab`, Regex: true}, text: "1"},
+		{name: "word boundary overlapping", args: CountOccurrencesArgs{Text: "abab ab", Pattern: `\bab`, Regex: true, Overlapping: true}, text: "2", out: `{"matches":[{"position":0,"match":"ab"},{"position":5,"match":"ab"}]}`},
+		{name: "line anchors overlapping", args: CountOccurrencesArgs{Text: "a\nab\nb", Pattern: `(?m)^\w`, Regex: true, Overlapping: true}, out: `{"matches":[{"position":0,"match":"a"},{"position":2,"match":"a"},{"position":5,"match":"b"}]}`},
+		{name: "overlapping with ignore case", args: CountOccurrencesArgs{Text: "AaA", Pattern: "aa", IgnoreCase: true, Overlapping: true}, text: "2"},
+		{name: "empty pattern", args: CountOccurrencesArgs{Text: "abc"}, err: true, text: "Pattern must not be empty"},
+		{name: "invalid regex", args: CountOccurrencesArgs{Text: "abc", Pattern: "(", Regex: true}, err: true, contains: []string{"Invalid regular expression: "}},
+	})
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type DateFactsArgs struct {
+	Date string `json:"date" jsonschema:"The date (YYYY-MM-DD or RFC3339; an RFC3339 value uses its own local date)"`
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// daysInMonth returns the number of days in the given month; day 0 of the
+// following month is the last day of this one.
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func handleDateFacts(ctx context.Context, req *mcp.CallToolRequest, args DateFactsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("date_facts called: %s", args.Date))
+
+	date, err := parseDate(args.Date)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid date: %v", err)), nil, nil
+	}
+
+	isoYear, isoWeek := date.ISOWeek()
+	facts := map[string]any{
+		"date":          date.Format("2006-01-02"),
+		"day_of_week":   date.Weekday().String(),
+		"iso_week":      isoWeek,
+		"iso_year":      isoYear,
+		"day_of_year":   date.YearDay(),
+		"leap_year":     isLeapYear(date.Year()),
+		"days_in_month": daysInMonth(date.Year(), date.Month()),
+	}
+
+	text := fmt.Sprintf("%s is a %s\nISO week: %d-W%02d\nDay of year: %d\nLeap year: %t\nDays in month: %d",
+		facts["date"], facts["day_of_week"], isoYear, isoWeek, facts["day_of_year"], facts["leap_year"], facts["days_in_month"])
+	return textResult(text), facts, nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestDateFacts(t *testing.T) {
+	runToolCases(t, handleDateFacts, []toolCase[DateFactsArgs]{
+		{
+			name: "leap day",
+			args: DateFactsArgs{Date: "2024-02-29"},
+			text: "2024-02-29 is a Thursday\nISO week: 2024-W09\nDay of year: 60\nLeap year: true\nDays in month: 29",
+			out:  `{"date":"2024-02-29","day_of_week":"Thursday","iso_week":9,"iso_year":2024,"day_of_year":60,"leap_year":true,"days_in_month":29}`,
+		},
+		{
+			name: "year end in next ISO year",
+			args: DateFactsArgs{Date: "2024-12-30"},
+			out:  `{"day_of_week":"Monday","iso_week":1,"iso_year":2025,"day_of_year":365,"days_in_month":31}`,
+		},
+		{
+			name: "new year in previous ISO year",
+			args: DateFactsArgs{Date: "2021-01-01"},
+			text: "2021-01-01 is a Friday\nISO week: 2020-W53\nDay of year: 1\nLeap year: false\nDays in month: 31",
+			out:  `{"iso_week":53,"iso_year":2020,"day_of_year":1,"leap_year":false}`,
+		},
+		{name: "century not leap", args: DateFactsArgs{Date: "1900-02-01"}, out: `{"leap_year":false,"days_in_month":28}`},
+		{name: "400 year leap", args: DateFactsArgs{Date: "2000-02-01"}, out: `{"leap_year":true,"days_in_month":29}`},
+		{name: "RFC3339 local date", args: DateFactsArgs{Date: "2026-12-31T23:30:00-08:00"}, out: `{"date":"2026-12-31","day_of_year":365,"iso_week":53,"iso_year":2026}`},
+		{name: "not a leap year", args: DateFactsArgs{Date: "2023-02-29"}, err: true, text: `Invalid date: invalid date "2023-02-29" (expected RFC3339 or YYYY-MM-DD)`},
+		{name: "garbage", args: DateFactsArgs{Date: "tomorrow"}, err: true, text: `Invalid date: invalid date "tomorrow" (expected RFC3339 or YYYY-MM-DD)`},
+	})
+}
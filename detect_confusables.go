@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/text/unicode/norm"
+)
+
+type DetectConfusablesArgs struct {
+	Text string `json:"text" jsonschema:"The string to check, such as a username, domain label, or display name"`
+}
+
+// latinConfusables maps letters from other scripts to the Latin letters they
+// are commonly mistaken for. It is a subset of the Unicode confusables data
+// (UTS #39) covering Cyrillic, Greek, and Armenian lookalikes.
+var latinConfusables = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'с': 'c', 'ԁ': 'd', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j',
+	'к': 'k', 'ӏ': 'l', 'м': 'm', 'о': 'o', 'р': 'p', 'ԛ': 'q',
+	'ѕ': 's', 'т': 't', 'ѵ': 'v', 'ԝ': 'w', 'х': 'x', 'у': 'y',
+	'А': 'A', 'В': 'B', 'С': 'C', 'Е': 'E', 'Н': 'H', 'І': 'I', 'Ј': 'J', 'К': 'K',
+	'М': 'M', 'О': 'O', 'Р': 'P', 'Ѕ': 'S', 'Т': 'T', 'Х': 'X', 'У': 'Y',
+	// Greek
+	'α': 'a', 'ɑ': 'a', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p',
+	'τ': 't', 'υ': 'u', 'χ': 'x', 'γ': 'y',
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M',
+	'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	// Armenian
+	'օ': 'o', 'ս': 'u', 'հ': 'h', 'ո': 'n', 'զ': 'q', 'ց': 'g',
+}
+
+// invisibleRunes are format characters that render as nothing and can hide
+// inside an otherwise ordinary-looking string.
+var invisibleRunes = map[rune]bool{
+	'\u00ad': true, '\u180e': true, '\u200b': true, '\u200c': true, '\u200d': true,
+	'\u200e': true, '\u200f': true, '\u2060': true, '\u2061': true, '\u2062': true,
+	'\u2063': true, '\u2064': true, '\ufeff': true,
+}
+
+type suspiciousRune struct {
+	Index     int    `json:"index" jsonschema:"Rune offset in the input"`
+	Char      string `json:"char"`
+	CodePoint string `json:"code_point"`
+	Script    string `json:"script"`
+	Reason    string `json:"reason" jsonschema:"confusable or invisible"`
+	LooksLike string `json:"looks_like,omitempty"`
+}
+
+// confusableSkeleton folds compatibility variants (fullwidth and
+// mathematical letters) with NFKC, drops invisible characters, and replaces
+// known lookalikes with their Latin prototypes, so two strings that look the
+// same produce the same skeleton.
+func confusableSkeleton(text string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKC.String(text) {
+		if invisibleRunes[r] {
+			continue
+		}
+		if latin, ok := latinConfusables[r]; ok {
+			r = latin
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func handleDetectConfusables(ctx context.Context, req *mcp.CallToolRequest, args DetectConfusablesArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("detect_confusables called with %d bytes", len(args.Text)))
+
+	runes := []rune(args.Text)
+	letterScripts := map[string]int{}
+	for _, r := range runes {
+		if script := scriptOf(r); script != "Common" && script != "Inherited" && script != "Unknown" {
+			letterScripts[script]++
+		}
+	}
+	scripts := make([]string, 0, len(letterScripts))
+	for name := range letterScripts {
+		scripts = append(scripts, name)
+	}
+	slices.Sort(scripts)
+
+	// Lookalikes are only suspicious beside letters they can pass for: in
+	// text mixing Latin with another script, or in text written entirely in
+	// lookalikes (a "whole-script" confusable such as Cyrillic "рау").
+	mixed := len(scripts) > 1 && letterScripts["Latin"] > 0
+	wholeScript := len(scripts) == 1 && scripts[0] != "Latin"
+	if wholeScript {
+		for _, r := range runes {
+			if script := scriptOf(r); script == scripts[0] && latinConfusables[r] == 0 {
+				wholeScript = false
+				break
+			}
+		}
+	}
+
+	suspicious := []suspiciousRune{}
+	for i, r := range runes {
+		entry := suspiciousRune{Index: i, Char: string(r), CodePoint: fmt.Sprintf("U+%04X", r), Script: scriptOf(r)}
+		switch latin, ok := latinConfusables[r]; {
+		case invisibleRunes[r]:
+			entry.Reason = "invisible"
+			entry.Char = ""
+		case ok && (mixed || wholeScript):
+			entry.Reason = "confusable"
+			entry.LooksLike = string(latin)
+		default:
+			continue
+		}
+		suspicious = append(suspicious, entry)
+	}
+
+	skeleton := confusableSkeleton(args.Text)
+	reasons := []string{}
+	if mixed {
+		reasons = append(reasons, "mixed scripts: "+strings.Join(scripts, ", "))
+	}
+	if wholeScript {
+		reasons = append(reasons, fmt.Sprintf("every %s letter looks like a Latin letter", scripts[0]))
+	}
+	for _, s := range suspicious {
+		if s.Reason == "invisible" {
+			reasons = append(reasons, "contains invisible characters")
+			break
+		}
+	}
+	risky := len(suspicious) > 0 || mixed
+
+	lines := []string{"Safe: no confusable characters found"}
+	if risky {
+		lines = []string{"Risky: " + strings.Join(reasons, "; ")}
+		for _, s := range suspicious {
+			if s.Reason == "invisible" {
+				lines = append(lines, fmt.Sprintf("  %d: invisible %s", s.Index, s.CodePoint))
+			} else {
+				lines = append(lines, fmt.Sprintf("  %d: %q %s (%s) looks like %q", s.Index, s.Char, s.CodePoint, s.Script, s.LooksLike))
+			}
+		}
+	}
+	lines = append(lines, "Skeleton: "+skeleton)
+
+	return textResult(strings.Join(lines, "\n")), map[string]any{
+		"risky":      risky,
+		"reasons":    reasons,
+		"scripts":    scripts,
+		"suspicious": suspicious,
+		"skeleton":   skeleton,
+	}, nil
+}
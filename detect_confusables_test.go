@@ -0,0 +1,81 @@
+package main
+
+import "testing"
+
+func TestDetectConfusables(t *testing.T) {
+	runToolCases(t, handleDetectConfusables, []toolCase[DetectConfusablesArgs]{
+		{
+			name: "pure Latin",
+			args: DetectConfusablesArgs{Text: "paypal"},
+			text: "Safe: no confusable characters found\nSkeleton: paypal",
+			out:  `{"risky":false,"reasons":[],"scripts":["Latin"],"suspicious":[],"skeleton":"paypal"}`,
+		},
+		{
+			name: "Latin with digits and punctuation",
+			args: DetectConfusablesArgs{Text: "google.com 123"},
+			out:  `{"risky":false,"scripts":["Latin"]}`,
+		},
+		{
+			name: "Latin and Cyrillic spoof",
+			args: DetectConfusablesArgs{Text: "p\u0430ypal"},
+			text: "Risky: mixed scripts: Cyrillic, Latin\n  1: \"\u0430\" U+0430 (Cyrillic) looks like \"a\"\nSkeleton: paypal",
+			out: `{"risky":true,"reasons":["mixed scripts: Cyrillic, Latin"],"scripts":["Cyrillic","Latin"],"skeleton":"paypal",
+				"suspicious":[{"index":1,"char":"\u0430","code_point":"U+0430","script":"Cyrillic","reason":"confusable","looks_like":"a"}]}`,
+		},
+		{
+			name: "mixed words flag only lookalikes",
+			args: DetectConfusablesArgs{Text: "hello \u043c\u0438\u0440"},
+			out: `{"risky":true,"skeleton":"hello m\u0438p","suspicious":[
+				{"index":6,"char":"\u043c","code_point":"U+043C","script":"Cyrillic","reason":"confusable","looks_like":"m"},
+				{"index":8,"char":"\u0440","code_point":"U+0440","script":"Cyrillic","reason":"confusable","looks_like":"p"}]}`,
+		},
+		{
+			name: "whole-script confusable",
+			args: DetectConfusablesArgs{Text: "\u0440\u0430\u0443"},
+			contains: []string{
+				"Risky: every Cyrillic letter looks like a Latin letter",
+				"  0: \"\u0440\" U+0440 (Cyrillic) looks like \"p\"",
+			},
+			out: `{"risky":true,"scripts":["Cyrillic"],"skeleton":"pay"}`,
+		},
+		{
+			name: "ordinary Cyrillic word",
+			args: DetectConfusablesArgs{Text: "\u043c\u0438\u0440"},
+			text: "Safe: no confusable characters found\nSkeleton: m\u0438p",
+			out:  `{"risky":false,"suspicious":[]}`,
+		},
+		{
+			name: "invisible character",
+			args: DetectConfusablesArgs{Text: "pay\u200bpal"},
+			text: "Risky: contains invisible characters\n  3: invisible U+200B\nSkeleton: paypal",
+			out: `{"risky":true,"reasons":["contains invisible characters"],
+				"suspicious":[{"index":3,"char":"","code_point":"U+200B","script":"Common","reason":"invisible"}]}`,
+		},
+		{
+			name: "fullwidth letters fold in the skeleton",
+			args: DetectConfusablesArgs{Text: "\uff50\uff41\uff59"},
+			out:  `{"risky":false,"skeleton":"pay"}`,
+		},
+		{
+			name: "empty",
+			args: DetectConfusablesArgs{Text: ""},
+			out:  `{"risky":false,"scripts":[],"skeleton":""}`,
+		},
+	})
+}
+
+func TestConfusableSkeleton(t *testing.T) {
+	lookalikes := []string{
+		"paypal",
+		"p\u0430ypal",
+		"\u0440\u0430\u0443\u0440\u0430l",
+		"pay\u200dpal",
+		"\uff50\uff41\uff59\uff50\uff41\uff4c",
+		"\U0001D429\U0001D41A\U0001D432\U0001D429\U0001D41A\U0001D425",
+	}
+	for _, s := range lookalikes {
+		if got := confusableSkeleton(s); got != "paypal" {
+			t.Errorf("confusableSkeleton(%q) = %q, want %q", s, got, "paypal")
+		}
+	}
+}
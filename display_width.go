@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type DisplayWidthArgs struct {
+	Text string `json:"text" jsonschema:"The text to measure"`
+}
+
+// wideRanges lists the East Asian Wide and Fullwidth blocks plus the emoji
+// blocks that terminals render in two columns. It is a compact approximation
+// of Unicode's EastAsianWidth.txt rather than the full table.
+var wideRanges = []struct{ lo, hi rune }{
+	{0x1100, 0x115F},   // Hangul Jamo initial consonants
+	{0x231A, 0x231B},   // watch, hourglass
+	{0x2329, 0x232A},   // angle brackets
+	{0x23E9, 0x23EC},   // media controls
+	{0x23F0, 0x23F3},   // alarm clock, timers
+	{0x25FD, 0x25FE},   // medium small squares
+	{0x2614, 0x2615},   // umbrella, hot beverage
+	{0x2648, 0x2653},   // zodiac
+	{0x26AA, 0x26AB},   // medium circles
+	{0x26BD, 0x26BE},   // soccer ball, baseball
+	{0x26C4, 0x26C5},   // snowman, sun behind cloud
+	{0x26F2, 0x26F5},   // fountain .. sailboat
+	{0x2705, 0x2705},   // check mark button
+	{0x270A, 0x270B},   // raised fists
+	{0x2728, 0x2728},   // sparkles
+	{0x274C, 0x274C},   // cross mark
+	{0x2753, 0x2755},   // question and exclamation marks
+	{0x2795, 0x2797},   // heavy plus, minus, division
+	{0x2B1B, 0x2B1C},   // large squares
+	{0x2B50, 0x2B50},   // star
+	{0x2E80, 0x303E},   // CJK radicals, Kangxi, CJK symbols and punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, Bopomofo, Hangul compatibility, CJK compatibility
+	{0x3400, 0x4DBF},   // CJK Extension A
+	{0x4E00, 0x9FFF},   // CJK Unified Ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xA960, 0xA97F},   // Hangul Jamo Extended-A
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE10, 0xFE19},   // vertical forms
+	{0xFE30, 0xFE6F},   // CJK compatibility forms, small form variants
+	{0xFF00, 0xFF60},   // fullwidth forms
+	{0xFFE0, 0xFFE6},   // fullwidth signs
+	{0x16FE0, 0x18AFF}, // Tangut and ideographic symbols
+	{0x1B000, 0x1B2FF}, // Kana supplement and extensions
+	{0x1F004, 0x1F004}, // mahjong red dragon
+	{0x1F0CF, 0x1F0CF}, // joker
+	{0x1F18E, 0x1F18E}, // AB button
+	{0x1F191, 0x1F19A}, // squared CL .. VS
+	{0x1F200, 0x1F251}, // enclosed ideographic supplement
+	{0x1F300, 0x1F64F}, // miscellaneous symbols and pictographs, emoticons
+	{0x1F680, 0x1F6FF}, // transport and map symbols
+	{0x1F7E0, 0x1F7EB}, // coloured circles and squares
+	{0x1F90C, 0x1F9FF}, // supplemental symbols and pictographs
+	{0x1FA70, 0x1FAFF}, // symbols and pictographs extended-A
+	{0x20000, 0x3FFFD}, // CJK Extensions B onwards
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// runeWidth returns the number of terminal columns r occupies: 0 for control,
+// combining, and format characters (including zero-width spaces and joiners,
+// variation selectors, and emoji skin-tone modifiers), 2 for wide characters,
+// and 1 otherwise.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0,
+		unicode.IsControl(r),
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf),
+		r >= 0x1160 && r <= 0x11FF, // Hangul medial vowels and final consonants
+		r >= 0x1F3FB && r <= 0x1F3FF:
+		return 0
+	}
+	for _, wr := range wideRanges {
+		if r < wr.lo {
+			break
+		}
+		if r <= wr.hi {
+			return 2
+		}
+	}
+	return 1
+}
+
+// displayWidth returns the number of terminal columns s occupies. A character
+// following a zero-width joiner is treated as part of the preceding emoji and
+// adds no width, so "👨‍👩‍👧" measures 2.
+func displayWidth(s string) int {
+	width := 0
+	joined := false
+	for _, r := range s {
+		if joined {
+			joined = false
+			continue
+		}
+		if r == zeroWidthJoiner {
+			joined = true
+			continue
+		}
+		width += runeWidth(r)
+	}
+	return width
+}
+
+func handleDisplayWidth(ctx context.Context, req *mcp.CallToolRequest, args DisplayWidthArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("display_width called with %d bytes", len(args.Text)))
+
+	width := displayWidth(args.Text)
+	runes := len([]rune(args.Text))
+
+	return textResult(fmt.Sprintf("Width: %d columns (%d runes, %d bytes)", width, runes, len(args.Text))),
+		map[string]any{
+			"width": width,
+			"runes": runes,
+			"bytes": len(args.Text),
+		}, nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	runToolCases(t, handleDisplayWidth, []toolCase[DisplayWidthArgs]{
+		{name: "ascii", args: DisplayWidthArgs{Text: "hello"}, text: "Width: 5 columns (5 runes, 5 bytes)", out: `{"width":5,"runes":5,"bytes":5}`},
+		{name: "CJK", args: DisplayWidthArgs{Text: "日本語"}, text: "Width: 6 columns (3 runes, 9 bytes)", out: `{"width":6,"runes":3,"bytes":9}`},
+		{name: "mixed", args: DisplayWidthArgs{Text: "Go言語"}, out: `{"width":6,"runes":4,"bytes":8}`},
+		{name: "combining accent", args: DisplayWidthArgs{Text: "cafe\u0301"}, text: "Width: 4 columns (5 runes, 6 bytes)", out: `{"width":4,"runes":5,"bytes":6}`},
+		{name: "ZWJ sequence", args: DisplayWidthArgs{Text: "\U0001F468\u200d\U0001F469\u200d\U0001F467"}, text: "Width: 2 columns (5 runes, 18 bytes)", out: `{"width":2,"runes":5,"bytes":18}`},
+		{name: "skin tone", args: DisplayWidthArgs{Text: "\U0001F44D\U0001F3FD"}, out: `{"width":2,"runes":2,"bytes":8}`},
+		{name: "zero-width space", args: DisplayWidthArgs{Text: "a\u200bb"}, out: `{"width":2,"runes":3}`},
+		{name: "fullwidth", args: DisplayWidthArgs{Text: "ＡＢ"}, out: `{"width":4}`},
+		{name: "hangul jamo", args: DisplayWidthArgs{Text: "\u1100\u1161"}, out: `{"width":2,"runes":2}`},
+		{name: "control", args: DisplayWidthArgs{Text: "a\tb"}, out: `{"width":2,"runes":3}`},
+		{name: "empty", args: DisplayWidthArgs{Text: ""}, text: "Width: 0 columns (0 runes, 0 bytes)", out: `{"width":0,"runes":0,"bytes":0}`},
+	})
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// argsValidator is implemented by tool argument types that can check range
+// and unit constraints beyond what the input schema expresses. Validate
+// returns one message per problem found, or nil when the arguments are valid.
+type argsValidator interface {
+	Validate() []string
+}
+
+// metaFlag reports whether the request's _meta sets key to true.
+func metaFlag(req *mcp.CallToolRequest, key string) bool {
+	if req == nil || req.Params == nil {
+		return false
+	}
+	set, _ := req.Params.Meta[key].(bool)
+	return set
+}
+
+// isDryRun reports whether a call should only validate its arguments: either
+// the server runs with --validate-only, or the request sets "dry_run": true in
+// its _meta.
+func isDryRun(req *mcp.CallToolRequest) bool {
+	return config.ValidateOnly || metaFlag(req, "dry_run")
+}
+
+// withDryRun wraps a handler so that dry-run calls skip the operation and
+// its side effects. The SDK has already checked the arguments against the
+// input schema by the time the handler runs; argument types implementing
+// argsValidator are checked further. The outcome is reported in the text
+// content and in the result's _meta under "validation". A dry run has no
+// output, so its result carries no structured content.
+func withDryRun[In any](handler mcp.ToolHandlerFor[In, any]) mcp.ToolHandlerFor[In, any] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, any, error) {
+		if !isDryRun(req) {
+			return handler(ctx, req, args)
+		}
+
+		logMsg("[TOOL]", "dry run of "+req.Params.Name)
+
+		problems := []string{}
+		v, toolChecks := any(args).(argsValidator)
+		if toolChecks {
+			if p := v.Validate(); p != nil {
+				problems = p
+			}
+		}
+
+		var result *mcp.CallToolResult
+		switch {
+		case len(problems) > 0:
+			result = errorResult("Validation failed:\n- " + strings.Join(problems, "\n- "))
+		case toolChecks:
+			result = textResult("Validation passed; the operation was not performed")
+		default:
+			result = textResult("Schema validation passed; tool-specific checks were not run and the operation was not performed")
+		}
+		result.Meta = mcp.Meta{"validation": map[string]any{
+			"valid":       len(problems) == 0,
+			"tool_checks": toolChecks,
+			"messages":    problems,
+		}}
+
+		return result, nil, nil
+	}
+}
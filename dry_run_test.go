@@ -0,0 +1,114 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestDryRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          serverConfig
+		params       *mcp.CallToolParams
+		wantErr      bool
+		wantText     string
+		wantMessages []string
+		schemaOnly   bool
+	}{
+		{
+			name:     "valid",
+			params:   &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "C", "to_unit": "F"}, Meta: mcp.Meta{"dry_run": true}},
+			wantText: "Validation passed; the operation was not performed",
+		},
+		{
+			name:         "invalid",
+			params:       &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "rankine", "to_unit": "F", "precision": 12}, Meta: mcp.Meta{"dry_run": true}},
+			wantErr:      true,
+			wantText:     "Validation failed:\n- Precision must be between 0 and 10\n- unknown unit: rankine",
+			wantMessages: []string{"Precision must be between 0 and 10", "unknown unit: rankine"},
+		},
+		{
+			name:         "missing unit",
+			params:       &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "C"}, Meta: mcp.Meta{"dry_run": true}},
+			wantErr:      true,
+			wantMessages: []string{"Please provide both 'from_unit' and 'to_unit'"},
+		},
+		{
+			name:     "config defaults count",
+			cfg:      serverConfig{DefaultToUnit: "kelvin"},
+			params:   &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "C"}, Meta: mcp.Meta{"dry_run": true}},
+			wantText: "Validation passed; the operation was not performed",
+		},
+		{
+			name:     "validate-only server",
+			cfg:      serverConfig{ValidateOnly: true},
+			params:   &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "C", "to_unit": "F"}},
+			wantText: "Validation passed; the operation was not performed",
+		},
+		{
+			name:       "tool without validator",
+			params:     &mcp.CallToolParams{Name: "word_count", Arguments: map[string]any{"text": "a b"}, Meta: mcp.Meta{"dry_run": true}},
+			wantText:   "Schema validation passed; tool-specific checks were not run and the operation was not performed",
+			schemaOnly: true,
+		},
+		{
+			name:       "handler checks not claimed",
+			params:     &mcp.CallToolParams{Name: "chunk_text", Arguments: map[string]any{"text": "abc", "size": 3, "overlap": 3}, Meta: mcp.Meta{"dry_run": true}},
+			wantText:   "Schema validation passed; tool-specific checks were not run and the operation was not performed",
+			schemaOnly: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setConfig(t, tt.cfg)
+			session := connectServer(t, nil)
+
+			result, out := callRemote(t, session, tt.params)
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %t, want %t (%q)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if tt.wantText != "" && resultText(result) != tt.wantText {
+				t.Errorf("text = %q, want %q", resultText(result), tt.wantText)
+			}
+			if out != nil {
+				t.Errorf("dry run has structured content %s", compactJSON(out))
+			}
+
+			validation, ok := result.Meta["validation"].(map[string]any)
+			if !ok {
+				t.Fatalf("_meta.validation = %v, want an object", result.Meta["validation"])
+			}
+			if valid, _ := validation["valid"].(bool); valid == tt.wantErr {
+				t.Errorf("_meta.validation.valid = %v, want %t", validation["valid"], !tt.wantErr)
+			}
+			if toolChecks, _ := validation["tool_checks"].(bool); toolChecks == tt.schemaOnly {
+				t.Errorf("_meta.validation.tool_checks = %v, want %t", validation["tool_checks"], !tt.schemaOnly)
+			}
+			var messages []string
+			raw, _ := validation["messages"].([]any)
+			for _, m := range raw {
+				messages = append(messages, m.(string))
+			}
+			if !slices.Equal(messages, tt.wantMessages) {
+				t.Errorf("_meta.validation.messages = %q, want %q", messages, tt.wantMessages)
+			}
+		})
+	}
+}
+
+func TestDryRunSkipsSideEffects(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+	setConfig(t, serverConfig{})
+	session := connectServer(t, nil)
+
+	result, _ := callRemote(t, session, &mcp.CallToolParams{Name: "slugify", Arguments: map[string]any{"text": "Dry Run"}, Meta: mcp.Meta{"dry_run": true}})
+	if result.IsError {
+		t.Fatalf("unexpected error: %s", resultText(result))
+	}
+	if slugs := generatedSlugs.List(); len(slugs) != 0 {
+		t.Errorf("dry run recorded slugs %q", slugs)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type EntropyArgs struct {
+	Text string `json:"text" jsonschema:"The text to analyze"`
+}
+
+// shannonEntropy returns the Shannon entropy of text in bits per character,
+// treating each rune as a symbol, along with the number of runes.
+func shannonEntropy(text string) (float64, int) {
+	counts := map[rune]int{}
+	n := 0
+	for _, r := range text {
+		counts[r]++
+		n++
+	}
+	if n == 0 {
+		return 0, 0
+	}
+
+	entropy := 0.0
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy, n
+}
+
+func entropyLabel(bitsPerChar float64) string {
+	switch {
+	case bitsPerChar < 1:
+		return "very low"
+	case bitsPerChar < 2.5:
+		return "low"
+	case bitsPerChar < 3.5:
+		return "moderate"
+	case bitsPerChar < 4.5:
+		return "high"
+	default:
+		return "very high"
+	}
+}
+
+func handleEntropy(ctx context.Context, req *mcp.CallToolRequest, args EntropyArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("entropy called with text length: %d", len(args.Text)))
+
+	perChar, n := shannonEntropy(args.Text)
+	total := perChar * float64(n)
+	label := entropyLabel(perChar)
+
+	return textResult(fmt.Sprintf("Entropy: %.4f bits/character\nTotal: %.2f bits\nRating: %s", perChar, total, label)),
+		map[string]any{
+			"bits_per_character": perChar,
+			"total_bits":         total,
+			"characters":         n,
+			"label":              label,
+		}, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEntropy(t *testing.T) {
+	runToolCases(t, handleEntropy, []toolCase[EntropyArgs]{
+		{
+			name: "repetitive",
+			args: EntropyArgs{Text: "aaaaaaaa"},
+			text: "Entropy: 0.0000 bits/character\nTotal: 0.00 bits\nRating: very low",
+			out:  `{"bits_per_character":0,"total_bits":0,"characters":8,"label":"very low"}`,
+		},
+		{
+			name: "two symbols",
+			args: EntropyArgs{Text: "abababab"},
+			text: "Entropy: 1.0000 bits/character\nTotal: 8.00 bits\nRating: low",
+			out:  `{"bits_per_character":1,"total_bits":8,"characters":8,"label":"low"}`,
+		},
+		{
+			name: "varied",
+			args: EntropyArgs{Text: "abcdefghijklmnop"},
+			text: "Entropy: 4.0000 bits/character\nTotal: 64.00 bits\nRating: high",
+			out:  `{"characters":16,"label":"high"}`,
+		},
+		{
+			name:     "runes",
+			args:     EntropyArgs{Text: "日本語日本語"},
+			contains: []string{"Entropy: 1.5850 bits/character", "Total: 9.51 bits", "Rating: low"},
+			out:      `{"characters":6}`,
+		},
+		{
+			name: "empty",
+			args: EntropyArgs{Text: ""},
+			text: "Entropy: 0.0000 bits/character\nTotal: 0.00 bits\nRating: very low",
+			out:  `{"bits_per_character":0,"total_bits":0,"characters":0}`,
+		},
+	})
+}
+
+func TestEntropyOrdering(t *testing.T) {
+	_, low := callTool(t, handleEntropy, EntropyArgs{Text: "aaaaaaab"})
+	_, high := callTool(t, handleEntropy, EntropyArgs{Text: "x7#Qp!2m"})
+
+	lowBits, highBits := numberField(t, low, "bits_per_character"), numberField(t, high, "bits_per_character")
+	if lowBits >= highBits {
+		t.Errorf("repetitive entropy %g not below varied entropy %g", lowBits, highBits)
+	}
+	if got := numberField(t, high, "bits_per_character"); math.Abs(got-3) > 1e-9 {
+		t.Errorf("bits_per_character = %g, want 3", got)
+	}
+	if got := numberField(t, high, "total_bits"); math.Abs(got-24) > 1e-9 {
+		t.Errorf("total_bits = %g, want 24", got)
+	}
+}
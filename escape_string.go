@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type EscapeStringArgs struct {
+	Text      string `json:"text" jsonschema:"The string to escape, or the escaped string to unescape"`
+	Target    string `json:"target" jsonschema:"Target language: go, json, shell, sql, or html"`
+	Direction string `json:"direction,omitempty" jsonschema:"escape (default) or unescape"`
+}
+
+// escapeString escapes text for target. Go, JSON, and SQL results are the
+// body of a string literal without its surrounding quotes; shell results are
+// a complete word, quoted only when needed.
+func escapeString(text, target string) string {
+	switch target {
+	case "go":
+		quoted := strconv.Quote(text)
+		return quoted[1 : len(quoted)-1]
+	case "json":
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		enc.Encode(text)
+		quoted := strings.TrimSuffix(b.String(), "\n")
+		return quoted[1 : len(quoted)-1]
+	case "shell":
+		return shellQuote(text)
+	case "sql":
+		return strings.ReplaceAll(text, "'", "''")
+	default:
+		return html.EscapeString(text)
+	}
+}
+
+// unescapeString reverses escapeString, rejecting input that could not have
+// come from it (such as a bare quote inside a Go or SQL literal body).
+func unescapeString(text, target string) (string, error) {
+	switch target {
+	case "go":
+		return strconv.Unquote(`"` + text + `"`)
+	case "json":
+		var s string
+		if err := json.Unmarshal([]byte(`"`+text+`"`), &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	case "shell":
+		words, err := splitCommandLine(text)
+		if err != nil {
+			return "", err
+		}
+		if len(words) != 1 {
+			return "", fmt.Errorf("expected a single shell word, got %d", len(words))
+		}
+		return words[0], nil
+	case "sql":
+		if strings.Contains(strings.ReplaceAll(text, "''", ""), "'") {
+			return "", fmt.Errorf("unpaired single quote")
+		}
+		return strings.ReplaceAll(text, "''", "'"), nil
+	default:
+		return html.UnescapeString(text), nil
+	}
+}
+
+func handleEscapeString(ctx context.Context, req *mcp.CallToolRequest, args EscapeStringArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("escape_string called: target=%q direction=%q text length=%d", args.Target, args.Direction, len(args.Text)))
+
+	target := strings.ToLower(strings.TrimSpace(args.Target))
+	switch target {
+	case "go", "json", "shell", "sql", "html":
+	default:
+		return errorResult(fmt.Sprintf("Unsupported target: %s (use go, json, shell, sql, or html)", args.Target)), nil, nil
+	}
+
+	direction := args.Direction
+	if direction == "" {
+		direction = "escape"
+	}
+
+	var result string
+	switch direction {
+	case "escape":
+		result = escapeString(args.Text, target)
+	case "unescape":
+		var err error
+		result, err = unescapeString(args.Text, target)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid %s escape sequence: %v", target, err)), nil, nil
+		}
+	default:
+		return errorResult(fmt.Sprintf("Unsupported direction: %s (use escape or unescape)", args.Direction)), nil, nil
+	}
+
+	return textResult(result), map[string]any{
+		"text":      result,
+		"target":    target,
+		"direction": direction,
+		"changed":   result != args.Text,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import "testing"
+
+func TestEscapeString(t *testing.T) {
+	runToolCases(t, handleEscapeString, []toolCase[EscapeStringArgs]{
+		{
+			name: "go quotes and backslashes",
+			args: EscapeStringArgs{Text: "say \"hi\" C:\\path\n", Target: "go"},
+			text: `say \"hi\" C:\\path\n`,
+			out:  `{"target":"go","direction":"escape","changed":true}`,
+		},
+		{
+			name: "go control character",
+			args: EscapeStringArgs{Text: "é\x01", Target: "go"},
+			text: `é\x01`,
+		},
+		{
+			name: "json quotes and backslashes",
+			args: EscapeStringArgs{Text: "say \"hi\" C:\\path\n\t<b>", Target: "json"},
+			text: `say \"hi\" C:\\path\n\t<b>`,
+		},
+		{
+			name: "json control character",
+			args: EscapeStringArgs{Text: "a\x01", Target: "json"},
+			text: `a\u0001`,
+		},
+		{
+			name: "html angle brackets",
+			args: EscapeStringArgs{Text: `<a href="x">Tom & Jerry's</a>`, Target: "html"},
+			text: "&lt;a href=&#34;x&#34;&gt;Tom &amp; Jerry&#39;s&lt;/a&gt;",
+		},
+		{
+			name: "sql",
+			args: EscapeStringArgs{Text: "it's", Target: "sql"},
+			text: "it''s",
+		},
+		{
+			name: "shell",
+			args: EscapeStringArgs{Text: "it's here", Target: "shell"},
+			text: `'it'\''s here'`,
+		},
+		{
+			name: "nothing to escape",
+			args: EscapeStringArgs{Text: "plain", Target: " GO "},
+			text: "plain",
+			out:  `{"target":"go","changed":false}`,
+		},
+		{
+			name: "go unescape",
+			args: EscapeStringArgs{Text: `\u00e9\x41`, Target: "go", Direction: "unescape"},
+			text: "éA",
+			out:  `{"direction":"unescape"}`,
+		},
+		{
+			name: "json unescape",
+			args: EscapeStringArgs{Text: `\u00e9\n`, Target: "json", Direction: "unescape"},
+			text: "é\n",
+		},
+		{
+			name: "html unescape",
+			args: EscapeStringArgs{Text: "&lt;b&gt; &amp;amp;", Target: "html", Direction: "unescape"},
+			text: "<b> &amp;",
+		},
+		{
+			name: "sql unescape",
+			args: EscapeStringArgs{Text: "it''s", Target: "sql", Direction: "unescape"},
+			text: "it's",
+		},
+		{
+			name: "shell unescape",
+			args: EscapeStringArgs{Text: `'it'\''s here'`, Target: "shell", Direction: "unescape"},
+			text: "it's here",
+		},
+		{
+			name: "bare quote in go literal",
+			args: EscapeStringArgs{Text: `a"b`, Target: "go", Direction: "unescape"},
+			err:  true,
+			text: "Invalid go escape sequence: invalid syntax",
+		},
+		{
+			name:     "bad json escape",
+			args:     EscapeStringArgs{Text: `\q`, Target: "json", Direction: "unescape"},
+			err:      true,
+			contains: []string{"Invalid json escape sequence: "},
+		},
+		{
+			name: "several shell words",
+			args: EscapeStringArgs{Text: "a b", Target: "shell", Direction: "unescape"},
+			err:  true,
+			text: "Invalid shell escape sequence: expected a single shell word, got 2",
+		},
+		{
+			name: "unpaired sql quote",
+			args: EscapeStringArgs{Text: "it's", Target: "sql", Direction: "unescape"},
+			err:  true,
+			text: "Invalid sql escape sequence: unpaired single quote",
+		},
+		{
+			name: "unsupported target",
+			args: EscapeStringArgs{Text: "x", Target: "python"},
+			err:  true,
+			text: "Unsupported target: python (use go, json, shell, sql, or html)",
+		},
+		{
+			name: "unsupported direction",
+			args: EscapeStringArgs{Text: "x", Target: "go", Direction: "both"},
+			err:  true,
+			text: "Unsupported direction: both (use escape or unescape)",
+		},
+	})
+}
+
+func TestEscapeStringRoundTrip(t *testing.T) {
+	inputs := []string{
+		"",
+		"plain",
+		`quotes " and ' and \ backslash`,
+		"tabs\tnewlines\n\r and \x00 nul",
+		"<script>alert('x') & more</script>",
+		"unicode é 日本 \U0001F600",
+	}
+	for _, target := range []string{"go", "json", "shell", "sql", "html"} {
+		for _, in := range inputs {
+			escaped := escapeString(in, target)
+			got, err := unescapeString(escaped, target)
+			if err != nil {
+				t.Errorf("%s: unescaping %q: %v", target, escaped, err)
+				continue
+			}
+			if got != in {
+				t.Errorf("%s: round trip of %q through %q = %q", target, in, escaped, got)
+			}
+		}
+	}
+}
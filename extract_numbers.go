@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ExtractNumbersArgs struct {
+	Text string `json:"text" jsonschema:"The text to scan for numbers"`
+}
+
+type extractedNumber struct {
+	Text     string  `json:"text"`
+	Value    float64 `json:"value"`
+	Type     string  `json:"type"`
+	Currency string  `json:"currency,omitempty"`
+	Position int     `json:"position"`
+}
+
+// numberPattern matches an optional sign and currency symbol, then digits
+// with optional comma thousands separators and decimals, then an optional
+// percent sign. Groups: sign, symbol, number, percent.
+var numberPattern = regexp.MustCompile(`([-+−]?)([$€£¥]?)((?:\d{1,3}(?:,\d{3})+|\d+)(?:\.\d+)?|\.\d+)(%?)`)
+
+// extractNumbers finds the numbers in text and classifies each as an
+// integer, decimal, percentage, or currency amount. Positions are character
+// (rune) offsets. A sign directly after a letter or digit is read as a
+// hyphen ("2020-2021", "COVID-19") rather than as a negative number.
+func extractNumbers(text string) []extractedNumber {
+	numbers := []extractedNumber{}
+	runePos, scanned := 0, 0
+	for _, m := range numberPattern.FindAllStringSubmatchIndex(text, -1) {
+		start := m[0]
+		sign := text[m[2]:m[3]]
+		if sign != "" && start > 0 {
+			prev, _ := utf8.DecodeLastRuneInString(text[:start])
+			if unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+				sign = ""
+				start = m[3]
+			}
+		}
+		// Digits glued to letters ("abc123", "3rd") are part of a word.
+		if prev, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && unicode.IsLetter(prev) {
+			continue
+		}
+
+		symbol := text[m[4]:m[5]]
+		digits := strings.ReplaceAll(text[m[6]:m[7]], ",", "")
+		percent := m[9] > m[8]
+
+		value, err := strconv.ParseFloat(digits, 64)
+		if err != nil {
+			continue
+		}
+		if sign == "-" || sign == "−" {
+			value = -value
+		}
+
+		kind := "integer"
+		switch {
+		case percent:
+			kind = "percentage"
+		case symbol != "":
+			kind = "currency"
+		case strings.Contains(digits, "."):
+			kind = "decimal"
+		}
+
+		currency := ""
+		if symbol != "" {
+			currency, _, _ = normalizeCurrency(symbol)
+		}
+
+		runePos += utf8.RuneCountInString(text[scanned:start])
+		scanned = start
+		numbers = append(numbers, extractedNumber{
+			Text:     text[start:m[1]],
+			Value:    value,
+			Type:     kind,
+			Currency: currency,
+			Position: runePos,
+		})
+	}
+	return numbers
+}
+
+func handleExtractNumbers(ctx context.Context, req *mcp.CallToolRequest, args ExtractNumbersArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("extract_numbers called with %d bytes", len(args.Text)))
+
+	numbers := extractNumbers(args.Text)
+	lines := make([]string, len(numbers))
+	for i, n := range numbers {
+		lines[i] = fmt.Sprintf("%s (%s, %s) at %d", n.Text, n.Type, strconv.FormatFloat(n.Value, 'f', -1, 64), n.Position)
+	}
+	text := fmt.Sprintf("Found %d numbers", len(numbers))
+	if len(lines) > 0 {
+		text += "\n" + strings.Join(lines, "\n")
+	}
+
+	return textResult(text), map[string]any{"numbers": numbers, "count": len(numbers)}, nil
+}
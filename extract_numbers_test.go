@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func TestExtractNumbers(t *testing.T) {
+	runToolCases(t, handleExtractNumbers, []toolCase[ExtractNumbersArgs]{
+		{
+			name: "mixed sentence",
+			args: ExtractNumbersArgs{Text: "Sales rose 12.5% to $1,234.56 across 42 stores."},
+			text: "Found 3 numbers\n12.5% (percentage, 12.5) at 11\n$1,234.56 (currency, 1234.56) at 20\n42 (integer, 42) at 37",
+			out: `{"count":3,"numbers":[
+				{"text":"12.5%","value":12.5,"type":"percentage","position":11},
+				{"text":"$1,234.56","value":1234.56,"type":"currency","currency":"USD","position":20},
+				{"text":"42","value":42,"type":"integer","position":37}
+			]}`,
+		},
+		{
+			name: "negative numbers",
+			args: ExtractNumbersArgs{Text: "down -3 and \u22124.5, up +2"},
+			out: `{"numbers":[
+				{"text":"-3","value":-3,"type":"integer","position":5},
+				{"text":"\u22124.5","value":-4.5,"type":"decimal","position":12},
+				{"text":"+2","value":2,"type":"integer","position":21}
+			]}`,
+		},
+		{
+			name: "hyphens are not signs",
+			args: ExtractNumbersArgs{Text: "2020-2021 COVID-19"},
+			out:  `{"numbers":[{"text":"2020","value":2020,"type":"integer","position":0},{"text":"2021","value":2021,"type":"integer","position":5},{"text":"19","value":19,"type":"integer","position":16}]}`,
+		},
+		{
+			name: "positions count runes",
+			args: ExtractNumbersArgs{Text: "café €10 .5"},
+			out:  `{"numbers":[{"text":"€10","value":10,"type":"currency","currency":"EUR","position":5},{"text":".5","value":0.5,"type":"decimal","position":9}]}`,
+		},
+		{name: "digits in words", args: ExtractNumbersArgs{Text: "abc123 x9"}, out: `{"count":0,"numbers":[]}`},
+		{name: "separator without groups", args: ExtractNumbersArgs{Text: "1,23"}, out: `{"numbers":[{"text":"1","value":1,"type":"integer","position":0},{"text":"23","value":23,"type":"integer","position":2}]}`},
+		{name: "none", args: ExtractNumbersArgs{Text: "no numbers"}, text: "Found 0 numbers", out: `{"count":0,"numbers":[]}`},
+	})
+}
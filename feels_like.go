@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type FeelsLikeArgs struct {
+	Temperature float64  `json:"temperature" jsonschema:"The air temperature"`
+	Unit        string   `json:"unit,omitempty" jsonschema:"Unit of temperature (celsius, fahrenheit, or kelvin). Defaults to celsius"`
+	ToUnit      string   `json:"to_unit,omitempty" jsonschema:"Unit for the apparent temperature. Defaults to unit"`
+	WindSpeed   *float64 `json:"wind_speed,omitempty" jsonschema:"Wind speed, used for wind chill"`
+	WindUnit    string   `json:"wind_unit,omitempty" jsonschema:"Unit of wind_speed (kmh, mph, or ms). Defaults to kmh"`
+	Humidity    *float64 `json:"humidity,omitempty" jsonschema:"Relative humidity in percent (0-100), used for heat index"`
+	Precision   *int     `json:"precision,omitempty" jsonschema:"Decimal places in the result (0-10, default 1)"`
+}
+
+// Air temperatures (in Celsius) and wind speeds outside these bounds are
+// beyond anything measured outdoors and far outside where either formula was
+// fitted.
+const (
+	minFeelsLikeCelsius = -100
+	maxFeelsLikeCelsius = 100
+	maxFeelsLikeWindMPH = 250
+)
+
+// windSpeedToMPH converts a wind speed in the given unit to miles per hour.
+func windSpeedToMPH(speed float64, unit string) (float64, error) {
+	switch strings.ToLower(unit) {
+	case "", "kmh", "km/h", "kph":
+		return speed / 1.609344, nil
+	case "mph":
+		return speed, nil
+	case "ms", "m/s":
+		return speed * 3600 / 1609.344, nil
+	default:
+		return 0, fmt.Errorf("unsupported wind unit: %s", unit)
+	}
+}
+
+// windChill returns the NWS (2001) wind chill in Fahrenheit for an air
+// temperature in Fahrenheit and a wind speed in mph. The formula is defined
+// for temperatures at or below 50°F and wind speeds of at least 3 mph.
+func windChill(tempF, windMPH float64) float64 {
+	v := math.Pow(windMPH, 0.16)
+	return 35.74 + 0.6215*tempF - 35.75*v + 0.4275*tempF*v
+}
+
+// heatIndex returns the NWS heat index in Fahrenheit for an air temperature
+// in Fahrenheit and a relative humidity in percent. Like the NWS calculator,
+// it starts from Steadman's simple formula and switches to the Rothfusz
+// regression, with its low- and high-humidity adjustments, once the result
+// reaches 80°F.
+func heatIndex(tempF, humidity float64) float64 {
+	simple := 0.5 * (tempF + 61 + (tempF-68)*1.2 + humidity*0.094)
+	if (simple+tempF)/2 < 80 {
+		return simple
+	}
+
+	t, rh := tempF, humidity
+	hi := -42.379 + 2.04901523*t + 10.14333127*rh - 0.22475541*t*rh -
+		0.00683783*t*t - 0.05481717*rh*rh + 0.00122874*t*t*rh +
+		0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+
+	switch {
+	case rh < 13 && t >= 80 && t <= 112:
+		hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(t-95))/17)
+	case rh > 85 && t >= 80 && t <= 87:
+		hi += (rh - 85) / 10 * (87 - t) / 5
+	}
+	return hi
+}
+
+func handleFeelsLike(ctx context.Context, req *mcp.CallToolRequest, args FeelsLikeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("feels_like called: %.2f %s", args.Temperature, args.Unit))
+
+	unit := "celsius"
+	if args.Unit != "" {
+		var ok bool
+		if unit, ok = normalizeTemperatureUnit(args.Unit); !ok {
+			return errorResult(fmt.Sprintf("unknown unit: %s", args.Unit)), nil, nil
+		}
+	}
+	toUnit := unit
+	if args.ToUnit != "" {
+		var ok bool
+		if toUnit, ok = normalizeTemperatureUnit(args.ToUnit); !ok {
+			return errorResult(fmt.Sprintf("unknown unit: %s", args.ToUnit)), nil, nil
+		}
+	}
+
+	precision := 1
+	if args.Precision != nil {
+		precision = *args.Precision
+		if precision < 0 || precision > 10 {
+			return errorResult("Precision must be between 0 and 10"), nil, nil
+		}
+	}
+
+	celsius, _ := toCelsius(args.Temperature, unit)
+	if !(celsius >= minFeelsLikeCelsius && celsius <= maxFeelsLikeCelsius) {
+		return errorResult(fmt.Sprintf("Temperature must be between %d°C and %d°C", minFeelsLikeCelsius, maxFeelsLikeCelsius)), nil, nil
+	}
+	tempF, _ := fromCelsius(celsius, "fahrenheit")
+
+	var windMPH float64
+	if args.WindSpeed != nil {
+		if *args.WindSpeed < 0 {
+			return errorResult("Wind speed cannot be negative"), nil, nil
+		}
+		var err error
+		if windMPH, err = windSpeedToMPH(*args.WindSpeed, args.WindUnit); err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		if windMPH > maxFeelsLikeWindMPH {
+			return errorResult(fmt.Sprintf("Wind speed must be at most %d mph (402 km/h)", maxFeelsLikeWindMPH)), nil, nil
+		}
+	}
+	if args.Humidity != nil && (*args.Humidity < 0 || *args.Humidity > 100) {
+		return errorResult("Humidity must be between 0 and 100"), nil, nil
+	}
+	if args.WindSpeed == nil && args.Humidity == nil {
+		return errorResult("Provide wind_speed for wind chill or humidity for heat index"), nil, nil
+	}
+
+	// The two formulas cover disjoint temperature ranges, so at most one
+	// applies; otherwise the air temperature is what it feels like.
+	formula, note := "none", ""
+	feelsF := tempF
+	switch {
+	case args.WindSpeed != nil && tempF <= 50 && windMPH >= 3:
+		formula = "wind_chill"
+		feelsF = windChill(tempF, windMPH)
+	case args.Humidity != nil && tempF >= 80:
+		formula = "heat_index"
+		feelsF = heatIndex(tempF, *args.Humidity)
+	case args.WindSpeed != nil && tempF <= 50:
+		note = "Wind chill needs a wind speed of at least 3 mph (4.8 km/h)"
+	default:
+		note = "Wind chill applies at or below 10°C (50°F) and heat index at or above 26.7°C (80°F)"
+	}
+
+	feelsC, _ := toCelsius(feelsF, "fahrenheit")
+	feels, _ := fromCelsius(feelsC, toUnit)
+	air, _ := fromCelsius(celsius, toUnit)
+	feels, air = roundTo(feels, precision), roundTo(air, precision)
+
+	text := fmt.Sprintf("Feels like %.*f %s", precision, feels, toUnit)
+	switch formula {
+	case "wind_chill":
+		text += " (wind chill)"
+	case "heat_index":
+		text += " (heat index)"
+	default:
+		text += fmt.Sprintf(" (no adjustment: %s)", note)
+	}
+
+	out := map[string]any{
+		"feels_like":      feels,
+		"air_temperature": air,
+		"unit":            toUnit,
+		"formula":         formula,
+	}
+	if note != "" {
+		out["note"] = note
+	}
+	return textResult(text), out, nil
+}
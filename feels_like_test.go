@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func TestFeelsLike(t *testing.T) {
+	// Reference values are from the NWS wind chill and heat index charts,
+	// which are rounded to whole degrees Fahrenheit.
+	windChillF := func(temp, wind float64) FeelsLikeArgs {
+		return FeelsLikeArgs{Temperature: temp, Unit: "fahrenheit", WindSpeed: ptr(wind), WindUnit: "mph", Precision: ptr(0)}
+	}
+	heatIndexF := func(temp, humidity float64) FeelsLikeArgs {
+		return FeelsLikeArgs{Temperature: temp, Unit: "fahrenheit", Humidity: ptr(humidity), Precision: ptr(0)}
+	}
+
+	runToolCases(t, handleFeelsLike, []toolCase[FeelsLikeArgs]{
+		{
+			name: "wind chill 0F 15mph",
+			args: windChillF(0, 15),
+			text: "Feels like -19 fahrenheit (wind chill)",
+			out:  `{"feels_like":-19,"air_temperature":0,"unit":"fahrenheit","formula":"wind_chill"}`,
+		},
+		{name: "wind chill 5F 10mph", args: windChillF(5, 10), out: `{"feels_like":-10}`},
+		{name: "wind chill 40F 5mph", args: windChillF(40, 5), out: `{"feels_like":36}`},
+		{name: "wind chill -10F 30mph", args: windChillF(-10, 30), out: `{"feels_like":-39}`},
+		{
+			name: "heat index 90F 60%",
+			args: heatIndexF(90, 60),
+			text: "Feels like 100 fahrenheit (heat index)",
+			out:  `{"feels_like":100,"air_temperature":90,"unit":"fahrenheit","formula":"heat_index"}`,
+		},
+		{name: "heat index 96F 65%", args: heatIndexF(96, 65), out: `{"feels_like":121}`},
+		{name: "heat index 100F 40%", args: heatIndexF(100, 40), out: `{"feels_like":109}`},
+		{name: "heat index high humidity", args: heatIndexF(86, 90), out: `{"feels_like":105}`},
+		{
+			name: "converted units",
+			args: FeelsLikeArgs{Temperature: 5, WindSpeed: ptr(10.0), WindUnit: "ms", ToUnit: "K"},
+			text: "Feels like 272.7 kelvin (wind chill)",
+			out:  `{"feels_like":272.7,"air_temperature":278.2,"unit":"kelvin"}`,
+		},
+		{
+			name: "between the formulas",
+			args: FeelsLikeArgs{Temperature: 20, WindSpeed: ptr(20.0), Humidity: ptr(50.0)},
+			text: "Feels like 20.0 celsius (no adjustment: Wind chill applies at or below 10°C (50°F) and heat index at or above 26.7°C (80°F))",
+			out:  `{"feels_like":20,"formula":"none","note":"Wind chill applies at or below 10°C (50°F) and heat index at or above 26.7°C (80°F)"}`,
+		},
+		{
+			name: "calm wind",
+			args: FeelsLikeArgs{Temperature: 5, WindSpeed: ptr(2.0)},
+			out:  `{"feels_like":5,"formula":"none","note":"Wind chill needs a wind speed of at least 3 mph (4.8 km/h)"}`,
+		},
+		{name: "no inputs", args: FeelsLikeArgs{Temperature: 5}, err: true, text: "Provide wind_speed for wind chill or humidity for heat index"},
+		{name: "negative wind", args: FeelsLikeArgs{Temperature: 5, WindSpeed: ptr(-1.0)}, err: true, text: "Wind speed cannot be negative"},
+		{name: "unknown wind unit", args: FeelsLikeArgs{Temperature: 5, WindSpeed: ptr(1.0), WindUnit: "knots"}, err: true, text: "unsupported wind unit: knots"},
+		{name: "wind too fast", args: FeelsLikeArgs{Temperature: 5, WindSpeed: ptr(500.0)}, err: true, text: "Wind speed must be at most 250 mph (402 km/h)"},
+		{name: "temperature too high", args: FeelsLikeArgs{Temperature: 150, Humidity: ptr(50.0)}, err: true, text: "Temperature must be between -100°C and 100°C"},
+		{name: "below absolute zero", args: FeelsLikeArgs{Temperature: -10, Unit: "kelvin", WindSpeed: ptr(10.0)}, err: true, text: "Temperature must be between -100°C and 100°C"},
+		{name: "humidity out of range", args: FeelsLikeArgs{Temperature: 30, Humidity: ptr(101.0)}, err: true, text: "Humidity must be between 0 and 100"},
+		{name: "unknown unit", args: FeelsLikeArgs{Temperature: 5, Unit: "rankine", WindSpeed: ptr(1.0)}, err: true, text: "unknown unit: rankine"},
+		{name: "precision out of range", args: FeelsLikeArgs{Temperature: 5, WindSpeed: ptr(10.0), Precision: ptr(11)}, err: true, text: "Precision must be between 0 and 10"},
+	})
+}
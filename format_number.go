@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type FormatNumberArgs struct {
+	Value              float64 `json:"value" jsonschema:"The number to format"`
+	Mode               string  `json:"mode,omitempty" jsonschema:"Formatting mode (fixed, significant, scientific, or engineering). Defaults to fixed"`
+	Decimals           *int    `json:"decimals,omitempty" jsonschema:"Digits after the decimal point for fixed, scientific, and engineering modes (0-20, default 2)"`
+	SignificantFigures *int    `json:"significant_figures,omitempty" jsonschema:"Number of significant figures for significant mode (1-17)"`
+	GroupThousands     bool    `json:"group_thousands,omitempty" jsonschema:"Insert comma separators between groups of thousands (fixed and significant modes only)"`
+}
+
+// groupThousands inserts commas between groups of three digits in the integer
+// part of a formatted decimal number such as "-1234567.89".
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign = "-"
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	if len(intPart) <= 3 {
+		return sign + s
+	}
+
+	var b strings.Builder
+	lead := len(intPart) % 3
+	if lead > 0 {
+		b.WriteString(intPart[:lead])
+	}
+	for i := lead; i < len(intPart); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(intPart[i : i+3])
+	}
+
+	if hasFrac {
+		return sign + b.String() + "." + fracPart
+	}
+	return sign + b.String()
+}
+
+// decimalExponent returns the power of ten of the leading digit of value once
+// it has been rounded to the given number of significant figures, so that
+// 9.99 at two figures reports 1 (it rounds to 10) rather than 0.
+func decimalExponent(value float64, sigFigs int) int {
+	if value == 0 {
+		return 0
+	}
+	s := strconv.FormatFloat(value, 'e', sigFigs-1, 64)
+	exp, _ := strconv.Atoi(s[strings.IndexByte(s, 'e')+1:])
+	return exp
+}
+
+func formatSignificant(value float64, sigFigs int) string {
+	decimals := sigFigs - 1 - decimalExponent(value, sigFigs)
+	if decimals >= 0 {
+		return strconv.FormatFloat(value, 'f', decimals, 64)
+	}
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'e', sigFigs-1, 64), 64)
+	return strconv.FormatFloat(rounded, 'f', 0, 64)
+}
+
+func formatEngineering(value float64, decimals int) string {
+	exp := decimalExponent(value, decimals+1)
+	eng := exp - ((exp%3)+3)%3
+	mantissa := value / math.Pow10(eng)
+
+	formatted := strconv.FormatFloat(mantissa, 'f', decimals, 64)
+	if m, _ := strconv.ParseFloat(formatted, 64); math.Abs(m) >= 1000 {
+		eng += 3
+		formatted = strconv.FormatFloat(value/math.Pow10(eng), 'f', decimals, 64)
+	}
+
+	return fmt.Sprintf("%se%+03d", formatted, eng)
+}
+
+func handleFormatNumber(ctx context.Context, req *mcp.CallToolRequest, args FormatNumberArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("format_number called: %g mode=%q", args.Value, args.Mode))
+
+	if math.IsNaN(args.Value) || math.IsInf(args.Value, 0) {
+		return errorResult("Value must be a finite number"), nil, nil
+	}
+
+	mode := args.Mode
+	if mode == "" {
+		mode = "fixed"
+	}
+
+	switch mode {
+	case "fixed", "scientific", "engineering":
+		if args.SignificantFigures != nil {
+			return errorResult(fmt.Sprintf("'significant_figures' cannot be used with %s mode", mode)), nil, nil
+		}
+	case "significant":
+		if args.Decimals != nil {
+			return errorResult("'decimals' cannot be used with significant mode"), nil, nil
+		}
+		if args.SignificantFigures == nil {
+			return errorResult("'significant_figures' is required for significant mode"), nil, nil
+		}
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+
+	if args.GroupThousands && (mode == "scientific" || mode == "engineering") {
+		return errorResult(fmt.Sprintf("'group_thousands' cannot be used with %s mode", mode)), nil, nil
+	}
+
+	decimals := 2
+	if args.Decimals != nil {
+		decimals = *args.Decimals
+		if decimals < 0 || decimals > 20 {
+			return errorResult("Decimals must be between 0 and 20"), nil, nil
+		}
+	}
+
+	var formatted string
+	switch mode {
+	case "fixed":
+		formatted = strconv.FormatFloat(args.Value, 'f', decimals, 64)
+	case "significant":
+		sigFigs := *args.SignificantFigures
+		if sigFigs < 1 || sigFigs > 17 {
+			return errorResult("Significant figures must be between 1 and 17"), nil, nil
+		}
+		formatted = formatSignificant(args.Value, sigFigs)
+	case "scientific":
+		formatted = strconv.FormatFloat(args.Value, 'e', decimals, 64)
+	case "engineering":
+		formatted = formatEngineering(args.Value, decimals)
+	}
+
+	if args.GroupThousands {
+		formatted = groupThousands(formatted)
+	}
+
+	return textResult(formatted), map[string]any{"formatted": formatted, "mode": mode}, nil
+}
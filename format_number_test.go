@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestFormatNumber(t *testing.T) {
+	runToolCases(t, handleFormatNumber, []toolCase[FormatNumberArgs]{
+		{name: "fixed small", args: FormatNumberArgs{Value: 0.000123}, text: "0.00", out: `{"formatted":"0.00","mode":"fixed"}`},
+		{name: "fixed grouped", args: FormatNumberArgs{Value: 1234567, GroupThousands: true}, text: "1,234,567.00"},
+		{name: "fixed decimals", args: FormatNumberArgs{Value: 0.000123, Decimals: ptr(6)}, text: "0.000123"},
+		{name: "significant small", args: FormatNumberArgs{Value: 0.000123, Mode: "significant", SignificantFigures: ptr(2)}, text: "0.00012", out: `{"formatted":"0.00012","mode":"significant"}`},
+		{name: "significant large", args: FormatNumberArgs{Value: 1234567, Mode: "significant", SignificantFigures: ptr(3)}, text: "1230000"},
+		{name: "significant grouped", args: FormatNumberArgs{Value: 1234567, Mode: "significant", SignificantFigures: ptr(3), GroupThousands: true}, text: "1,230,000"},
+		{name: "significant rounds up", args: FormatNumberArgs{Value: 9.99, Mode: "significant", SignificantFigures: ptr(2)}, text: "10"},
+		{name: "scientific small", args: FormatNumberArgs{Value: 0.000123, Mode: "scientific"}, text: "1.23e-04", out: `{"formatted":"1.23e-04","mode":"scientific"}`},
+		{name: "scientific large", args: FormatNumberArgs{Value: 1234567, Mode: "scientific", Decimals: ptr(3)}, text: "1.235e+06"},
+		{name: "engineering small", args: FormatNumberArgs{Value: 0.000123, Mode: "engineering"}, text: "123.00e-06", out: `{"formatted":"123.00e-06","mode":"engineering"}`},
+		{name: "engineering large", args: FormatNumberArgs{Value: 1234567, Mode: "engineering"}, text: "1.23e+06"},
+		{name: "engineering carries", args: FormatNumberArgs{Value: 999999, Mode: "engineering"}, text: "1.00e+06"},
+		{name: "significant without figures", args: FormatNumberArgs{Value: 1, Mode: "significant"}, err: true, text: "'significant_figures' is required for significant mode"},
+		{name: "significant with decimals", args: FormatNumberArgs{Value: 1, Mode: "significant", SignificantFigures: ptr(2), Decimals: ptr(2)}, err: true, text: "'decimals' cannot be used with significant mode"},
+		{name: "figures with fixed", args: FormatNumberArgs{Value: 1, SignificantFigures: ptr(2)}, err: true, text: "'significant_figures' cannot be used with fixed mode"},
+		{name: "grouping with scientific", args: FormatNumberArgs{Value: 1, Mode: "scientific", GroupThousands: true}, err: true, text: "'group_thousands' cannot be used with scientific mode"},
+		{name: "too many figures", args: FormatNumberArgs{Value: 1, Mode: "significant", SignificantFigures: ptr(18)}, err: true, text: "Significant figures must be between 1 and 17"},
+		{name: "too many decimals", args: FormatNumberArgs{Value: 1, Decimals: ptr(21)}, err: true, text: "Decimals must be between 0 and 20"},
+		{name: "unknown mode", args: FormatNumberArgs{Value: 1, Mode: "roman"}, err: true, text: "Unsupported mode: roman"},
+	})
+}
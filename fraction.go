@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type FractionArgs struct {
+	Mode           string   `json:"mode" jsonschema:"to_decimal to evaluate a fraction, or to_fraction to approximate a decimal"`
+	Fraction       string   `json:"fraction,omitempty" jsonschema:"Fraction or mixed number for to_decimal, e.g. 3/4, -1 1/2, or 2"`
+	Value          *float64 `json:"value,omitempty" jsonschema:"Decimal number for to_fraction"`
+	MaxDenominator int64    `json:"max_denominator,omitempty" jsonschema:"Largest denominator to_fraction may use (1-1000000000, default 1000)"`
+}
+
+// parseFraction parses an integer, a fraction "n/d", or a mixed number
+// "w n/d", each with an optional leading minus sign that applies to the
+// whole value.
+func parseFraction(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	fields := strings.Fields(strings.TrimPrefix(s, "-"))
+
+	parseInt := func(part string) (*big.Int, error) {
+		n, ok := new(big.Int).SetString(part, 10)
+		if !ok || n.Sign() < 0 || strings.HasPrefix(part, "+") {
+			return nil, fmt.Errorf("invalid fraction %q", s)
+		}
+		return n, nil
+	}
+
+	if len(fields) != 1 && len(fields) != 2 {
+		return nil, fmt.Errorf("invalid fraction %q (expected n/d, w n/d, or an integer)", s)
+	}
+
+	result := new(big.Rat)
+
+	fracPart := fields[len(fields)-1]
+	if len(fields) == 2 {
+		whole, err := parseInt(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		result.SetInt(whole)
+		if !strings.Contains(fracPart, "/") {
+			return nil, fmt.Errorf("invalid mixed number %q (expected w n/d)", s)
+		}
+	}
+
+	if numText, denText, ok := strings.Cut(fracPart, "/"); ok {
+		num, err := parseInt(strings.TrimSpace(numText))
+		if err != nil {
+			return nil, err
+		}
+		den, err := parseInt(strings.TrimSpace(denText))
+		if err != nil {
+			return nil, err
+		}
+		if den.Sign() == 0 {
+			return nil, fmt.Errorf("division by zero in %q", s)
+		}
+		result.Add(result, new(big.Rat).SetFrac(num, den))
+	} else {
+		n, err := parseInt(fracPart)
+		if err != nil {
+			return nil, err
+		}
+		result.SetInt(n)
+	}
+
+	if negative {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
+// bestRational returns the closest fraction to x with a denominator of at
+// most maxDen, using the continued-fraction convergents of x and the best
+// semiconvergent at the cutoff.
+func bestRational(x float64, maxDen int64) *big.Rat {
+	exact := new(big.Rat)
+	exact.SetFloat64(x)
+	if exact.Denom().IsInt64() && exact.Denom().Int64() <= maxDen {
+		return exact
+	}
+
+	// Convergents h/k follow h_n = a_n h_{n-1} + h_{n-2}, likewise for k.
+	h0, h1 := big.NewInt(0), big.NewInt(1)
+	k0, k1 := big.NewInt(1), big.NewInt(0)
+	limit := big.NewInt(maxDen)
+	rem := new(big.Rat).Set(exact)
+	for {
+		// Euclidean division by the positive denominator is the floor.
+		a := new(big.Int).Div(rem.Num(), rem.Denom())
+		k2 := new(big.Int).Add(new(big.Int).Mul(a, k1), k0)
+		if k2.Cmp(limit) > 0 {
+			// The largest semiconvergent t that fits is the better candidate
+			// if it is closer than the last convergent.
+			t := new(big.Int).Quo(new(big.Int).Sub(limit, k0), k1)
+			semi := new(big.Rat).SetFrac(new(big.Int).Add(new(big.Int).Mul(t, h1), h0), new(big.Int).Add(new(big.Int).Mul(t, k1), k0))
+			last := new(big.Rat).SetFrac(h1, k1)
+			if distance(semi, exact).Cmp(distance(last, exact)) < 0 {
+				return semi
+			}
+			return last
+		}
+		h2 := new(big.Int).Add(new(big.Int).Mul(a, h1), h0)
+		h0, h1, k0, k1 = h1, h2, k1, k2
+
+		frac := new(big.Rat).Sub(rem, new(big.Rat).SetInt(a))
+		if frac.Sign() == 0 {
+			return new(big.Rat).SetFrac(h1, k1)
+		}
+		rem = frac.Inv(frac)
+	}
+}
+
+func distance(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Abs(new(big.Rat).Sub(a, b))
+}
+
+// formatMixed renders r as "w n/d", "n/d", or an integer.
+func formatMixed(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	num := new(big.Int).Abs(r.Num())
+	whole, rest := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	sign := ""
+	if r.Sign() < 0 {
+		sign = "-"
+	}
+	if whole.Sign() == 0 {
+		return fmt.Sprintf("%s%s/%s", sign, rest, r.Denom())
+	}
+	return fmt.Sprintf("%s%s %s/%s", sign, whole, rest, r.Denom())
+}
+
+func fractionResult(r *big.Rat, extra map[string]any) (*mcp.CallToolResult, any, error) {
+	out := map[string]any{
+		"fraction":    r.RatString(),
+		"mixed":       formatMixed(r),
+		"numerator":   r.Num().String(),
+		"denominator": r.Denom().String(),
+	}
+	for k, v := range extra {
+		out[k] = v
+	}
+
+	// Values beyond float64's range have no decimal that JSON can carry: the
+	// text shows them in big.Float's wider range and "decimal" is left out.
+	decimal, _ := r.Float64()
+	if math.IsInf(decimal, 0) || (decimal == 0 && r.Sign() != 0) {
+		approx := new(big.Float).SetPrec(64).SetRat(r).Text('g', 17)
+		return textResult(fmt.Sprintf("%s = %s", formatMixed(r), approx)), out, nil
+	}
+	out["decimal"] = decimal
+	return textResult(fmt.Sprintf("%s = %s", formatMixed(r), strconv.FormatFloat(decimal, 'g', -1, 64))), out, nil
+}
+
+func handleFraction(ctx context.Context, req *mcp.CallToolRequest, args FractionArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("fraction called: mode=%s", args.Mode))
+
+	switch args.Mode {
+	case "to_decimal":
+		if args.Value != nil {
+			return errorResult("'value' cannot be used with to_decimal mode"), nil, nil
+		}
+		r, err := parseFraction(args.Fraction)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid input: %v", err)), nil, nil
+		}
+		return fractionResult(r, nil)
+
+	case "to_fraction":
+		if args.Fraction != "" {
+			return errorResult("'fraction' cannot be used with to_fraction mode"), nil, nil
+		}
+		if args.Value == nil {
+			return errorResult("'value' is required for to_fraction mode"), nil, nil
+		}
+		if math.IsNaN(*args.Value) || math.IsInf(*args.Value, 0) {
+			return errorResult("Value must be a finite number"), nil, nil
+		}
+		maxDen := args.MaxDenominator
+		if maxDen == 0 {
+			maxDen = 1000
+		}
+		if maxDen < 1 || maxDen > 1_000_000_000 {
+			return errorResult("Max denominator must be between 1 and 1000000000"), nil, nil
+		}
+		r := bestRational(*args.Value, maxDen)
+		approx, _ := r.Float64()
+		return fractionResult(r, map[string]any{"approximation_error": approx - *args.Value})
+
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+}
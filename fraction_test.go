@@ -0,0 +1,171 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFraction(t *testing.T) {
+	huge := "1" + strings.Repeat("0", 400)
+
+	runToolCases(t, handleFraction, []toolCase[FractionArgs]{
+		{
+			name: "three quarters",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "3/4"},
+			text: "3/4 = 0.75",
+			out:  `{"decimal":0.75,"fraction":"3/4","mixed":"3/4","numerator":"3","denominator":"4"}`,
+		},
+		{
+			name: "reduced",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "6/8"},
+			text: "3/4 = 0.75",
+			out:  `{"fraction":"3/4"}`,
+		},
+		{
+			name: "mixed number",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "1 1/2"},
+			text: "1 1/2 = 1.5",
+			out:  `{"decimal":1.5,"fraction":"3/2","mixed":"1 1/2"}`,
+		},
+		{
+			name: "negative mixed number",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "-1 1/2"},
+			text: "-1 1/2 = -1.5",
+			out:  `{"decimal":-1.5,"fraction":"-3/2","numerator":"-3","denominator":"2"}`,
+		},
+		{
+			name: "repeating decimal",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "1/3"},
+			text: "1/3 = 0.3333333333333333",
+			out:  `{"decimal":0.3333333333333333,"fraction":"1/3"}`,
+		},
+		{
+			name: "integer",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "5"},
+			text: "5 = 5",
+			out:  `{"decimal":5,"fraction":"5","denominator":"1"}`,
+		},
+		{
+			name: "decimal to fraction",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(0.75)},
+			text: "3/4 = 0.75",
+			out:  `{"fraction":"3/4","mixed":"3/4","approximation_error":0}`,
+		},
+		{
+			name: "one tenth",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(0.1)},
+			out:  `{"fraction":"1/10","approximation_error":0}`,
+		},
+		{
+			name: "repeating decimal to fraction",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(1.0 / 3)},
+			text: "1/3 = 0.3333333333333333",
+			out:  `{"fraction":"1/3","approximation_error":0}`,
+		},
+		{
+			name: "approximate repeating decimal",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(0.333), MaxDenominator: 10},
+			out:  `{"fraction":"1/3"}`,
+		},
+		{
+			name: "negative eighth",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(-0.125)},
+			text: "-1/8 = -0.125",
+			out:  `{"fraction":"-1/8"}`,
+		},
+		{
+			name: "improper to mixed",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(1.5)},
+			text: "1 1/2 = 1.5",
+			out:  `{"fraction":"3/2","mixed":"1 1/2"}`,
+		},
+		{
+			name: "pi within default denominator",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(3.14159265358979)},
+			text: "3 16/113 = 3.1415929203539825",
+			out:  `{"fraction":"355/113"}`,
+		},
+		{
+			name: "pi semiconvergent",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(3.14159265358979), MaxDenominator: 100},
+			out:  `{"fraction":"311/99"}`,
+		},
+		{
+			name: "denominator one",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(0.6), MaxDenominator: 1},
+			out:  `{"fraction":"1","approximation_error":0.4}`,
+		},
+		{
+			name: "division by zero",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "1/0"},
+			err:  true,
+			text: `Invalid input: division by zero in "1/0"`,
+		},
+		{
+			name: "unparseable",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "abc"},
+			err:  true,
+			text: `Invalid input: invalid fraction "abc"`,
+		},
+		{
+			name: "mixed number without fraction",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "1 2"},
+			err:  true,
+			text: `Invalid input: invalid mixed number "1 2" (expected w n/d)`,
+		},
+		{
+			name: "negative denominator",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "1/-2"},
+			err:  true,
+			text: `Invalid input: invalid fraction "1/-2"`,
+		},
+		{
+			name: "value with to_decimal",
+			args: FractionArgs{Mode: "to_decimal", Fraction: "1/2", Value: ptr(1.0)},
+			err:  true,
+			text: "'value' cannot be used with to_decimal mode",
+		},
+		{
+			name: "fraction with to_fraction",
+			args: FractionArgs{Mode: "to_fraction", Fraction: "1/2", Value: ptr(0.5)},
+			err:  true,
+			text: "'fraction' cannot be used with to_fraction mode",
+		},
+		{
+			name: "missing value",
+			args: FractionArgs{Mode: "to_fraction"},
+			err:  true,
+			text: "'value' is required for to_fraction mode",
+		},
+		{
+			name: "max denominator out of range",
+			args: FractionArgs{Mode: "to_fraction", Value: ptr(0.5), MaxDenominator: -1},
+			err:  true,
+			text: "Max denominator must be between 1 and 1000000000",
+		},
+		{
+			name:     "beyond float64 range",
+			args:     FractionArgs{Mode: "to_decimal", Fraction: huge},
+			contains: []string{" = 1e+400"},
+			out:      `{"denominator":"1","numerator":"` + huge + `"}`,
+		},
+		{
+			name:     "below float64 range",
+			args:     FractionArgs{Mode: "to_decimal", Fraction: "1/" + huge},
+			contains: []string{" = 1e-400"},
+		},
+		{
+			name: "unsupported mode",
+			args: FractionArgs{Mode: "x"},
+			err:  true,
+			text: "Unsupported mode: x",
+		},
+	})
+
+	for _, f := range []string{huge, "-" + huge, "1/" + huge} {
+		_, out := callTool(t, handleFraction, FractionArgs{Mode: "to_decimal", Fraction: f})
+		if d, ok := out.(map[string]any)["decimal"]; ok {
+			t.Errorf("%.10s...: decimal = %v, want it left out", f, d)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type GeoDistanceArgs struct {
+	FromLat   float64 `json:"from_lat" jsonschema:"Latitude of the starting point in degrees (-90 to 90)"`
+	FromLon   float64 `json:"from_lon" jsonschema:"Longitude of the starting point in degrees (-180 to 180)"`
+	ToLat     float64 `json:"to_lat" jsonschema:"Latitude of the destination in degrees (-90 to 90)"`
+	ToLon     float64 `json:"to_lon" jsonschema:"Longitude of the destination in degrees (-180 to 180)"`
+	Unit      string  `json:"unit,omitempty" jsonschema:"Distance unit (km, m, mi, or nmi). Defaults to km"`
+	Precision *int    `json:"precision,omitempty" jsonschema:"Decimal places in the distance (0-10, default 3)"`
+}
+
+// earthRadiusMeters is the IUGG mean Earth radius.
+const earthRadiusMeters = 6371008.8
+
+// lengthUnitAliases maps spellings of length units to their canonical names.
+var lengthUnitAliases = map[string]string{
+	"cm":             "cm",
+	"centimeter":     "cm",
+	"centimeters":    "cm",
+	"centimetre":     "cm",
+	"centimetres":    "cm",
+	"m":              "m",
+	"meter":          "m",
+	"meters":         "m",
+	"metre":          "m",
+	"metres":         "m",
+	"km":             "km",
+	"kilometer":      "km",
+	"kilometers":     "km",
+	"kilometre":      "km",
+	"kilometres":     "km",
+	"mi":             "mi",
+	"mile":           "mi",
+	"miles":          "mi",
+	"nmi":            "nmi",
+	"nm":             "nmi",
+	"nautical_mile":  "nmi",
+	"nautical_miles": "nmi",
+	"in":             "in",
+	"inch":           "in",
+	"inches":         "in",
+	"ft":             "ft",
+	"foot":           "ft",
+	"feet":           "ft",
+}
+
+var metersPerLengthUnit = map[string]float64{
+	"cm":  0.01,
+	"in":  0.0254,
+	"ft":  0.3048,
+	"m":   1,
+	"km":  1000,
+	"mi":  1609.344,
+	"nmi": 1852,
+}
+
+// convertLength converts a length between units, going through meters.
+func convertLength(value float64, from, to string) (float64, error) {
+	fromScale, ok := metersPerLengthUnit[from]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit: %s", from)
+	}
+	toScale, ok := metersPerLengthUnit[to]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit: %s", to)
+	}
+	return value * fromScale / toScale, nil
+}
+
+// haversine returns the great-circle distance in meters and the initial
+// bearing in degrees clockwise from north between two points on a spherical
+// Earth.
+func haversine(lat1, lon1, lat2, lon2 float64) (float64, float64) {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi := phi2 - phi1
+	dLambda := (lon2 - lon1) * math.Pi / 180
+
+	h := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	distance := 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+	bearing := math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+
+	return distance, bearing
+}
+
+func validCoordinate(lat, lon float64) error {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return fmt.Errorf("latitude %g is outside -90 to 90", lat)
+	}
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return fmt.Errorf("longitude %g is outside -180 to 180", lon)
+	}
+	return nil
+}
+
+func handleGeoDistance(ctx context.Context, req *mcp.CallToolRequest, args GeoDistanceArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("geo_distance called: (%g, %g) to (%g, %g)", args.FromLat, args.FromLon, args.ToLat, args.ToLon))
+
+	if err := validCoordinate(args.FromLat, args.FromLon); err != nil {
+		return errorResult(fmt.Sprintf("Invalid starting point: %v", err)), nil, nil
+	}
+	if err := validCoordinate(args.ToLat, args.ToLon); err != nil {
+		return errorResult(fmt.Sprintf("Invalid destination: %v", err)), nil, nil
+	}
+
+	unit := "km"
+	if args.Unit != "" {
+		var ok bool
+		if unit, ok = lengthUnitAliases[strings.ToLower(strings.TrimSpace(args.Unit))]; !ok {
+			return errorResult(fmt.Sprintf("unknown unit: %s", args.Unit)), nil, nil
+		}
+	}
+
+	precision := 3
+	if args.Precision != nil {
+		precision = *args.Precision
+		if precision < 0 || precision > 10 {
+			return errorResult("Precision must be between 0 and 10"), nil, nil
+		}
+	}
+
+	meters, bearing := haversine(args.FromLat, args.FromLon, args.ToLat, args.ToLon)
+	distance, err := convertLength(meters, "m", unit)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	distance = roundTo(distance, precision)
+	bearing = roundTo(bearing, 2)
+
+	return textResult(fmt.Sprintf("%.*f %s, initial bearing %.2f°", precision, distance, unit, bearing)),
+		map[string]any{
+			"distance":        distance,
+			"unit":            unit,
+			"initial_bearing": bearing,
+		}, nil
+}
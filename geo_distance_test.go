@@ -0,0 +1,58 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeoDistance(t *testing.T) {
+	londonParis := GeoDistanceArgs{FromLat: 51.5074, FromLon: -0.1278, ToLat: 48.8566, ToLon: 2.3522}
+	newYorkLondon := GeoDistanceArgs{FromLat: 40.7128, FromLon: -74.0060, ToLat: 51.5074, ToLon: -0.1278, Unit: "miles", Precision: ptr(0)}
+
+	runToolCases(t, handleGeoDistance, []toolCase[GeoDistanceArgs]{
+		{
+			name: "london to paris",
+			args: londonParis,
+			text: "343.557 km, initial bearing 148.12°",
+			out:  `{"distance":343.557,"unit":"km","initial_bearing":148.12}`,
+		},
+		{
+			name: "new york to london in miles",
+			args: newYorkLondon,
+			text: "3461 mi, initial bearing 51.21°",
+			out:  `{"distance":3461,"unit":"mi"}`,
+		},
+		{name: "one degree of equator", args: GeoDistanceArgs{ToLon: 1, Unit: "nmi"}, out: `{"distance":60.041,"unit":"nmi","initial_bearing":90}`},
+		{name: "across the antimeridian", args: GeoDistanceArgs{FromLon: 179, ToLon: -179, Precision: ptr(1)}, out: `{"distance":222.4,"initial_bearing":90}`},
+		{name: "antipodes", args: GeoDistanceArgs{ToLon: 180}, out: `{"distance":20015.114}`},
+		{name: "due south", args: GeoDistanceArgs{FromLat: 10, ToLat: -10, Unit: "m", Precision: ptr(0)}, out: `{"initial_bearing":180}`},
+		{name: "same point", args: GeoDistanceArgs{FromLat: 10, FromLon: 10, ToLat: 10, ToLon: 10}, text: "0.000 km, initial bearing 0.00°"},
+		{name: "latitude out of range", args: GeoDistanceArgs{FromLat: 91}, err: true, text: "Invalid starting point: latitude 91 is outside -90 to 90"},
+		{name: "longitude out of range", args: GeoDistanceArgs{ToLon: -180.5}, err: true, text: "Invalid destination: longitude -180.5 is outside -180 to 180"},
+		{name: "not a number", args: GeoDistanceArgs{ToLat: math.NaN()}, err: true, text: "Invalid destination: latitude NaN is outside -90 to 90"},
+		{name: "unknown unit", args: GeoDistanceArgs{Unit: "furlongs"}, err: true, text: "unknown unit: furlongs"},
+		{name: "precision out of range", args: GeoDistanceArgs{Precision: ptr(11)}, err: true, text: "Precision must be between 0 and 10"},
+	})
+}
+
+func TestConvertLength(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		want     float64
+	}{
+		{1, "mi", "km", 1.609344},
+		{1, "nmi", "m", 1852},
+		{12, "in", "ft", 1},
+		{100, "cm", "m", 1},
+	}
+	for _, tt := range tests {
+		got, err := convertLength(tt.value, tt.from, tt.to)
+		if err != nil || math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("convertLength(%g, %s, %s) = %g, %v; want %g", tt.value, tt.from, tt.to, got, err, tt.want)
+		}
+	}
+	if _, err := convertLength(1, "m", "league"); err == nil || err.Error() != "unknown unit: league" {
+		t.Errorf("unknown unit error = %v", err)
+	}
+}
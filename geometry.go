@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type GeometryArgs struct {
+	Shape  string   `json:"shape" jsonschema:"The shape (circle, rectangle, or triangle)"`
+	Radius *float64 `json:"radius,omitempty" jsonschema:"Radius of a circle"`
+	Width  *float64 `json:"width,omitempty" jsonschema:"Width of a rectangle"`
+	Height *float64 `json:"height,omitempty" jsonschema:"Height of a rectangle"`
+	A      *float64 `json:"a,omitempty" jsonschema:"First side of a triangle"`
+	B      *float64 `json:"b,omitempty" jsonschema:"Second side of a triangle"`
+	C      *float64 `json:"c,omitempty" jsonschema:"Third side of a triangle"`
+}
+
+type namedDimension struct {
+	name  string
+	value *float64
+}
+
+// dimensions lists every dimension argument in declaration order, with a nil
+// value when it was not supplied.
+func (a GeometryArgs) dimensions() []namedDimension {
+	return []namedDimension{
+		{"radius", a.Radius},
+		{"width", a.Width},
+		{"height", a.Height},
+		{"a", a.A},
+		{"b", a.B},
+		{"c", a.C},
+	}
+}
+
+var shapeDimensions = map[string][]string{
+	"circle":    {"radius"},
+	"rectangle": {"width", "height"},
+	"triangle":  {"a", "b", "c"},
+}
+
+// triangleArea applies Heron's formula in Kahan's rearrangement, which stays
+// accurate for needle-like triangles. The sides must satisfy the triangle
+// inequality.
+func triangleArea(a, b, c float64) float64 {
+	sides := []float64{a, b, c}
+	slices.Sort(sides)
+	c, b, a = sides[0], sides[1], sides[2]
+	return math.Sqrt((a+(b+c))*(c-(a-b))*(c+(a-b))*(a+(b-c))) / 4
+}
+
+func handleGeometry(ctx context.Context, req *mcp.CallToolRequest, args GeometryArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("geometry called for shape: %s", args.Shape))
+
+	shape := strings.ToLower(strings.TrimSpace(args.Shape))
+	required, ok := shapeDimensions[shape]
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported shape: %s", args.Shape)), nil, nil
+	}
+
+	values := map[string]float64{}
+	for _, d := range args.dimensions() {
+		if d.value == nil {
+			continue
+		}
+		if !slices.Contains(required, d.name) {
+			return errorResult(fmt.Sprintf("'%s' cannot be used with a %s", d.name, shape)), nil, nil
+		}
+		values[d.name] = *d.value
+	}
+	for _, name := range required {
+		value, ok := values[name]
+		if !ok {
+			return errorResult(fmt.Sprintf("'%s' is required for a %s", name, shape)), nil, nil
+		}
+		if math.IsNaN(value) || math.IsInf(value, 0) || value <= 0 {
+			return errorResult(fmt.Sprintf("'%s' must be a positive finite number", name)), nil, nil
+		}
+	}
+
+	var area, perimeter float64
+	perimeterName := "perimeter"
+	switch shape {
+	case "circle":
+		r := values["radius"]
+		area = math.Pi * r * r
+		perimeter = 2 * math.Pi * r
+		perimeterName = "circumference"
+	case "rectangle":
+		w, h := values["width"], values["height"]
+		area = w * h
+		perimeter = 2 * (w + h)
+	case "triangle":
+		a, b, c := values["a"], values["b"], values["c"]
+		if a+b <= c || a+c <= b || b+c <= a {
+			return errorResult(fmt.Sprintf("Sides %g, %g, %g violate the triangle inequality: each side must be shorter than the sum of the other two", a, b, c)), nil, nil
+		}
+		area = triangleArea(a, b, c)
+		perimeter = a + b + c
+	}
+	if math.IsInf(area, 0) || math.IsInf(perimeter, 0) {
+		return errorResult("Result is too large to represent"), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("Area: %g\n%s%s: %g", area, strings.ToUpper(perimeterName[:1]), perimeterName[1:], perimeter)),
+		map[string]any{
+			"shape":     shape,
+			"area":      area,
+			"perimeter": perimeter,
+		}, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeometry(t *testing.T) {
+	runToolCases(t, handleGeometry, []toolCase[GeometryArgs]{
+		{
+			name: "circle",
+			args: GeometryArgs{Shape: "circle", Radius: ptr(2.0)},
+			text: "Area: 12.566370614359172\nCircumference: 12.566370614359172",
+			out:  `{"shape":"circle","area":12.566370614359172,"perimeter":12.566370614359172}`,
+		},
+		{
+			name: "rectangle",
+			args: GeometryArgs{Shape: "Rectangle", Width: ptr(3.0), Height: ptr(4.0)},
+			text: "Area: 12\nPerimeter: 14",
+			out:  `{"shape":"rectangle","area":12,"perimeter":14}`,
+		},
+		{
+			name: "right triangle",
+			args: GeometryArgs{Shape: "triangle", A: ptr(3.0), B: ptr(4.0), C: ptr(5.0)},
+			text: "Area: 6\nPerimeter: 12",
+			out:  `{"shape":"triangle","area":6,"perimeter":12}`,
+		},
+		{
+			name: "sides in any order",
+			args: GeometryArgs{Shape: "triangle", A: ptr(5.0), B: ptr(3.0), C: ptr(4.0)},
+			out:  `{"area":6}`,
+		},
+		{name: "degenerate triangle", args: GeometryArgs{Shape: "triangle", A: ptr(1.0), B: ptr(2.0), C: ptr(3.0)}, err: true, text: "Sides 1, 2, 3 violate the triangle inequality: each side must be shorter than the sum of the other two"},
+		{name: "impossible triangle", args: GeometryArgs{Shape: "triangle", A: ptr(1.0), B: ptr(2.0), C: ptr(10.0)}, err: true, text: "Sides 1, 2, 10 violate the triangle inequality: each side must be shorter than the sum of the other two"},
+		{name: "missing dimension", args: GeometryArgs{Shape: "rectangle", Width: ptr(3.0)}, err: true, text: "'height' is required for a rectangle"},
+		{name: "wrong dimension", args: GeometryArgs{Shape: "circle", Radius: ptr(1.0), Width: ptr(2.0)}, err: true, text: "'width' cannot be used with a circle"},
+		{name: "zero radius", args: GeometryArgs{Shape: "circle", Radius: ptr(0.0)}, err: true, text: "'radius' must be a positive finite number"},
+		{name: "negative side", args: GeometryArgs{Shape: "triangle", A: ptr(3.0), B: ptr(-4.0), C: ptr(5.0)}, err: true, text: "'b' must be a positive finite number"},
+		{name: "infinite width", args: GeometryArgs{Shape: "rectangle", Width: ptr(math.Inf(1)), Height: ptr(1.0)}, err: true, text: "'width' must be a positive finite number"},
+		{name: "unknown shape", args: GeometryArgs{Shape: "hexagon"}, err: true, text: "Unsupported shape: hexagon"},
+		{name: "overflow", args: GeometryArgs{Shape: "rectangle", Width: ptr(1e200), Height: ptr(1e200)}, err: true, text: "Result is too large to represent"},
+	})
+}
+
+func TestGeometryNeedleTriangle(t *testing.T) {
+	// Plain Heron's formula loses most of its digits on a triangle this flat.
+	// It is isosceles, so the height over the long side c is
+	// sqrt(1 - (c/2)^2) = sqrt((1 - c/2) * (1 + c/2)).
+	half := 1 - 5e-15
+	result, out := callTool(t, handleGeometry, GeometryArgs{Shape: "triangle", A: ptr(1.0), B: ptr(1.0), C: ptr(2 * half)})
+	if result.IsError {
+		t.Fatal(resultText(result))
+	}
+	want := half * math.Sqrt((1-half)*(1+half))
+	if got := numberField(t, out, "area"); math.Abs(got-want) > want*1e-6 {
+		t.Errorf("area = %g, want %g", got, want)
+	}
+}
@@ -5,6 +5,7 @@ go 1.23.0
 require (
 	github.com/google/jsonschema-go v0.4.2
 	github.com/modelcontextprotocol/go-sdk v1.2.0
+	golang.org/x/text v0.28.0
 )
 
 require (
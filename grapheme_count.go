@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type GraphemeCountArgs struct {
+	Text string `json:"text" jsonschema:"The text to measure"`
+}
+
+// graphemeClass is the subset of Unicode grapheme cluster break properties
+// used by graphemeClusters.
+type graphemeClass int
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRegionalIndicator
+	gcSpacingMark
+	gcL
+	gcV
+	gcT
+	gcLV
+	gcLVT
+)
+
+func classifyGrapheme(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return gcCR
+	case r == '\n':
+		return gcLF
+	case r == zeroWidthJoiner:
+		return gcZWJ
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gcRegionalIndicator
+	case unicode.In(r, unicode.Mn, unicode.Me),
+		r >= 0xFE00 && r <= 0xFE0F,   // variation selectors
+		r >= 0x1F3FB && r <= 0x1F3FF, // emoji skin-tone modifiers
+		r >= 0xE0020 && r <= 0xE007F: // tags used by subdivision flags
+		return gcExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcSpacingMark
+	case unicode.IsControl(r), unicode.Is(unicode.Cf, r), r == 0x2028, r == 0x2029:
+		return gcControl
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gcL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gcV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gcT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gcLV
+		}
+		return gcLVT
+	}
+	return gcOther
+}
+
+// isExtendedPictographic approximates the Extended_Pictographic property
+// with the blocks that hold emoji.
+func isExtendedPictographic(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF,
+		r >= 0x2600 && r <= 0x27BF,
+		r >= 0x2300 && r <= 0x23FF,
+		r >= 0x2190 && r <= 0x21FF,
+		r >= 0x2B00 && r <= 0x2BFF,
+		r == 0x00A9, r == 0x00AE, r == 0x203C, r == 0x2049, r == 0x2122, r == 0x2139,
+		r == 0x3030, r == 0x303D, r == 0x3297, r == 0x3299:
+		return true
+	}
+	return false
+}
+
+// graphemeClusters splits s into user-perceived characters following the
+// main rules of Unicode's extended grapheme cluster algorithm (UAX #29):
+// CR LF stays together, combining marks, spacing marks, and zero-width
+// joiners attach to the preceding character, Hangul jamo combine into
+// syllables, emoji joined by a zero-width joiner form one cluster, and
+// regional indicators pair into flags. Prepend characters are not handled.
+func graphemeClusters(s string) []string {
+	var clusters []string
+	start := 0
+	var prev graphemeClass
+	prevPictographic := false // the current cluster so far is an emoji (plus extenders)
+	regionalRun := 0
+
+	for i, r := range s {
+		class := classifyGrapheme(r)
+		if i > 0 && graphemeBreak(prev, class, prevPictographic, regionalRun, isExtendedPictographic(r)) {
+			clusters = append(clusters, s[start:i])
+			start = i
+			prevPictographic = false
+			regionalRun = 0
+		}
+
+		switch {
+		case isExtendedPictographic(r):
+			prevPictographic = true
+		case class != gcExtend && class != gcZWJ:
+			prevPictographic = false
+		}
+		if class == gcRegionalIndicator {
+			regionalRun++
+		}
+		prev = class
+	}
+	if start < len(s) {
+		clusters = append(clusters, s[start:])
+	}
+	return clusters
+}
+
+// graphemeBreak reports whether there is a cluster boundary between a
+// character of class prev and one of class next.
+func graphemeBreak(prev, next graphemeClass, pictographic bool, regionalRun int, nextPictographic bool) bool {
+	switch {
+	case prev == gcCR && next == gcLF:
+		return false
+	case prev == gcCR, prev == gcLF, prev == gcControl,
+		next == gcCR, next == gcLF, next == gcControl:
+		return true
+	case prev == gcL && (next == gcL || next == gcV || next == gcLV || next == gcLVT),
+		(prev == gcLV || prev == gcV) && (next == gcV || next == gcT),
+		(prev == gcLVT || prev == gcT) && next == gcT:
+		return false
+	case next == gcExtend, next == gcZWJ, next == gcSpacingMark:
+		return false
+	case prev == gcZWJ && pictographic && nextPictographic:
+		return false
+	case prev == gcRegionalIndicator && next == gcRegionalIndicator:
+		return regionalRun%2 == 0
+	}
+	return true
+}
+
+func handleGraphemeCount(ctx context.Context, req *mcp.CallToolRequest, args GraphemeCountArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("grapheme_count called with %d bytes", len(args.Text)))
+
+	graphemes := len(graphemeClusters(args.Text))
+	runes := utf8.RuneCountInString(args.Text)
+
+	return textResult(fmt.Sprintf("Graphemes: %d\nRunes: %d\nBytes: %d", graphemes, runes, len(args.Text))),
+		map[string]any{
+			"graphemes": graphemes,
+			"runes":     runes,
+			"bytes":     len(args.Text),
+		}, nil
+}
@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestGraphemeCount(t *testing.T) {
+	runToolCases(t, handleGraphemeCount, []toolCase[GraphemeCountArgs]{
+		{
+			name: "country flag",
+			args: GraphemeCountArgs{Text: "\U0001F1EF\U0001F1F5"},
+			text: "Graphemes: 1\nRunes: 2\nBytes: 8",
+			out:  `{"graphemes":1,"runes":2,"bytes":8}`,
+		},
+		{
+			name: "combining mark",
+			args: GraphemeCountArgs{Text: "cafe\u0301"},
+			text: "Graphemes: 4\nRunes: 5\nBytes: 6",
+			out:  `{"graphemes":4,"runes":5,"bytes":6}`,
+		},
+		{name: "odd regional indicator", args: GraphemeCountArgs{Text: "\U0001F1EF\U0001F1F5\U0001F1FA"}, out: `{"graphemes":2,"runes":3,"bytes":12}`},
+		{name: "zwj sequence", args: GraphemeCountArgs{Text: "\U0001F468\u200d\U0001F469\u200d\U0001F467"}, out: `{"graphemes":1,"runes":5,"bytes":18}`},
+		{name: "skin tone", args: GraphemeCountArgs{Text: "\U0001F44D\U0001F3FD"}, out: `{"graphemes":1,"runes":2,"bytes":8}`},
+		{name: "hangul jamo", args: GraphemeCountArgs{Text: "\u1112\u1161\u11ab\ud55c"}, out: `{"graphemes":2,"runes":4,"bytes":12}`},
+		{name: "crlf", args: GraphemeCountArgs{Text: "a\r\nb"}, out: `{"graphemes":3,"runes":4,"bytes":4}`},
+		{name: "empty", args: GraphemeCountArgs{}, text: "Graphemes: 0\nRunes: 0\nBytes: 0", out: `{"graphemes":0,"runes":0,"bytes":0}`},
+	})
+}
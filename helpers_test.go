@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// toolCase is one row of a table-driven tool test. text is the expected text
+// content and contains lists substrings it must include; either may be left
+// empty. out is a JSON object whose keys must all appear in the structured
+// output with equal values. Error results must carry no structured output.
+type toolCase[In any] struct {
+	name     string
+	args     In
+	err      bool
+	text     string
+	contains []string
+	out      string
+}
+
+// runToolCases calls handler with the arguments of each case and checks the
+// result.
+func runToolCases[In, Out any](t *testing.T, handler mcp.ToolHandlerFor[In, Out], cases []toolCase[In]) {
+	t.Helper()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, out := callTool(t, handler, c.args)
+			checkResult(t, result, out, c)
+		})
+	}
+}
+
+// callTool invokes handler directly, dropping the output of error results
+// as addTool does, and returns the result with the structured output
+// decoded back from JSON.
+func callTool[In, Out any](t *testing.T, handler mcp.ToolHandlerFor[In, Out], args In) (*mcp.CallToolResult, any) {
+	t.Helper()
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "test"}}
+	result, out, err := withoutErrorOutput(handler)(context.Background(), req, args)
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if result == nil {
+		t.Fatal("handler returned a nil result")
+	}
+	if out == nil {
+		return result, nil
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		t.Fatalf("marshaling output: %v", err)
+	}
+	decoded, err := decodeJSON(string(data))
+	if err != nil {
+		t.Fatalf("decoding output: %v", err)
+	}
+	return result, decoded
+}
+
+// resultText joins the text content of a result, one block per line.
+func resultText(result *mcp.CallToolResult) string {
+	var texts []string
+	for _, c := range result.Content {
+		if tc, ok := c.(*mcp.TextContent); ok {
+			texts = append(texts, tc.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+func checkResult[In any](t *testing.T, result *mcp.CallToolResult, out any, c toolCase[In]) {
+	t.Helper()
+	text := resultText(result)
+	if result.IsError != c.err {
+		t.Fatalf("IsError = %t, want %t (text %q)", result.IsError, c.err, text)
+	}
+	if c.text != "" && text != c.text {
+		t.Errorf("text = %q, want %q", text, c.text)
+	}
+	for _, s := range c.contains {
+		if !strings.Contains(text, s) {
+			t.Errorf("text %q does not contain %q", text, s)
+		}
+	}
+	if c.err {
+		if out != nil {
+			t.Errorf("error result has structured output %s", compactJSON(out))
+		}
+		return
+	}
+	if c.out != "" {
+		checkOutput(t, out, c.out)
+	}
+}
+
+// checkOutput reports the keys of the JSON object want whose values differ
+// from those in the decoded structured output got.
+func checkOutput(t *testing.T, got any, want string) {
+	t.Helper()
+	w, err := decodeJSON(want)
+	if err != nil {
+		t.Fatalf("bad expected output %s: %v", want, err)
+	}
+	fields, ok := got.(map[string]any)
+	if !ok {
+		t.Fatalf("structured output = %s, want an object", compactJSON(got))
+	}
+	for k, v := range w.(map[string]any) {
+		g, ok := fields[k]
+		if !ok {
+			t.Errorf("structured output has no %q", k)
+			continue
+		}
+		if !jsonEqual(g, v) {
+			t.Errorf("%s = %s, want %s", k, compactJSON(g), compactJSON(v))
+		}
+	}
+}
+
+// setConfig replaces the server configuration for the rest of a test.
+func setConfig(t *testing.T, cfg serverConfig) {
+	t.Helper()
+	saved := config
+	config = cfg
+	t.Cleanup(func() { config = saved })
+}
+
+// connectServer starts a server from the current config, as main does, and
+// returns a client session connected to it in memory. opts may be nil.
+func connectServer(t *testing.T, opts *mcp.ClientOptions) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	server, closeServer, err := newServer()
+	if err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, opts)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() {
+		session.Close()
+		serverSession.Wait()
+		closeServer()
+	})
+	return session
+}
+
+// callRemote calls a tool through session and returns the result with its
+// structured content decoded.
+func callRemote(t *testing.T, session *mcp.ClientSession, params *mcp.CallToolParams) (*mcp.CallToolResult, any) {
+	t.Helper()
+	result, err := session.CallTool(context.Background(), params)
+	if err != nil {
+		t.Fatalf("calling %s: %v", params.Name, err)
+	}
+	if result.StructuredContent == nil {
+		return result, nil
+	}
+	data, err := json.Marshal(result.StructuredContent)
+	if err != nil {
+		t.Fatalf("marshaling structured content: %v", err)
+	}
+	decoded, err := decodeJSON(string(data))
+	if err != nil {
+		t.Fatalf("decoding structured content: %v", err)
+	}
+	return result, decoded
+}
+
+// ptr returns a pointer to v, for optional arguments.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// numberField returns the numeric value of key in a decoded structured
+// output, for values that are compared within a tolerance.
+func numberField(t *testing.T, out any, key string) float64 {
+	t.Helper()
+	fields, ok := out.(map[string]any)
+	if !ok {
+		t.Fatalf("structured output = %s, want an object", compactJSON(out))
+	}
+	n, ok := fields[key].(json.Number)
+	if !ok {
+		t.Fatalf("%s = %s, want a number", key, compactJSON(fields[key]))
+	}
+	f, err := n.Float64()
+	if err != nil {
+		t.Fatalf("%s: %v", key, err)
+	}
+	return f
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type HighlightArgs struct {
+	Text      string   `json:"text" jsonschema:"The text to search"`
+	Terms     []string `json:"terms" jsonschema:"Search terms to highlight, matched case-insensitively"`
+	Open      *string  `json:"open,omitempty" jsonschema:"Marker inserted before each match (default **)"`
+	Close     *string  `json:"close,omitempty" jsonschema:"Marker inserted after each match (default: the open marker)"`
+	WholeWord bool     `json:"whole_word,omitempty" jsonschema:"Only match terms that are not part of a longer word"`
+}
+
+type termCount struct {
+	Term  string `json:"term"`
+	Count int    `json:"count"`
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// atWordBoundaries reports whether text[start:end] is not joined to a word
+// character on either side.
+func atWordBoundaries(text string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+		return false
+	}
+	if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(after) {
+		return false
+	}
+	return true
+}
+
+// highlightTerms wraps every match of terms in the two markers. Matches never
+// overlap: scanning left to right, the longest term matching at a position
+// wins, so "new york" is preferred over "new", and scanning resumes after it.
+// Counts are per term, in input order.
+func highlightTerms(text string, terms []string, openMarker, closeMarker string, wholeWord bool) (string, []termCount) {
+	counts := make([]termCount, len(terms))
+	order := make([]int, len(terms))
+	anchored := make([]*regexp.Regexp, len(terms))
+	alternatives := make([]string, len(terms))
+	for i, term := range terms {
+		counts[i] = termCount{Term: term}
+		order[i] = i
+		anchored[i] = regexp.MustCompile(`\A(?i:` + regexp.QuoteMeta(term) + `)`)
+		alternatives[i] = regexp.QuoteMeta(term)
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return utf8.RuneCountInString(terms[b]) - utf8.RuneCountInString(terms[a])
+	})
+	// candidates finds the next position where some term starts, so the
+	// per-term checks only run where a match is possible.
+	candidates := regexp.MustCompile(`(?i)` + strings.Join(alternatives, "|"))
+
+	var b strings.Builder
+	pos := 0
+	for pos < len(text) {
+		loc := candidates.FindStringIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+		start := pos + loc[0]
+
+		matched := -1
+		end := 0
+		for _, i := range order {
+			m := anchored[i].FindStringIndex(text[start:])
+			if m == nil || m[1] == 0 || wholeWord && !atWordBoundaries(text, start, start+m[1]) {
+				continue
+			}
+			matched, end = i, start+m[1]
+			break
+		}
+
+		if matched < 0 {
+			_, size := utf8.DecodeRuneInString(text[start:])
+			b.WriteString(text[pos : start+size])
+			pos = start + size
+			continue
+		}
+		counts[matched].Count++
+		b.WriteString(text[pos:start])
+		b.WriteString(openMarker)
+		b.WriteString(text[start:end])
+		b.WriteString(closeMarker)
+		pos = end
+	}
+	b.WriteString(text[pos:])
+	return b.String(), counts
+}
+
+func handleHighlight(ctx context.Context, req *mcp.CallToolRequest, args HighlightArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("highlight called with %d terms over %d bytes", len(args.Terms), len(args.Text)))
+
+	terms := []string{}
+	for _, term := range args.Terms {
+		if term == "" {
+			return errorResult("Terms must not be empty"), nil, nil
+		}
+		if !slices.ContainsFunc(terms, func(t string) bool { return strings.EqualFold(t, term) }) {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) == 0 {
+		return errorResult("At least one term is required"), nil, nil
+	}
+
+	openMarker := "**"
+	if args.Open != nil {
+		openMarker = *args.Open
+	}
+	closeMarker := openMarker
+	if args.Close != nil {
+		closeMarker = *args.Close
+	}
+
+	highlighted, counts := highlightTerms(args.Text, terms, openMarker, closeMarker, args.WholeWord)
+	total := 0
+	for _, c := range counts {
+		total += c.Count
+	}
+
+	return textResult(highlighted), map[string]any{
+		"text":        highlighted,
+		"counts":      counts,
+		"total_count": total,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+	runToolCases(t, handleHighlight, []toolCase[HighlightArgs]{
+		{
+			name: "multiple terms",
+			args: HighlightArgs{Text: "Go is great. I love go and Golang.", Terms: []string{"go", "love"}},
+			text: "**Go** is great. I **love** **go** and **Go**lang.",
+			out: `{"text":"**Go** is great. I **love** **go** and **Go**lang.",
+				"counts":[{"term":"go","count":3},{"term":"love","count":1}],"total_count":4}`,
+		},
+		{
+			name: "whole word",
+			args: HighlightArgs{Text: "Go is great. I love go and Golang.", Terms: []string{"go"}, WholeWord: true},
+			text: "**Go** is great. I love **go** and Golang.",
+			out:  `{"counts":[{"term":"go","count":2}],"total_count":2}`,
+		},
+		{
+			name: "whole word with non-ASCII letters",
+			args: HighlightArgs{Text: "café_au cafés café", Terms: []string{"café"}, WholeWord: true, Open: ptr(""), Close: ptr("|")},
+			text: "café_au cafés café|",
+			out:  `{"total_count":1}`,
+		},
+		{
+			name: "longest overlapping term wins",
+			args: HighlightArgs{Text: "I moved to New York from new jersey", Terms: []string{"new", "new york"}},
+			text: "I moved to **New York** from **new** jersey",
+			out:  `{"counts":[{"term":"new","count":1},{"term":"new york","count":1}]}`,
+		},
+		{
+			name: "shorter term when longer is not a whole word",
+			args: HighlightArgs{Text: "new yorker", Terms: []string{"new", "new york"}, WholeWord: true},
+			text: "**new** yorker",
+			out:  `{"counts":[{"term":"new","count":1},{"term":"new york","count":0}]}`,
+		},
+		{
+			name: "matches do not overlap",
+			args: HighlightArgs{Text: "aaa", Terms: []string{"aa"}},
+			text: "**aa**a",
+			out:  `{"total_count":1}`,
+		},
+		{
+			name: "custom markers",
+			args: HighlightArgs{Text: "cat catalog", Terms: []string{"cat"}, Open: ptr("<mark>"), Close: ptr("</mark>")},
+			text: "<mark>cat</mark> <mark>cat</mark>alog",
+		},
+		{
+			name: "terms are literal",
+			args: HighlightArgs{Text: "a.b axb a+b", Terms: []string{"a.b", "+"}, Open: ptr("["), Close: ptr("]")},
+			text: "[a.b] axb a[+]b",
+			out:  `{"counts":[{"term":"a.b","count":1},{"term":"+","count":1}]}`,
+		},
+		{
+			name: "case-insensitive duplicates merged",
+			args: HighlightArgs{Text: "x", Terms: []string{"Go", "go"}},
+			text: "x",
+			out:  `{"counts":[{"term":"Go","count":0}],"total_count":0}`,
+		},
+		{
+			name: "no terms",
+			args: HighlightArgs{Text: "x", Terms: []string{}},
+			err:  true,
+			text: "At least one term is required",
+		},
+		{
+			name: "empty term",
+			args: HighlightArgs{Text: "x", Terms: []string{""}},
+			err:  true,
+			text: "Terms must not be empty",
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type IdenticonArgs struct {
+	Identifier string `json:"identifier" jsonschema:"Email address or username to derive the identicon from"`
+	Size       int    `json:"size,omitempty" jsonschema:"Grid width and height in cells (4-15, default 5)"`
+	Render     string `json:"render,omitempty" jsonschema:"Output style (unicode for full blocks or ascii for ## pairs). Defaults to unicode"`
+}
+
+// normalizeIdentifier trims the identifier and lower-cases it when it looks
+// like an email address, as Gravatar does, so that "User@Example.com " and
+// "user@example.com" produce the same identicon.
+func normalizeIdentifier(id string) string {
+	id = strings.TrimSpace(id)
+	if strings.Contains(id, "@") {
+		id = strings.ToLower(id)
+	}
+	return id
+}
+
+// identiconGrid derives a horizontally symmetric size x size grid from hash:
+// one bit per cell of the left half (including the middle column), read from
+// the hash in order, then mirrored onto the right half.
+func identiconGrid(hash []byte, size int) [][]bool {
+	half := (size + 1) / 2
+	grid := make([][]bool, size)
+	bit := 0
+	for y := range grid {
+		grid[y] = make([]bool, size)
+		for x := 0; x < half; x++ {
+			on := hash[bit/8]&(0x80>>(bit%8)) != 0
+			grid[y][x] = on
+			grid[y][size-1-x] = on
+			bit++
+		}
+	}
+	return grid
+}
+
+func handleIdenticon(ctx context.Context, req *mcp.CallToolRequest, args IdenticonArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("identicon called: size=%d", args.Size))
+
+	id := normalizeIdentifier(args.Identifier)
+	if id == "" {
+		return errorResult("Please provide a non-empty 'identifier'"), nil, nil
+	}
+
+	size := args.Size
+	if size == 0 {
+		size = 5
+	}
+	if size < 4 || size > 15 {
+		return errorResult("Size must be between 4 and 15"), nil, nil
+	}
+
+	render := args.Render
+	if render == "" {
+		render = "unicode"
+	}
+	if render != "unicode" && render != "ascii" {
+		return errorResult(fmt.Sprintf("Unsupported render style: %s", args.Render)), nil, nil
+	}
+	on, off := "██", "  "
+	if render == "ascii" {
+		on, off = "##", ".."
+	}
+
+	sum := sha256.Sum256([]byte(id))
+	// The last three bytes are not used by any grid of up to 15x15 cells
+	// (15 rows x 8 columns = 120 bits), so they choose the colour.
+	color := fmt.Sprintf("#%02x%02x%02x", sum[29], sum[30], sum[31])
+	grid := identiconGrid(sum[:], size)
+
+	rows := make([]string, size)
+	matrix := make([]string, size)
+	for y, row := range grid {
+		var b, m strings.Builder
+		for _, cell := range row {
+			if cell {
+				b.WriteString(on)
+				m.WriteByte('1')
+			} else {
+				b.WriteString(off)
+				m.WriteByte('0')
+			}
+		}
+		rows[y] = b.String()
+		matrix[y] = m.String()
+	}
+
+	return textResult(strings.Join(rows, "\n")), map[string]any{
+		"hash":       hex.EncodeToString(sum[:]),
+		"algorithm":  "sha256",
+		"normalized": id,
+		"size":       size,
+		"color":      color,
+		"matrix":     matrix,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIdenticon(t *testing.T) {
+	runToolCases(t, handleIdenticon, []toolCase[IdenticonArgs]{
+		{
+			name: "normalized email",
+			args: IdenticonArgs{Identifier: " User@Example.com ", Render: "ascii"},
+			text: "##..##..##\n##..##..##\n....##....\n##......##\n##......##",
+			out: `{
+				"hash": "b4c9a289323b21a01c3e940f150eb9b8c542587f1abfd8f0e1cc1ffc5e475514",
+				"algorithm": "sha256",
+				"normalized": "user@example.com",
+				"size": 5,
+				"color": "#475514",
+				"matrix": ["10101", "10101", "00100", "10001", "10001"]
+			}`,
+		},
+		{
+			name: "username keeps case",
+			args: IdenticonArgs{Identifier: "Alice", Size: 4},
+			text: "        \n████████\n██    ██\n████████",
+			out:  `{"normalized":"Alice","size":4,"matrix":["0000","1111","1001","1111"]}`,
+		},
+		{name: "empty identifier", args: IdenticonArgs{Identifier: "  "}, err: true, text: "Please provide a non-empty 'identifier'"},
+		{name: "size too small", args: IdenticonArgs{Identifier: "a", Size: 3}, err: true, text: "Size must be between 4 and 15"},
+		{name: "size too large", args: IdenticonArgs{Identifier: "a", Size: 16}, err: true, text: "Size must be between 4 and 15"},
+		{name: "unknown render", args: IdenticonArgs{Identifier: "a", Render: "svg"}, err: true, text: "Unsupported render style: svg"},
+	})
+}
+
+func TestIdenticonDeterministic(t *testing.T) {
+	matrix := func(id string, size int) []any {
+		t.Helper()
+		result, out := callTool(t, handleIdenticon, IdenticonArgs{Identifier: id, Size: size})
+		if result.IsError {
+			t.Fatalf("identicon(%q): %s", id, resultText(result))
+		}
+		return out.(map[string]any)["matrix"].([]any)
+	}
+
+	for _, size := range []int{4, 5, 15} {
+		first := matrix("user@example.com", size)
+		if again := matrix("USER@example.com", size); !reflect.DeepEqual(first, again) {
+			t.Errorf("size %d: same identifier gave %v and %v", size, first, again)
+		}
+		if other := matrix("other@example.com", size); reflect.DeepEqual(first, other) {
+			t.Errorf("size %d: different identifiers both gave %v", size, first)
+		}
+		if len(first) != size {
+			t.Fatalf("size %d: got %d rows", size, len(first))
+		}
+		for _, r := range first {
+			row := r.(string)
+			for x := range row {
+				if row[x] != row[size-1-x] {
+					t.Errorf("size %d: row %q is not symmetric", size, row)
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type InterestArgs struct {
+	Principal        float64 `json:"principal" jsonschema:"The initial amount"`
+	Rate             float64 `json:"rate" jsonschema:"Annual interest rate as a percentage (e.g. 5 for 5%)"`
+	Years            float64 `json:"years" jsonschema:"Time period in years"`
+	Type             string  `json:"type,omitempty" jsonschema:"Interest type (compound or simple). Defaults to compound"`
+	CompoundsPerYear *int    `json:"compounds_per_year,omitempty" jsonschema:"Number of compounding periods per year for compound interest (default 1)"`
+	Currency         string  `json:"currency,omitempty" jsonschema:"Optional currency code or symbol used to format the amounts"`
+}
+
+func handleInterest(ctx context.Context, req *mcp.CallToolRequest, args InterestArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("interest called: principal=%.2f rate=%.4f years=%.2f type=%q", args.Principal, args.Rate, args.Years, args.Type))
+
+	if args.Principal < 0 || args.Rate < 0 || args.Years < 0 {
+		return errorResult("Principal, rate, and years must be non-negative"), nil, nil
+	}
+
+	interestType := args.Type
+	if interestType == "" {
+		interestType = "compound"
+	}
+
+	rate := args.Rate / 100
+	var amount float64
+
+	switch interestType {
+	case "simple":
+		if args.CompoundsPerYear != nil {
+			return errorResult("'compounds_per_year' cannot be used with simple interest"), nil, nil
+		}
+		amount = args.Principal * (1 + rate*args.Years)
+	case "compound":
+		n := 1
+		if args.CompoundsPerYear != nil {
+			n = *args.CompoundsPerYear
+			if n < 1 {
+				return errorResult("Compounds per year must be at least 1"), nil, nil
+			}
+		}
+		amount = args.Principal * math.Pow(1+rate/float64(n), float64(n)*args.Years)
+	default:
+		return errorResult(fmt.Sprintf("Unsupported interest type: %s", args.Type)), nil, nil
+	}
+
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return errorResult("Interest calculation resulted in invalid value"), nil, nil
+	}
+
+	interest := amount - args.Principal
+	result := map[string]any{
+		"final_amount":   amount,
+		"total_interest": interest,
+		"type":           interestType,
+	}
+
+	formattedAmount := fmt.Sprintf("%.2f", amount)
+	formattedInterest := fmt.Sprintf("%.2f", interest)
+	if args.Currency != "" {
+		code, _, ok := normalizeCurrency(args.Currency)
+		if !ok {
+			return errorResult(fmt.Sprintf("Unsupported currency: %s", args.Currency)), nil, nil
+		}
+		formattedAmount = formatMoney(amount, currencies[code])
+		formattedInterest = formatMoney(interest, currencies[code])
+		result["currency"] = code
+		result["formatted_final_amount"] = formattedAmount
+		result["formatted_total_interest"] = formattedInterest
+	}
+
+	return textResult(fmt.Sprintf("Final amount: %s\nTotal interest: %s", formattedAmount, formattedInterest)), result, nil
+}
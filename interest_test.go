@@ -0,0 +1,40 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterest(t *testing.T) {
+	runToolCases(t, handleInterest, []toolCase[InterestArgs]{
+		{name: "compound annually", args: InterestArgs{Principal: 1000, Rate: 5, Years: 10}, text: "Final amount: 1628.89\nTotal interest: 628.89", out: `{"type":"compound"}`},
+		{name: "compound monthly", args: InterestArgs{Principal: 10000, Rate: 6, Years: 5, CompoundsPerYear: ptr(12), Currency: "usd"}, text: "Final amount: $13488.50\nTotal interest: $3488.50", out: `{"currency":"USD","formatted_final_amount":"$13488.50","formatted_total_interest":"$3488.50"}`},
+		{name: "simple", args: InterestArgs{Principal: 1000, Rate: 5, Years: 3, Type: "simple"}, text: "Final amount: 1150.00\nTotal interest: 150.00", out: `{"type":"simple"}`},
+		{name: "zero rate", args: InterestArgs{Principal: 500, Years: 2}, text: "Final amount: 500.00\nTotal interest: 0.00"},
+		{name: "simple with compounding", args: InterestArgs{Principal: 1, Rate: 1, Years: 1, Type: "simple", CompoundsPerYear: ptr(4)}, err: true, text: "'compounds_per_year' cannot be used with simple interest"},
+		{name: "no compounding periods", args: InterestArgs{Principal: 1, Rate: 1, Years: 1, CompoundsPerYear: ptr(0)}, err: true, text: "Compounds per year must be at least 1"},
+		{name: "negative", args: InterestArgs{Principal: -1, Rate: 1, Years: 1}, err: true, text: "Principal, rate, and years must be non-negative"},
+		{name: "overflow", args: InterestArgs{Principal: 1e300, Rate: 1000, Years: 1000}, err: true, text: "Interest calculation resulted in invalid value"},
+		{name: "unknown type", args: InterestArgs{Principal: 1, Rate: 1, Years: 1, Type: "continuous"}, err: true, text: "Unsupported interest type: continuous"},
+	})
+}
+
+func TestInterestAmounts(t *testing.T) {
+	tests := []struct {
+		args     InterestArgs
+		amount   float64
+		interest float64
+	}{
+		{InterestArgs{Principal: 1000, Rate: 5, Years: 10}, 1628.894627, 628.894627},
+		{InterestArgs{Principal: 1000, Rate: 5, Years: 3, Type: "simple"}, 1150, 150},
+	}
+	for _, tt := range tests {
+		_, out := callTool(t, handleInterest, tt.args)
+		if got := numberField(t, out, "final_amount"); math.Abs(got-tt.amount) > 1e-6 {
+			t.Errorf("%+v: final_amount = %v, want %v", tt.args, got, tt.amount)
+		}
+		if got := numberField(t, out, "total_interest"); math.Abs(got-tt.interest) > 1e-6 {
+			t.Errorf("%+v: total_interest = %v, want %v", tt.args, got, tt.interest)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type IPInRangeArgs struct {
+	IP     string   `json:"ip" jsonschema:"The IPv4 or IPv6 address to check"`
+	Ranges []string `json:"ranges" jsonschema:"CIDR ranges to check against, e.g. 10.0.0.0/8"`
+}
+
+func handleIPInRange(ctx context.Context, req *mcp.CallToolRequest, args IPInRangeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("ip_in_range called: %s against %d ranges", args.IP, len(args.Ranges)))
+
+	ip := net.ParseIP(strings.TrimSpace(args.IP))
+	if ip == nil {
+		return errorResult(fmt.Sprintf("Invalid IP address: %s", args.IP)), nil, nil
+	}
+	if len(args.Ranges) == 0 {
+		return errorResult("At least one range is required"), nil, nil
+	}
+
+	// Every range is parsed before any is checked, so a malformed entry is
+	// reported even when an earlier range already matched.
+	networks := make([]*net.IPNet, len(args.Ranges))
+	for i, r := range args.Ranges {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(r))
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid CIDR at index %d: %s", i, r)), nil, nil
+		}
+		networks[i] = network
+	}
+
+	matches := []string{}
+	for i, network := range networks {
+		if network.Contains(ip) {
+			matches = append(matches, args.Ranges[i])
+		}
+	}
+
+	text := fmt.Sprintf("%s is not in any of the given ranges", ip)
+	if len(matches) > 0 {
+		text = fmt.Sprintf("%s is in %s", ip, strings.Join(matches, ", "))
+	}
+	return textResult(text), map[string]any{
+		"in_range": len(matches) > 0,
+		"matches":  matches,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestIPInRange(t *testing.T) {
+	private := []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+	runToolCases(t, handleIPInRange, []toolCase[IPInRangeArgs]{
+		{
+			name: "in range",
+			args: IPInRangeArgs{IP: "192.168.1.20", Ranges: private},
+			text: "192.168.1.20 is in 192.168.0.0/16",
+			out:  `{"in_range":true,"matches":["192.168.0.0/16"]}`,
+		},
+		{
+			name: "out of range",
+			args: IPInRangeArgs{IP: "8.8.8.8", Ranges: private},
+			text: "8.8.8.8 is not in any of the given ranges",
+			out:  `{"in_range":false,"matches":[]}`,
+		},
+		{
+			name: "just outside",
+			args: IPInRangeArgs{IP: "172.32.0.1", Ranges: private},
+			out:  `{"in_range":false}`,
+		},
+		{
+			name: "several matches",
+			args: IPInRangeArgs{IP: "10.1.2.3", Ranges: []string{"10.0.0.0/8", "10.1.0.0/16", "11.0.0.0/8"}},
+			text: "10.1.2.3 is in 10.0.0.0/8, 10.1.0.0/16",
+			out:  `{"in_range":true,"matches":["10.0.0.0/8","10.1.0.0/16"]}`,
+		},
+		{name: "ipv6", args: IPInRangeArgs{IP: "2001:db8::1", Ranges: []string{"2001:db8::/32"}}, out: `{"in_range":true}`},
+		{name: "ipv4 against ipv6 range", args: IPInRangeArgs{IP: "10.0.0.1", Ranges: []string{"2001:db8::/32"}}, out: `{"in_range":false}`},
+		{name: "malformed cidr", args: IPInRangeArgs{IP: "10.0.0.1", Ranges: []string{"10.0.0.0/8", "10.0.0.0/40"}}, err: true, text: "Invalid CIDR at index 1: 10.0.0.0/40"},
+		{name: "bare address as range", args: IPInRangeArgs{IP: "10.0.0.1", Ranges: []string{"10.0.0.1"}}, err: true, text: "Invalid CIDR at index 0: 10.0.0.1"},
+		{name: "malformed ip", args: IPInRangeArgs{IP: "10.0.0.256", Ranges: private}, err: true, text: "Invalid IP address: 10.0.0.256"},
+		{name: "no ranges", args: IPInRangeArgs{IP: "10.0.0.1"}, err: true, text: "At least one range is required"},
+	})
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type IPNormalizeArgs struct {
+	Address string `json:"address" jsonschema:"An IPv4 or IPv6 address in any valid notation"`
+}
+
+// expandIPv6 writes all eight groups of a 16-byte address as four hex digits
+// each, with no :: compression.
+func expandIPv6(ip net.IP) string {
+	groups := make([]string, 8)
+	for i := range groups {
+		groups[i] = fmt.Sprintf("%04x", binary.BigEndian.Uint16(ip[i*2:]))
+	}
+	return strings.Join(groups, ":")
+}
+
+func handleIPNormalize(ctx context.Context, req *mcp.CallToolRequest, args IPNormalizeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("ip_normalize called with address: %s", args.Address))
+
+	input := strings.TrimSpace(args.Address)
+	ip := net.ParseIP(input)
+	if ip == nil {
+		return errorResult(fmt.Sprintf("Invalid IP address: %s", args.Address)), nil, nil
+	}
+
+	// An IPv4-mapped IPv6 address such as ::ffff:192.0.2.1 is reported as
+	// IPv6 because that is how it was written.
+	if v4 := ip.To4(); v4 != nil && !strings.Contains(input, ":") {
+		n := binary.BigEndian.Uint32(v4)
+		hex := fmt.Sprintf("0x%08x", n)
+		return textResult(fmt.Sprintf("%s\nInteger: %d\nHex: %s", v4, n, hex)), map[string]any{
+			"version":     4,
+			"canonical":   v4.String(),
+			"integer":     n,
+			"hex":         hex,
+			"ipv4_mapped": "::ffff:" + v4.String(),
+		}, nil
+	}
+
+	// net.IP.String prints IPv4-mapped addresses in dotted form, so the
+	// ::ffff: prefix is restored for them.
+	compressed := ip.String()
+	v4 := ip.To4()
+	if v4 != nil {
+		compressed = "::ffff:" + v4.String()
+	}
+	expanded := expandIPv6(ip.To16())
+	out := map[string]any{
+		"version":    6,
+		"canonical":  compressed,
+		"compressed": compressed,
+		"expanded":   expanded,
+	}
+	if v4 != nil {
+		out["mapped_ipv4"] = v4.String()
+	}
+	return textResult(fmt.Sprintf("Compressed: %s\nExpanded: %s", compressed, expanded)), out, nil
+}
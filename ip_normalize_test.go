@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestIPNormalize(t *testing.T) {
+	runToolCases(t, handleIPNormalize, []toolCase[IPNormalizeArgs]{
+		{
+			name: "expand loopback",
+			args: IPNormalizeArgs{Address: "::1"},
+			text: "Compressed: ::1\nExpanded: 0000:0000:0000:0000:0000:0000:0000:0001",
+			out:  `{"version":6,"canonical":"::1","compressed":"::1","expanded":"0000:0000:0000:0000:0000:0000:0000:0001"}`,
+		},
+		{
+			name: "collapse full form",
+			args: IPNormalizeArgs{Address: "2001:0DB8:0000:0000:0000:ff00:0042:8329"},
+			text: "Compressed: 2001:db8::ff00:42:8329\nExpanded: 2001:0db8:0000:0000:0000:ff00:0042:8329",
+			out:  `{"compressed":"2001:db8::ff00:42:8329"}`,
+		},
+		{
+			name: "ipv4 to integer",
+			args: IPNormalizeArgs{Address: "192.168.1.1"},
+			text: "192.168.1.1\nInteger: 3232235777\nHex: 0xc0a80101",
+			out:  `{"version":4,"canonical":"192.168.1.1","integer":3232235777,"hex":"0xc0a80101","ipv4_mapped":"::ffff:192.168.1.1"}`,
+		},
+		{name: "largest ipv4", args: IPNormalizeArgs{Address: " 255.255.255.255 "}, out: `{"integer":4294967295,"hex":"0xffffffff"}`},
+		{name: "smallest ipv4", args: IPNormalizeArgs{Address: "0.0.0.0"}, out: `{"integer":0,"hex":"0x00000000"}`},
+		{
+			name: "ipv4-mapped ipv6",
+			args: IPNormalizeArgs{Address: "::FFFF:10.0.0.1"},
+			text: "Compressed: ::ffff:10.0.0.1\nExpanded: 0000:0000:0000:0000:0000:ffff:0a00:0001",
+			out:  `{"version":6,"compressed":"::ffff:10.0.0.1","mapped_ipv4":"10.0.0.1"}`,
+		},
+		{name: "invalid", args: IPNormalizeArgs{Address: "1.2.3"}, err: true, text: "Invalid IP address: 1.2.3"},
+		{name: "leading zeros", args: IPNormalizeArgs{Address: "01.2.3.4"}, err: true, text: "Invalid IP address: 01.2.3.4"},
+		{name: "zone", args: IPNormalizeArgs{Address: "fe80::1%eth0"}, err: true, text: "Invalid IP address: fe80::1%eth0"},
+		{name: "empty", args: IPNormalizeArgs{}, err: true, text: "Invalid IP address: "},
+	})
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type IPSubnetArgs struct {
+	CIDR string `json:"cidr" jsonschema:"Network in CIDR notation, e.g. 192.168.1.0/24 or 2001:db8::/32"`
+}
+
+// lastAddress returns the highest address in network, which for IPv4 is the
+// broadcast address.
+func lastAddress(network *net.IPNet) net.IP {
+	last := make(net.IP, len(network.IP))
+	for i := range network.IP {
+		last[i] = network.IP[i] | ^network.Mask[i]
+	}
+	return last
+}
+
+// offsetIP returns ip moved by delta, which must not leave the address space.
+func offsetIP(ip net.IP, delta int64) net.IP {
+	n := new(big.Int).SetBytes(ip)
+	n.Add(n, big.NewInt(delta))
+	out := make(net.IP, len(ip))
+	n.FillBytes(out)
+	return out
+}
+
+func handleIPSubnet(ctx context.Context, req *mcp.CallToolRequest, args IPSubnetArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("ip_subnet called with cidr: %s", args.CIDR))
+
+	ip, network, err := net.ParseCIDR(strings.TrimSpace(args.CIDR))
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid CIDR: %s", args.CIDR)), nil, nil
+	}
+
+	// An IPv4-mapped IPv6 network would be printed in dotted IPv4 form with
+	// an IPv6 prefix length, so it is rejected in favor of its IPv4 form.
+	ones, bits := network.Mask.Size()
+	if bits == 32 {
+		network.IP = network.IP.To4()
+	} else if v4 := network.IP.To4(); v4 != nil {
+		return errorResult(fmt.Sprintf("IPv4-mapped IPv6 networks are not supported; use %s/%d instead", v4, ones-96)), nil, nil
+	}
+	last := lastAddress(network)
+	total := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+
+	out := map[string]any{
+		"network":         network.IP.String(),
+		"prefix_length":   ones,
+		"total_addresses": total.String(),
+		"last_address":    last.String(),
+	}
+	if !ip.Equal(network.IP) {
+		out["host_bits_set"] = true
+	}
+
+	// IPv4 networks reserve the network and broadcast addresses, except for
+	// point-to-point /31 links (RFC 3021) and single-host /32 routes. IPv6
+	// has no broadcast, so every address is usable.
+	first, lastUsable := network.IP, last
+	usable := new(big.Int).Set(total)
+	var lines []string
+	if bits == 32 {
+		netmask := net.IP(network.Mask).String()
+		out["version"] = 4
+		out["netmask"] = netmask
+		out["wildcard"] = lastAddress(&net.IPNet{IP: make(net.IP, 4), Mask: network.Mask}).String()
+		lines = append(lines, fmt.Sprintf("Netmask: %s", netmask))
+		if ones <= 30 {
+			first, lastUsable = offsetIP(network.IP, 1), offsetIP(last, -1)
+			usable.Sub(usable, big.NewInt(2))
+			out["broadcast"] = last.String()
+			lines = append(lines, fmt.Sprintf("Broadcast: %s", last))
+		}
+	} else {
+		out["version"] = 6
+	}
+	out["first_usable"] = first.String()
+	out["last_usable"] = lastUsable.String()
+	out["usable_hosts"] = usable.String()
+
+	lines = append(lines,
+		fmt.Sprintf("Usable range: %s - %s", first, lastUsable),
+		fmt.Sprintf("Usable hosts: %s of %s addresses", usable, total),
+	)
+	text := fmt.Sprintf("Network: %s/%d\n%s", network.IP, ones, strings.Join(lines, "\n"))
+
+	return textResult(text), out, nil
+}
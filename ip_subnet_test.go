@@ -0,0 +1,70 @@
+package main
+
+import "testing"
+
+func TestIPSubnet(t *testing.T) {
+	runToolCases(t, handleIPSubnet, []toolCase[IPSubnetArgs]{
+		{
+			name: "/24",
+			args: IPSubnetArgs{CIDR: "192.168.1.0/24"},
+			text: "Network: 192.168.1.0/24\nNetmask: 255.255.255.0\nBroadcast: 192.168.1.255\nUsable range: 192.168.1.1 - 192.168.1.254\nUsable hosts: 254 of 256 addresses",
+			out: `{
+				"version": 4, "network": "192.168.1.0", "prefix_length": 24,
+				"netmask": "255.255.255.0", "wildcard": "0.0.0.255", "broadcast": "192.168.1.255",
+				"first_usable": "192.168.1.1", "last_usable": "192.168.1.254", "last_address": "192.168.1.255",
+				"usable_hosts": "254", "total_addresses": "256"
+			}`,
+		},
+		{
+			name: "/30 with host bits",
+			args: IPSubnetArgs{CIDR: "10.0.0.5/30"},
+			text: "Network: 10.0.0.4/30\nNetmask: 255.255.255.252\nBroadcast: 10.0.0.7\nUsable range: 10.0.0.5 - 10.0.0.6\nUsable hosts: 2 of 4 addresses",
+			out:  `{"network":"10.0.0.4","broadcast":"10.0.0.7","first_usable":"10.0.0.5","last_usable":"10.0.0.6","usable_hosts":"2","host_bits_set":true}`,
+		},
+		{
+			name: "/31 point to point",
+			args: IPSubnetArgs{CIDR: "10.0.0.0/31"},
+			text: "Network: 10.0.0.0/31\nNetmask: 255.255.255.254\nUsable range: 10.0.0.0 - 10.0.0.1\nUsable hosts: 2 of 2 addresses",
+			out:  `{"first_usable":"10.0.0.0","last_usable":"10.0.0.1","usable_hosts":"2","total_addresses":"2"}`,
+		},
+		{
+			name: "/32 single host",
+			args: IPSubnetArgs{CIDR: "10.0.0.7/32"},
+			text: "Network: 10.0.0.7/32\nNetmask: 255.255.255.255\nUsable range: 10.0.0.7 - 10.0.0.7\nUsable hosts: 1 of 1 addresses",
+			out:  `{"netmask":"255.255.255.255","wildcard":"0.0.0.0","usable_hosts":"1"}`,
+		},
+		{
+			name: "whole ipv4 space",
+			args: IPSubnetArgs{CIDR: "0.0.0.0/0"},
+			out:  `{"usable_hosts":"4294967294","total_addresses":"4294967296","broadcast":"255.255.255.255"}`,
+		},
+		{
+			name: "ipv6",
+			args: IPSubnetArgs{CIDR: " 2001:db8::/32 "},
+			text: "Network: 2001:db8::/32\nUsable range: 2001:db8:: - 2001:db8:ffff:ffff:ffff:ffff:ffff:ffff\nUsable hosts: 79228162514264337593543950336 of 79228162514264337593543950336 addresses",
+			out: `{
+				"version": 6, "network": "2001:db8::", "prefix_length": 32,
+				"first_usable": "2001:db8::", "last_usable": "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff",
+				"usable_hosts": "79228162514264337593543950336", "total_addresses": "79228162514264337593543950336"
+			}`,
+		},
+		{name: "ipv6 /128", args: IPSubnetArgs{CIDR: "2001:db8::1/128"}, out: `{"version":6,"first_usable":"2001:db8::1","last_usable":"2001:db8::1","usable_hosts":"1"}`},
+		{name: "ipv4-mapped ipv6", args: IPSubnetArgs{CIDR: "::ffff:192.168.1.0/120"}, err: true, text: "IPv4-mapped IPv6 networks are not supported; use 192.168.1.0/24 instead"},
+		{name: "prefix too long", args: IPSubnetArgs{CIDR: "192.168.1.0/33"}, err: true, text: "Invalid CIDR: 192.168.1.0/33"},
+		{name: "missing prefix", args: IPSubnetArgs{CIDR: "192.168.1.0"}, err: true, text: "Invalid CIDR: 192.168.1.0"},
+		{name: "bad address", args: IPSubnetArgs{CIDR: "300.1.1.1/8"}, err: true, text: "Invalid CIDR: 300.1.1.1/8"},
+	})
+
+	for _, cidr := range []string{"192.168.1.0/24", "10.0.0.0/31", "2001:db8::/32"} {
+		_, out := callTool(t, handleIPSubnet, IPSubnetArgs{CIDR: cidr})
+		if _, ok := out.(map[string]any)["host_bits_set"]; ok {
+			t.Errorf("%s: host_bits_set reported for a network address", cidr)
+		}
+	}
+	for _, cidr := range []string{"10.0.0.0/31", "10.0.0.7/32", "2001:db8::/32"} {
+		_, out := callTool(t, handleIPSubnet, IPSubnetArgs{CIDR: cidr})
+		if b, ok := out.(map[string]any)["broadcast"]; ok {
+			t.Errorf("%s: broadcast = %v, want none", cidr, b)
+		}
+	}
+}
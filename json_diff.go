@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type JSONDiffArgs struct {
+	Before    string `json:"before" jsonschema:"The original JSON document"`
+	After     string `json:"after" jsonschema:"The updated JSON document"`
+	ArrayMode string `json:"array_mode,omitempty" jsonschema:"How to compare arrays: ordered (default, element by element) or set (order ignored, duplicates counted)"`
+}
+
+type jsonDifference struct {
+	Op     string
+	Path   string
+	Before any
+	After  any
+}
+
+// MarshalJSON omits "before" for additions and "after" for removals while
+// still writing a null value when that is what changed.
+func (d jsonDifference) MarshalJSON() ([]byte, error) {
+	out := map[string]any{"op": d.Op, "path": d.Path}
+	if d.Op != "added" {
+		out["before"] = d.Before
+	}
+	if d.Op != "removed" {
+		out["after"] = d.After
+	}
+	return json.Marshal(out)
+}
+
+// decodeJSON parses a JSON document, keeping numbers as json.Number so that
+// integers beyond float64 precision compare exactly.
+func decodeJSON(text string) (any, error) {
+	// Unmarshaling into a RawMessage reports syntax errors, including
+	// trailing data, the same way json.Unmarshal does.
+	if err := json.Unmarshal([]byte(text), new(json.RawMessage)); err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// canonicalNumber rewrites a JSON number as its significant digits and a
+// power of ten, so that 1, 1.0, and 10e-1 all read "1".
+func canonicalNumber(n json.Number) string {
+	s := string(n)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	digits, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return string(n)
+		}
+		digits, exp = s[:i], e
+	}
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		exp -= len(digits) - i - 1
+		digits = digits[:i] + digits[i+1:]
+	}
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return "0"
+	}
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	if exp != 0 {
+		trimmed += "e" + strconv.Itoa(exp)
+	}
+	return sign + trimmed
+}
+
+// canonicalNumbers returns a copy of a decoded value with every json.Number
+// in canonical form.
+func canonicalNumbers(v any) any {
+	switch x := v.(type) {
+	case json.Number:
+		return json.Number(canonicalNumber(x))
+	case []any:
+		out := make([]any, len(x))
+		for i, item := range x {
+			out[i] = canonicalNumbers(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(x))
+		for k, item := range x {
+			out[k] = canonicalNumbers(item)
+		}
+		return out
+	}
+	return v
+}
+
+// jsonEqual reports whether two decoded values are the same JSON, treating
+// numbers as equal when their values are.
+func jsonEqual(a, b any) bool {
+	return reflect.DeepEqual(canonicalNumbers(a), canonicalNumbers(b))
+}
+
+// jsonDiffer accumulates the differences between two decoded documents.
+type jsonDiffer struct {
+	setArrays   bool
+	differences []jsonDifference
+}
+
+func (d *jsonDiffer) diff(path string, before, after any) {
+	switch b := before.(type) {
+	case map[string]any:
+		a, ok := after.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(b)+len(a))
+		for k := range b {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, ok := b[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			bv, inBefore := b[k]
+			av, inAfter := a[k]
+			switch {
+			case !inAfter:
+				d.differences = append(d.differences, jsonDifference{Op: "removed", Path: pointerJoin(path, k), Before: bv})
+			case !inBefore:
+				d.differences = append(d.differences, jsonDifference{Op: "added", Path: pointerJoin(path, k), After: av})
+			default:
+				d.diff(pointerJoin(path, k), bv, av)
+			}
+		}
+		return
+	case []any:
+		a, ok := after.([]any)
+		if !ok {
+			break
+		}
+		if d.setArrays {
+			d.diffSet(path, b, a)
+			return
+		}
+		for i := range max(len(b), len(a)) {
+			itemPath := pointerJoin(path, strconv.Itoa(i))
+			switch {
+			case i >= len(a):
+				d.differences = append(d.differences, jsonDifference{Op: "removed", Path: itemPath, Before: b[i]})
+			case i >= len(b):
+				d.differences = append(d.differences, jsonDifference{Op: "added", Path: itemPath, After: a[i]})
+			default:
+				d.diff(itemPath, b[i], a[i])
+			}
+		}
+		return
+	}
+
+	if !jsonEqual(before, after) {
+		d.differences = append(d.differences, jsonDifference{Op: "changed", Path: path, Before: before, After: after})
+	}
+}
+
+// diffSet compares arrays as multisets: each element of before is matched
+// with an equal, not yet matched element of after. Unmatched elements are
+// reported at their index in the array they came from.
+func (d *jsonDiffer) diffSet(path string, before, after []any) {
+	unmatched := map[string][]int{}
+	for i, v := range after {
+		key := compactJSON(canonicalNumbers(v))
+		unmatched[key] = append(unmatched[key], i)
+	}
+	for i, v := range before {
+		key := compactJSON(canonicalNumbers(v))
+		if len(unmatched[key]) > 0 {
+			unmatched[key] = unmatched[key][1:]
+			continue
+		}
+		d.differences = append(d.differences, jsonDifference{Op: "removed", Path: pointerJoin(path, strconv.Itoa(i)), Before: v})
+	}
+	var added []int
+	for _, indexes := range unmatched {
+		added = append(added, indexes...)
+	}
+	slices.Sort(added)
+	for _, i := range added {
+		d.differences = append(d.differences, jsonDifference{Op: "added", Path: pointerJoin(path, strconv.Itoa(i)), After: after[i]})
+	}
+}
+
+func handleJSONDiff(ctx context.Context, req *mcp.CallToolRequest, args JSONDiffArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("json_diff called: before %d bytes, after %d bytes, array_mode=%q", len(args.Before), len(args.After), args.ArrayMode))
+
+	arrayMode := args.ArrayMode
+	if arrayMode == "" {
+		arrayMode = "ordered"
+	}
+	if arrayMode != "ordered" && arrayMode != "set" {
+		return errorResult(fmt.Sprintf("Unsupported array mode: %s (use ordered or set)", args.ArrayMode)), nil, nil
+	}
+
+	before, err := decodeJSON(args.Before)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid 'before' JSON: %v", err)), nil, nil
+	}
+	after, err := decodeJSON(args.After)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid 'after' JSON: %v", err)), nil, nil
+	}
+
+	d := &jsonDiffer{setArrays: arrayMode == "set", differences: []jsonDifference{}}
+	d.diff("", before, after)
+
+	counts := map[string]int{"added": 0, "removed": 0, "changed": 0}
+	lines := make([]string, 0, len(d.differences))
+	for _, diff := range d.differences {
+		counts[diff.Op]++
+		path := diff.Path
+		if path == "" {
+			path = "(root)"
+		}
+		switch diff.Op {
+		case "added":
+			lines = append(lines, fmt.Sprintf("+ %s: %s", path, compactJSON(diff.After)))
+		case "removed":
+			lines = append(lines, fmt.Sprintf("- %s: %s", path, compactJSON(diff.Before)))
+		case "changed":
+			lines = append(lines, fmt.Sprintf("~ %s: %s -> %s", path, compactJSON(diff.Before), compactJSON(diff.After)))
+		}
+	}
+
+	text := "Documents are equal"
+	if len(lines) > 0 {
+		text = strings.Join(lines, "\n")
+	}
+
+	return textResult(text), map[string]any{
+		"equal":       len(d.differences) == 0,
+		"differences": d.differences,
+		"added":       counts["added"],
+		"removed":     counts["removed"],
+		"changed":     counts["changed"],
+	}, nil
+}
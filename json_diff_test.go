@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONDiff(t *testing.T) {
+	runToolCases(t, handleJSONDiff, []toolCase[JSONDiffArgs]{
+		{
+			name: "added key and changed value",
+			args: JSONDiffArgs{Before: `{"name":"app","port":80}`, After: `{"port":8080,"name":"app","debug":true}`},
+			text: "+ /debug: true\n~ /port: 80 -> 8080",
+			out: `{"equal":false,"added":1,"removed":0,"changed":1,"differences":[
+				{"op":"added","path":"/debug","after":true},
+				{"op":"changed","path":"/port","before":80,"after":8080}]}`,
+		},
+		{
+			name: "key order ignored",
+			args: JSONDiffArgs{Before: `{"a":1,"b":{"c":2,"d":3}}`, After: `{"b":{"d":3,"c":2},"a":1}`},
+			text: "Documents are equal",
+			out:  `{"equal":true,"differences":[]}`,
+		},
+		{
+			name: "nested array difference",
+			args: JSONDiffArgs{Before: `{"a":{"tags":[1,2,3]}}`, After: `{"a":{"tags":[1,5]}}`},
+			text: "~ /a/tags/1: 2 -> 5\n- /a/tags/2: 3",
+			out: `{"differences":[
+				{"op":"changed","path":"/a/tags/1","before":2,"after":5},
+				{"op":"removed","path":"/a/tags/2","before":3}]}`,
+		},
+		{
+			name: "arrays as sets",
+			args: JSONDiffArgs{Before: `{"tags":[1,2,3]}`, After: `{"tags":[3,1,2]}`, ArrayMode: "set"},
+			text: "Documents are equal",
+		},
+		{
+			name: "set duplicates counted",
+			args: JSONDiffArgs{Before: `{"tags":[1,2,2]}`, After: `{"tags":[2,4,1]}`, ArrayMode: "set"},
+			text: "- /tags/2: 2\n+ /tags/1: 4",
+			out:  `{"added":1,"removed":1}`,
+		},
+		{
+			name: "large integers compared exactly",
+			args: JSONDiffArgs{Before: `{"id":12345678901234567890}`, After: `{"id":12345678901234567891}`},
+			text: "~ /id: 12345678901234567890 -> 12345678901234567891",
+			out:  `{"differences":[{"op":"changed","path":"/id","before":12345678901234567890,"after":12345678901234567891}]}`,
+		},
+		{
+			name: "large integer in another notation",
+			args: JSONDiffArgs{Before: `{"id":12345678901234567890}`, After: `{"id":1.2345678901234567890e19}`},
+			text: "Documents are equal",
+		},
+		{
+			name: "equal numbers",
+			args: JSONDiffArgs{Before: `{"a":1}`, After: `{"a":1.0}`},
+			out:  `{"equal":true}`,
+		},
+		{
+			name: "null values",
+			args: JSONDiffArgs{Before: `{"a":null,"b":1}`, After: `{"b":null}`},
+			text: "- /a: null\n~ /b: 1 -> null",
+			out: `{"differences":[
+				{"op":"removed","path":"/a","before":null},
+				{"op":"changed","path":"/b","before":1,"after":null}]}`,
+		},
+		{
+			name: "escaped pointer",
+			args: JSONDiffArgs{Before: `{"a/b":1}`, After: `{"a/b":2}`},
+			text: "~ /a~1b: 1 -> 2",
+		},
+		{
+			name: "type change at root",
+			args: JSONDiffArgs{Before: `[1]`, After: `{"x":1}`},
+			text: `~ (root): [1] -> {"x":1}`,
+			out:  `{"differences":[{"op":"changed","path":"","before":[1],"after":{"x":1}}]}`,
+		},
+		{
+			name: "invalid before",
+			args: JSONDiffArgs{Before: `{`, After: `{}`},
+			err:  true,
+			text: "Invalid 'before' JSON: unexpected end of JSON input",
+		},
+		{
+			name: "trailing data in after",
+			args: JSONDiffArgs{Before: `{}`, After: `{} x`},
+			err:  true,
+			text: "Invalid 'after' JSON: invalid character 'x' after top-level value",
+		},
+		{
+			name: "unsupported array mode",
+			args: JSONDiffArgs{Before: `{}`, After: `{}`, ArrayMode: "bag"},
+			err:  true,
+			text: "Unsupported array mode: bag (use ordered or set)",
+		},
+	})
+}
+
+func TestCanonicalNumber(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"0", "0"},
+		{"-0.0", "0"},
+		{"1", "1"},
+		{"1.0", "1"},
+		{"10e-1", "1"},
+		{"100", "1e2"},
+		{"0.25", "25e-2"},
+		{"-1.5E3", "-15e2"},
+		{"12345678901234567890", "1234567890123456789e1"},
+		{"1.2345678901234567890e19", "1234567890123456789e1"},
+		{"12345678901234567891", "12345678901234567891"},
+	}
+	for _, tt := range tests {
+		if got := canonicalNumber(json.Number(tt.in)); got != tt.want {
+			t.Errorf("canonicalNumber(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
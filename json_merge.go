@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type JSONMergeArgs struct {
+	Left     string `json:"left" jsonschema:"The base JSON object"`
+	Right    string `json:"right" jsonschema:"The JSON object merged on top of left"`
+	Strategy string `json:"strategy,omitempty" jsonschema:"Conflict strategy: right_wins (default), left_wins, or concat_arrays (concatenate arrays present on both sides; other conflicts go to right)"`
+}
+
+// jsonMerger deep-merges decoded objects and records the paths where both
+// sides held different, non-mergeable values.
+type jsonMerger struct {
+	strategy  string
+	conflicts []string
+}
+
+func (m *jsonMerger) merge(path string, left, right map[string]any) map[string]any {
+	merged := make(map[string]any, len(left)+len(right))
+	for k, v := range left {
+		merged[k] = v
+	}
+
+	keys := make([]string, 0, len(right))
+	for k := range right {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		rv := right[k]
+		lv, ok := left[k]
+		if !ok {
+			merged[k] = rv
+			continue
+		}
+		keyPath := pointerJoin(path, k)
+
+		lo, lIsObject := lv.(map[string]any)
+		ro, rIsObject := rv.(map[string]any)
+		if lIsObject && rIsObject {
+			merged[k] = m.merge(keyPath, lo, ro)
+			continue
+		}
+		la, lIsArray := lv.([]any)
+		ra, rIsArray := rv.([]any)
+		if lIsArray && rIsArray && m.strategy == "concat_arrays" {
+			merged[k] = slices.Concat(la, ra)
+			continue
+		}
+
+		if jsonEqual(lv, rv) {
+			continue
+		}
+		m.conflicts = append(m.conflicts, keyPath)
+		if m.strategy != "left_wins" {
+			merged[k] = rv
+		}
+	}
+	return merged
+}
+
+func handleJSONMerge(ctx context.Context, req *mcp.CallToolRequest, args JSONMergeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("json_merge called: left %d bytes, right %d bytes, strategy=%q", len(args.Left), len(args.Right), args.Strategy))
+
+	strategy := args.Strategy
+	if strategy == "" {
+		strategy = "right_wins"
+	}
+	if strategy != "right_wins" && strategy != "left_wins" && strategy != "concat_arrays" {
+		return errorResult(fmt.Sprintf("Unsupported strategy: %s (use right_wins, left_wins, or concat_arrays)", args.Strategy)), nil, nil
+	}
+
+	var decoded [2]map[string]any
+	for i, doc := range []struct{ name, text string }{{"left", args.Left}, {"right", args.Right}} {
+		v, err := decodeJSON(doc.text)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid '%s' JSON: %v", doc.name, err)), nil, nil
+		}
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return errorResult(fmt.Sprintf("'%s' must be a JSON object, got %s", doc.name, jsonTypeOf(v))), nil, nil
+		}
+		decoded[i] = obj
+	}
+
+	m := &jsonMerger{strategy: strategy, conflicts: []string{}}
+	merged := m.merge("", decoded[0], decoded[1])
+
+	out, err := json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to encode merged document: %v", err)), nil, nil
+	}
+
+	return textResult(string(out)), map[string]any{
+		"merged":    merged,
+		"strategy":  strategy,
+		"conflicts": m.conflicts,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import "testing"
+
+func TestJSONMerge(t *testing.T) {
+	runToolCases(t, handleJSONMerge, []toolCase[JSONMergeArgs]{
+		{
+			name: "nested objects",
+			args: JSONMergeArgs{
+				Left:  `{"db":{"host":"localhost","port":5432},"debug":false}`,
+				Right: `{"db":{"port":6543,"user":"app"},"debug":true}`,
+			},
+			text: "{\n  \"db\": {\n    \"host\": \"localhost\",\n    \"port\": 6543,\n    \"user\": \"app\"\n  },\n  \"debug\": true\n}",
+			out: `{"strategy":"right_wins","conflicts":["/db/port","/debug"],
+				"merged":{"db":{"host":"localhost","port":6543,"user":"app"},"debug":true}}`,
+		},
+		{
+			name: "left wins conflicts",
+			args: JSONMergeArgs{
+				Left:     `{"db":{"port":5432},"debug":false}`,
+				Right:    `{"db":{"port":6543,"user":"app"},"debug":true}`,
+				Strategy: "left_wins",
+			},
+			out: `{"strategy":"left_wins","conflicts":["/db/port","/debug"],
+				"merged":{"db":{"port":5432,"user":"app"},"debug":false}}`,
+		},
+		{
+			name: "array concatenation",
+			args: JSONMergeArgs{Left: `{"tags":["a","b"],"n":1}`, Right: `{"tags":["b","c"],"n":2}`, Strategy: "concat_arrays"},
+			out:  `{"conflicts":["/n"],"merged":{"tags":["a","b","b","c"],"n":2}}`,
+		},
+		{
+			name: "arrays replaced by default",
+			args: JSONMergeArgs{Left: `{"tags":["a"]}`, Right: `{"tags":["b"]}`},
+			out:  `{"conflicts":["/tags"],"merged":{"tags":["b"]}}`,
+		},
+		{
+			name: "object replaced by scalar",
+			args: JSONMergeArgs{Left: `{"a":{"b":1}}`, Right: `{"a":5}`},
+			out:  `{"conflicts":["/a"],"merged":{"a":5}}`,
+		},
+		{
+			name: "equal values are not conflicts",
+			args: JSONMergeArgs{Left: `{"a":1,"b":[1,2]}`, Right: `{"a":1.0,"b":[1,2]}`},
+			out:  `{"conflicts":[],"merged":{"a":1,"b":[1,2]}}`,
+		},
+		{
+			name: "large integers kept exact",
+			args: JSONMergeArgs{Left: `{"id":12345678901234567890}`, Right: `{"big":98765432109876543210}`},
+			text: "{\n  \"big\": 98765432109876543210,\n  \"id\": 12345678901234567890\n}",
+			out:  `{"conflicts":[],"merged":{"id":12345678901234567890,"big":98765432109876543210}}`,
+		},
+		{
+			name: "large integer conflict",
+			args: JSONMergeArgs{Left: `{"id":12345678901234567890}`, Right: `{"id":12345678901234567891}`},
+			text: "{\n  \"id\": 12345678901234567891\n}",
+			out:  `{"conflicts":["/id"],"merged":{"id":12345678901234567891}}`,
+		},
+		{
+			name: "empty objects",
+			args: JSONMergeArgs{Left: `{}`, Right: `{}`},
+			text: "{}",
+			out:  `{"merged":{},"conflicts":[]}`,
+		},
+		{
+			name: "left not an object",
+			args: JSONMergeArgs{Left: `[1]`, Right: `{}`},
+			err:  true,
+			text: "'left' must be a JSON object, got array",
+		},
+		{
+			name: "right not an object",
+			args: JSONMergeArgs{Left: `{}`, Right: `null`},
+			err:  true,
+			text: "'right' must be a JSON object, got null",
+		},
+		{
+			name: "invalid JSON",
+			args: JSONMergeArgs{Left: `{`, Right: `{}`},
+			err:  true,
+			text: "Invalid 'left' JSON: unexpected end of JSON input",
+		},
+		{
+			name: "unsupported strategy",
+			args: JSONMergeArgs{Left: `{}`, Right: `{}`, Strategy: "deep"},
+			err:  true,
+			text: "Unsupported strategy: deep (use right_wins, left_wins, or concat_arrays)",
+		},
+	})
+}
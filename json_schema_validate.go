@@ -0,0 +1,437 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type JSONSchemaValidateArgs struct {
+	Document string `json:"document" jsonschema:"The JSON document to validate"`
+	Schema   string `json:"schema" jsonschema:"The JSON Schema (draft 2020-12 or draft-07) to validate against"`
+}
+
+type schemaViolation struct {
+	Path    string `json:"path" jsonschema:"JSON Pointer to the offending value (empty for the document root)"`
+	Message string `json:"message"`
+}
+
+// schemaCollector walks a document and its schema together and records every
+// violation it finds, where the jsonschema package stops at the first. It
+// covers the common keywords; validity itself is always decided by the
+// jsonschema package, so a keyword the collector does not know can only cost
+// detail in the error list, never correctness. The same holds for the depth
+// and step limits that keep recursive schemas from running away.
+type schemaCollector struct {
+	root       *jsonschema.Schema
+	violations []schemaViolation
+	steps      *int // checks left, shared with the collectors made by matches
+}
+
+// maxSchemaSteps bounds the number of schema checks one validation makes, so a
+// self-referencing schema under several anyOf branches cannot grow without
+// limit inside the depth limit.
+const maxSchemaSteps = 100000
+
+func newSchemaCollector(root *jsonschema.Schema) *schemaCollector {
+	steps := maxSchemaSteps
+	return &schemaCollector{root: root, steps: &steps}
+}
+
+func (c *schemaCollector) add(path, format string, args ...any) {
+	c.violations = append(c.violations, schemaViolation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// resolveRef follows a reference within the root schema ("#", "#/$defs/x",
+// or "#/definitions/x"). Other references are not followed.
+func (c *schemaCollector) resolveRef(ref string) *jsonschema.Schema {
+	switch {
+	case ref == "#":
+		return c.root
+	case strings.HasPrefix(ref, "#/$defs/"):
+		return c.root.Defs[strings.TrimPrefix(ref, "#/$defs/")]
+	case strings.HasPrefix(ref, "#/definitions/"):
+		return c.root.Definitions[strings.TrimPrefix(ref, "#/definitions/")]
+	}
+	return nil
+}
+
+// isFalseSchema reports whether s is the boolean schema false, which the
+// jsonschema package represents as {"not": {}}.
+func isFalseSchema(s *jsonschema.Schema) bool {
+	return s != nil && s.Not != nil && reflect.DeepEqual(*s.Not, jsonschema.Schema{})
+}
+
+func jsonTypeOf(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if x == math.Trunc(x) && !math.IsInf(x, 0) {
+			return "integer"
+		}
+		return "number"
+	case json.Number:
+		if strings.Contains(canonicalNumber(x), "e-") {
+			return "number"
+		}
+		return "integer"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func typeMatches(got, want string) bool {
+	return got == want || (got == "integer" && want == "number")
+}
+
+func pointerJoin(path, token string) string {
+	token = strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+	return path + "/" + token
+}
+
+var jsonSchemaTypes = map[string]bool{
+	"null": true, "boolean": true, "integer": true, "number": true,
+	"string": true, "array": true, "object": true,
+}
+
+// checkSchemaTypes reports the first unknown "type" value in s or its common
+// subschemas, which the jsonschema package accepts without complaint.
+func checkSchemaTypes(s *jsonschema.Schema, path string) error {
+	if s == nil {
+		return nil
+	}
+	for _, t := range append([]string{s.Type}, s.Types...) {
+		if t != "" && !jsonSchemaTypes[t] {
+			return fmt.Errorf("%s: unknown type %q", cmp.Or(path, "(root)"), t)
+		}
+	}
+
+	children := map[string]*jsonschema.Schema{
+		"items": s.Items, "additionalItems": s.AdditionalItems, "additionalProperties": s.AdditionalProperties,
+		"not": s.Not, "if": s.If, "then": s.Then, "else": s.Else, "contains": s.Contains,
+	}
+	for name, sub := range s.Properties {
+		children["properties/"+name] = sub
+	}
+	for name, sub := range s.PatternProperties {
+		children["patternProperties/"+name] = sub
+	}
+	for name, sub := range s.Defs {
+		children["$defs/"+name] = sub
+	}
+	for name, sub := range s.Definitions {
+		children["definitions/"+name] = sub
+	}
+	for keyword, list := range map[string][]*jsonschema.Schema{
+		"allOf": s.AllOf, "anyOf": s.AnyOf, "oneOf": s.OneOf, "prefixItems": s.PrefixItems, "items": s.ItemsArray,
+	} {
+		for i, sub := range list {
+			children[fmt.Sprintf("%s/%d", keyword, i)] = sub
+		}
+	}
+
+	keys := make([]string, 0, len(children))
+	for k := range children {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := checkSchemaTypes(children[k], path+"/"+k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matches reports whether v satisfies s without recording violations.
+func (c *schemaCollector) matches(v any, s *jsonschema.Schema, path string, depth int) bool {
+	sub := &schemaCollector{root: c.root, steps: c.steps}
+	sub.check(v, s, path, depth)
+	return len(sub.violations) == 0
+}
+
+func (c *schemaCollector) check(v any, s *jsonschema.Schema, path string, depth int) {
+	if s == nil || depth > 64 || *c.steps <= 0 {
+		return
+	}
+	*c.steps--
+	if isFalseSchema(s) {
+		c.add(path, "no value is allowed here")
+		return
+	}
+	if s.Ref != "" {
+		c.check(v, c.resolveRef(s.Ref), path, depth+1)
+	}
+
+	got := jsonTypeOf(v)
+	if s.Type != "" && !typeMatches(got, s.Type) {
+		c.add(path, "expected %s, got %s", s.Type, got)
+		return
+	}
+	if len(s.Types) > 0 {
+		ok := false
+		for _, t := range s.Types {
+			ok = ok || typeMatches(got, t)
+		}
+		if !ok {
+			c.add(path, "expected one of %s, got %s", strings.Join(s.Types, ", "), got)
+			return
+		}
+	}
+
+	if s.Enum != nil {
+		ok := false
+		for _, e := range s.Enum {
+			ok = ok || reflect.DeepEqual(normalizeJSONValue(e), v)
+		}
+		if !ok {
+			c.add(path, "value must be one of %s", compactJSON(s.Enum))
+		}
+	}
+	if s.Const != nil && !reflect.DeepEqual(normalizeJSONValue(*s.Const), v) {
+		c.add(path, "value must be %s", compactJSON(*s.Const))
+	}
+
+	switch x := v.(type) {
+	case float64:
+		c.checkNumber(x, s, path)
+	case string:
+		c.checkString(x, s, path)
+	case []any:
+		c.checkArray(x, s, path, depth)
+	case map[string]any:
+		c.checkObject(x, s, path, depth)
+	}
+
+	for _, sub := range s.AllOf {
+		c.check(v, sub, path, depth+1)
+	}
+	if len(s.AnyOf) > 0 {
+		ok := false
+		for _, sub := range s.AnyOf {
+			ok = ok || c.matches(v, sub, path, depth+1)
+		}
+		if !ok {
+			c.add(path, "value does not match any of the anyOf schemas")
+		}
+	}
+	if len(s.OneOf) > 0 {
+		n := 0
+		for _, sub := range s.OneOf {
+			if c.matches(v, sub, path, depth+1) {
+				n++
+			}
+		}
+		if n != 1 {
+			c.add(path, "value matches %d of the oneOf schemas, expected exactly 1", n)
+		}
+	}
+	if s.Not != nil && c.matches(v, s.Not, path, depth+1) {
+		c.add(path, "value must not match the 'not' schema")
+	}
+	if s.If != nil {
+		if c.matches(v, s.If, path, depth+1) {
+			c.check(v, s.Then, path, depth+1)
+		} else {
+			c.check(v, s.Else, path, depth+1)
+		}
+	}
+}
+
+func (c *schemaCollector) checkNumber(n float64, s *jsonschema.Schema, path string) {
+	if s.Minimum != nil && n < *s.Minimum {
+		c.add(path, "%g is less than the minimum %g", n, *s.Minimum)
+	}
+	if s.Maximum != nil && n > *s.Maximum {
+		c.add(path, "%g is greater than the maximum %g", n, *s.Maximum)
+	}
+	if s.ExclusiveMinimum != nil && n <= *s.ExclusiveMinimum {
+		c.add(path, "%g must be greater than %g", n, *s.ExclusiveMinimum)
+	}
+	if s.ExclusiveMaximum != nil && n >= *s.ExclusiveMaximum {
+		c.add(path, "%g must be less than %g", n, *s.ExclusiveMaximum)
+	}
+	if s.MultipleOf != nil && *s.MultipleOf > 0 {
+		if q := n / *s.MultipleOf; q != math.Trunc(q) {
+			c.add(path, "%g is not a multiple of %g", n, *s.MultipleOf)
+		}
+	}
+}
+
+func (c *schemaCollector) checkString(str string, s *jsonschema.Schema, path string) {
+	n := utf8.RuneCountInString(str)
+	if s.MinLength != nil && n < *s.MinLength {
+		c.add(path, "string has %d characters, fewer than the minimum %d", n, *s.MinLength)
+	}
+	if s.MaxLength != nil && n > *s.MaxLength {
+		c.add(path, "string has %d characters, more than the maximum %d", n, *s.MaxLength)
+	}
+	if s.Pattern != "" {
+		if re, err := regexp.Compile(s.Pattern); err == nil && !re.MatchString(str) {
+			c.add(path, "string does not match the pattern %q", s.Pattern)
+		}
+	}
+}
+
+func (c *schemaCollector) checkArray(items []any, s *jsonschema.Schema, path string, depth int) {
+	if s.MinItems != nil && len(items) < *s.MinItems {
+		c.add(path, "array has %d items, fewer than the minimum %d", len(items), *s.MinItems)
+	}
+	if s.MaxItems != nil && len(items) > *s.MaxItems {
+		c.add(path, "array has %d items, more than the maximum %d", len(items), *s.MaxItems)
+	}
+	if s.UniqueItems {
+	unique:
+		for i := range items {
+			for j := i + 1; j < len(items); j++ {
+				if reflect.DeepEqual(items[i], items[j]) {
+					c.add(path, "items %d and %d are equal but items must be unique", i, j)
+					break unique
+				}
+			}
+		}
+	}
+
+	for i, item := range items {
+		itemPath := pointerJoin(path, strconv.Itoa(i))
+		switch {
+		case i < len(s.PrefixItems):
+			c.check(item, s.PrefixItems[i], itemPath, depth+1)
+		case i < len(s.ItemsArray):
+			c.check(item, s.ItemsArray[i], itemPath, depth+1)
+		case len(s.ItemsArray) > 0:
+			c.check(item, s.AdditionalItems, itemPath, depth+1)
+		default:
+			c.check(item, s.Items, itemPath, depth+1)
+		}
+	}
+}
+
+func (c *schemaCollector) checkObject(obj map[string]any, s *jsonschema.Schema, path string, depth int) {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			c.add(path, "missing required property %q", name)
+		}
+	}
+	if s.MinProperties != nil && len(obj) < *s.MinProperties {
+		c.add(path, "object has %d properties, fewer than the minimum %d", len(obj), *s.MinProperties)
+	}
+	if s.MaxProperties != nil && len(obj) > *s.MaxProperties {
+		c.add(path, "object has %d properties, more than the maximum %d", len(obj), *s.MaxProperties)
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := obj[name]
+		propPath := pointerJoin(path, name)
+		matched := false
+		if sub, ok := s.Properties[name]; ok {
+			c.check(value, sub, propPath, depth+1)
+			matched = true
+		}
+		for pattern, sub := range s.PatternProperties {
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+				c.check(value, sub, propPath, depth+1)
+				matched = true
+			}
+		}
+		if !matched && s.AdditionalProperties != nil {
+			if isFalseSchema(s.AdditionalProperties) {
+				c.add(path, "additional property %q is not allowed", name)
+			} else {
+				c.check(value, s.AdditionalProperties, propPath, depth+1)
+			}
+		}
+	}
+}
+
+// normalizeJSONValue round-trips a schema value through JSON so that it
+// compares equal to the decoded document (numbers as float64 and so on).
+func normalizeJSONValue(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if json.Unmarshal(data, &out) != nil {
+		return v
+	}
+	return out
+}
+
+func compactJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func handleJSONSchemaValidate(ctx context.Context, req *mcp.CallToolRequest, args JSONSchemaValidateArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("json_schema_validate called: document %d bytes, schema %d bytes", len(args.Document), len(args.Schema)))
+
+	var schema jsonschema.Schema
+	if err := json.Unmarshal([]byte(args.Schema), &schema); err != nil {
+		return errorResult(fmt.Sprintf("Invalid schema: %v", err)), nil, nil
+	}
+	resolved, err := schema.Resolve(nil)
+	if err == nil {
+		err = checkSchemaTypes(&schema, "")
+	}
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid schema: %v", err)), nil, nil
+	}
+
+	var document any
+	if err := json.Unmarshal([]byte(args.Document), &document); err != nil {
+		return errorResult(fmt.Sprintf("Invalid JSON document: %v", err)), nil, nil
+	}
+
+	violations := []schemaViolation{}
+	if verr := resolved.Validate(document); verr != nil {
+		collector := newSchemaCollector(&schema)
+		collector.check(document, &schema, "", 0)
+		violations = collector.violations
+		if len(violations) == 0 {
+			violations = []schemaViolation{{Path: "", Message: verr.Error()}}
+		}
+	}
+
+	if len(violations) == 0 {
+		return textResult("Valid"), map[string]any{"valid": true, "errors": violations}, nil
+	}
+
+	lines := []string{fmt.Sprintf("Invalid: %d error(s)", len(violations))}
+	for _, v := range violations {
+		path := v.Path
+		if path == "" {
+			path = "(root)"
+		}
+		lines = append(lines, fmt.Sprintf("- %s: %s", path, v.Message))
+	}
+	return textResult(strings.Join(lines, "\n")), map[string]any{"valid": false, "errors": violations}, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import "testing"
+
+func TestJSONSchemaValidate(t *testing.T) {
+	const schema = `{
+		"type": "object",
+		"required": ["name", "email"],
+		"properties": {
+			"name": {"type": "string", "minLength": 2},
+			"email": {"type": "string"},
+			"age": {"type": "integer", "minimum": 0},
+			"tags": {"type": "array", "uniqueItems": true, "items": {"type": "string"}}
+		},
+		"additionalProperties": false
+	}`
+
+	runToolCases(t, handleJSONSchemaValidate, []toolCase[JSONSchemaValidateArgs]{
+		{
+			name: "valid",
+			args: JSONSchemaValidateArgs{Document: `{"name":"Ann","email":"a@b.c","age":30,"tags":["x"]}`, Schema: schema},
+			text: "Valid",
+			out:  `{"valid":true,"errors":[]}`,
+		},
+		{
+			name: "several violations",
+			args: JSONSchemaValidateArgs{Document: `{"name":"A","age":-3,"tags":["x","x",1],"extra":true}`, Schema: schema},
+			text: "Invalid: 6 error(s)\n" +
+				"- (root): missing required property \"email\"\n" +
+				"- /age: -3 is less than the minimum 0\n" +
+				"- (root): additional property \"extra\" is not allowed\n" +
+				"- /name: string has 1 characters, fewer than the minimum 2\n" +
+				"- /tags: items 0 and 1 are equal but items must be unique\n" +
+				"- /tags/2: expected string, got integer",
+			out: `{"valid":false,"errors":[
+				{"path":"","message":"missing required property \"email\""},
+				{"path":"/age","message":"-3 is less than the minimum 0"},
+				{"path":"","message":"additional property \"extra\" is not allowed"},
+				{"path":"/name","message":"string has 1 characters, fewer than the minimum 2"},
+				{"path":"/tags","message":"items 0 and 1 are equal but items must be unique"},
+				{"path":"/tags/2","message":"expected string, got integer"}
+			]}`,
+		},
+		{
+			name: "integer type",
+			args: JSONSchemaValidateArgs{Document: `1.5`, Schema: `{"type":"integer"}`},
+			out:  `{"valid":false,"errors":[{"path":"","message":"expected integer, got number"}]}`,
+		},
+		{
+			name: "reference",
+			args: JSONSchemaValidateArgs{Document: `{"a":{"b":"x"}}`, Schema: `{"$defs":{"n":{"type":"number"}},"properties":{"a":{"properties":{"b":{"$ref":"#/$defs/n"}}}}}`},
+			out:  `{"valid":false,"errors":[{"path":"/a/b","message":"expected number, got string"}]}`,
+		},
+		{
+			name: "escaped pointer",
+			args: JSONSchemaValidateArgs{Document: `{"a/b":1}`, Schema: `{"properties":{"a/b":{"type":"string"}}}`},
+			out:  `{"errors":[{"path":"/a~1b","message":"expected string, got integer"}]}`,
+		},
+		{
+			name: "self reference under anyOf",
+			args: JSONSchemaValidateArgs{Document: `5`, Schema: `{"minimum":10,"anyOf":[{"$ref":"#"}]}`},
+			text: "Invalid: 2 error(s)\n- (root): 5 is less than the minimum 10\n- (root): value does not match any of the anyOf schemas",
+		},
+		{
+			name: "self reference under several branches",
+			args: JSONSchemaValidateArgs{Document: `5`, Schema: `{"minimum":10,"anyOf":[{"$ref":"#"},{"$ref":"#"}],"oneOf":[{"$ref":"#"},{"not":{"$ref":"#"}}]}`},
+			out:  `{"valid":false}`,
+		},
+		{name: "unknown type", args: JSONSchemaValidateArgs{Document: `{}`, Schema: `{"type":"strung"}`}, err: true, text: `Invalid schema: (root): unknown type "strung"`},
+		{name: "malformed schema", args: JSONSchemaValidateArgs{Document: `{}`, Schema: `{`}, err: true, text: "Invalid schema: unexpected end of JSON input"},
+		{name: "malformed document", args: JSONSchemaValidateArgs{Document: `{`, Schema: `{}`}, err: true, text: "Invalid JSON document: unexpected end of JSON input"},
+	})
+}
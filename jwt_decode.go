@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type JWTDecodeArgs struct {
+	Token string `json:"token" jsonschema:"The JWT to decode (header.payload.signature). The signature is NOT verified"`
+}
+
+// jwtTimeClaims are the registered claims holding NumericDate values.
+var jwtTimeClaims = []string{"exp", "nbf", "iat"}
+
+// NumericDate values are limited to the years 1 through 9999, the range an
+// RFC3339 timestamp can show.
+var (
+	minNumericDate = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxNumericDate = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC).Unix()
+)
+
+// numericDate converts a NumericDate (seconds since the epoch, possibly
+// fractional) to a time, reporting false when it is out of range.
+func numericDate(n json.Number) (time.Time, bool) {
+	seconds, err := n.Float64()
+	if err != nil || seconds < float64(minNumericDate) || seconds >= float64(maxNumericDate+1) {
+		return time.Time{}, false
+	}
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*float64(time.Second))).UTC(), true
+}
+
+// decodeJWTSegment base64url-decodes one segment of a token, tolerating
+// trailing padding, and checks that it holds a JSON object. It returns the
+// parsed object and the JSON indented for display in its original key order.
+func decodeJWTSegment(name, segment string) (map[string]any, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return nil, "", fmt.Errorf("%s is not valid base64url: %v", name, err)
+	}
+
+	var obj map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil || obj == nil {
+		return nil, "", fmt.Errorf("%s is not a JSON object", name)
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, raw, "", "  "); err != nil {
+		return nil, "", fmt.Errorf("%s is not a JSON object", name)
+	}
+	return obj, pretty.String(), nil
+}
+
+func handleJWTDecode(ctx context.Context, req *mcp.CallToolRequest, args JWTDecodeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("jwt_decode called with %d bytes", len(args.Token)))
+
+	parts := strings.Split(strings.TrimSpace(args.Token), ".")
+	if len(parts) != 3 {
+		return errorResult(fmt.Sprintf("A JWT must have three dot-separated parts, got %d", len(parts))), nil, nil
+	}
+
+	header, headerJSON, err := decodeJWTSegment("Header", parts[0])
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	payload, payloadJSON, err := decodeJWTSegment("Payload", parts[1])
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+
+	now := time.Now()
+	times := map[string]string{}
+	invalidTimes := []string{}
+	var timeLines []string
+	expired := false
+	for _, claim := range jwtTimeClaims {
+		n, ok := payload[claim].(json.Number)
+		if !ok {
+			continue
+		}
+		t, ok := numericDate(n)
+		if !ok {
+			invalidTimes = append(invalidTimes, claim)
+			timeLines = append(timeLines, fmt.Sprintf("%s: invalid, %s is outside the years 1-9999", claim, n))
+			continue
+		}
+		times[claim] = t.Format(time.RFC3339)
+		timeLines = append(timeLines, fmt.Sprintf("%s: %s", claim, times[claim]))
+		if claim == "exp" && now.After(t) {
+			expired = true
+		}
+	}
+
+	text := fmt.Sprintf("Header:\n%s\n\nPayload:\n%s\n\nSignature (not verified): %s", headerJSON, payloadJSON, parts[2])
+	if len(timeLines) > 0 {
+		text += "\n\n" + strings.Join(timeLines, "\n")
+		if _, ok := times["exp"]; ok {
+			text += fmt.Sprintf("\nexpired: %t", expired)
+		}
+	}
+
+	result := map[string]any{
+		"header":        header,
+		"payload":       payload,
+		"signature":     parts[2],
+		"verified":      false,
+		"times":         times,
+		"invalid_times": invalidTimes,
+	}
+	if _, ok := times["exp"]; ok {
+		result["expired"] = expired
+	}
+	return textResult(text), result, nil
+}
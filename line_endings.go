@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type LineEndingsArgs struct {
+	Text   string `json:"text" jsonschema:"The text to inspect or convert"`
+	Mode   string `json:"mode,omitempty" jsonschema:"Operation: detect (count line endings) or convert (rewrite every line ending). Defaults to detect"`
+	Target string `json:"target,omitempty" jsonschema:"Line ending to convert to (lf, crlf, or cr); required for convert mode"`
+}
+
+var lineEndingSequences = map[string]string{
+	"lf":   "\n",
+	"crlf": "\r\n",
+	"cr":   "\r",
+}
+
+// lineEndingStats holds how many of each line ending a text contains.
+type lineEndingStats struct {
+	LF   int `json:"lf"`
+	CRLF int `json:"crlf"`
+	CR   int `json:"cr"`
+}
+
+// style names the single line ending used throughout the text, "mixed" when
+// more than one kind appears, or "none" when the text has no line breaks.
+func (s lineEndingStats) style() string {
+	kinds, style := 0, "none"
+	for _, k := range []struct {
+		name  string
+		count int
+	}{{"lf", s.LF}, {"crlf", s.CRLF}, {"cr", s.CR}} {
+		if k.count > 0 {
+			kinds++
+			style = k.name
+		}
+	}
+	if kinds > 1 {
+		return "mixed"
+	}
+	return style
+}
+
+// countLineEndings counts line endings in text, treating "\r\n" as a single
+// CRLF rather than a CR followed by an LF.
+func countLineEndings(text string) lineEndingStats {
+	var stats lineEndingStats
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\n':
+			stats.LF++
+		case '\r':
+			if i+1 < len(text) && text[i+1] == '\n' {
+				stats.CRLF++
+				i++
+			} else {
+				stats.CR++
+			}
+		}
+	}
+	return stats
+}
+
+// convertLineEndings rewrites every line ending in text to ending, leaving
+// all other content untouched.
+func convertLineEndings(text, ending string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	if ending == "\n" {
+		return text
+	}
+	return strings.ReplaceAll(text, "\n", ending)
+}
+
+func handleLineEndings(ctx context.Context, req *mcp.CallToolRequest, args LineEndingsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("line_endings called: mode=%q target=%q text length=%d", args.Mode, args.Target, len(args.Text)))
+
+	mode := args.Mode
+	if mode == "" {
+		mode = "detect"
+	}
+
+	stats := countLineEndings(args.Text)
+	style := stats.style()
+	label := strings.ToUpper(style)
+	switch style {
+	case "mixed":
+		label = "Mixed line endings"
+	case "none":
+		label = "No line endings"
+	}
+	summary := fmt.Sprintf("%s (LF: %d, CRLF: %d, CR: %d)", label, stats.LF, stats.CRLF, stats.CR)
+
+	switch mode {
+	case "detect":
+		if args.Target != "" {
+			return errorResult("'target' cannot be used with detect mode"), nil, nil
+		}
+		return textResult(summary), map[string]any{
+			"style": style,
+			"mixed": style == "mixed",
+			"stats": stats,
+		}, nil
+	case "convert":
+		target := strings.ToLower(strings.TrimSpace(args.Target))
+		if target == "" {
+			return errorResult("'target' is required for convert mode"), nil, nil
+		}
+		ending, ok := lineEndingSequences[target]
+		if !ok {
+			return errorResult(fmt.Sprintf("Unsupported target: %s", args.Target)), nil, nil
+		}
+
+		converted := convertLineEndings(args.Text, ending)
+		return textResult(converted), map[string]any{
+			"text":    converted,
+			"target":  target,
+			"changed": converted != args.Text,
+			"style":   style,
+			"mixed":   style == "mixed",
+			"stats":   stats,
+		}, nil
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import "testing"
+
+func TestLineEndings(t *testing.T) {
+	runToolCases(t, handleLineEndings, []toolCase[LineEndingsArgs]{
+		{
+			name: "detect LF",
+			args: LineEndingsArgs{Text: "a\nb\nc\n"},
+			text: "LF (LF: 3, CRLF: 0, CR: 0)",
+			out:  `{"style":"lf","mixed":false,"stats":{"lf":3,"crlf":0,"cr":0}}`,
+		},
+		{
+			name: "detect CRLF",
+			args: LineEndingsArgs{Text: "a\r\nb\r\n", Mode: "detect"},
+			text: "CRLF (LF: 0, CRLF: 2, CR: 0)",
+			out:  `{"style":"crlf","stats":{"lf":0,"crlf":2,"cr":0}}`,
+		},
+		{
+			name: "detect CR",
+			args: LineEndingsArgs{Text: "a\rb"},
+			text: "CR (LF: 0, CRLF: 0, CR: 1)",
+		},
+		{
+			name: "detect mixed",
+			args: LineEndingsArgs{Text: "one\r\ntwo\nthree\rfour\r\n"},
+			text: "Mixed line endings (LF: 1, CRLF: 2, CR: 1)",
+			out:  `{"style":"mixed","mixed":true,"stats":{"lf":1,"crlf":2,"cr":1}}`,
+		},
+		{
+			name: "LF then CR is not CRLF",
+			args: LineEndingsArgs{Text: "a\n\rb"},
+			out:  `{"style":"mixed","stats":{"lf":1,"crlf":0,"cr":1}}`,
+		},
+		{
+			name: "detect none",
+			args: LineEndingsArgs{Text: "single line"},
+			text: "No line endings (LF: 0, CRLF: 0, CR: 0)",
+			out:  `{"style":"none","mixed":false}`,
+		},
+		{
+			name: "convert CRLF to LF",
+			args: LineEndingsArgs{Text: "line 1\r\nline 2\r\n\r\nend", Mode: "convert", Target: "lf"},
+			text: "line 1\nline 2\n\nend",
+			out: `{"text":"line 1\nline 2\n\nend","target":"lf","changed":true,"style":"crlf",
+				"stats":{"lf":0,"crlf":3,"cr":0}}`,
+		},
+		{
+			name: "convert mixed to CRLF",
+			args: LineEndingsArgs{Text: "a\nb\r\nc\rd", Mode: "convert", Target: " CRLF "},
+			text: "a\r\nb\r\nc\r\nd",
+			out:  `{"target":"crlf","changed":true,"mixed":true}`,
+		},
+		{
+			name: "convert to CR",
+			args: LineEndingsArgs{Text: "a\r\nb\n", Mode: "convert", Target: "cr"},
+			text: "a\rb\r",
+		},
+		{
+			name: "already converted",
+			args: LineEndingsArgs{Text: "a\nb", Mode: "convert", Target: "lf"},
+			out:  `{"text":"a\nb","changed":false}`,
+		},
+		{
+			name: "content preserved",
+			args: LineEndingsArgs{Text: "tab\there\r\n  spaces  \r\n", Mode: "convert", Target: "lf"},
+			text: "tab\there\n  spaces  \n",
+		},
+		{
+			name: "target with detect",
+			args: LineEndingsArgs{Text: "a", Target: "lf"},
+			err:  true,
+			text: "'target' cannot be used with detect mode",
+		},
+		{
+			name: "missing target",
+			args: LineEndingsArgs{Text: "a", Mode: "convert"},
+			err:  true,
+			text: "'target' is required for convert mode",
+		},
+		{
+			name: "unsupported target",
+			args: LineEndingsArgs{Text: "a", Mode: "convert", Target: "nel"},
+			err:  true,
+			text: "Unsupported target: nel",
+		},
+		{
+			name: "unsupported mode",
+			args: LineEndingsArgs{Text: "a", Mode: "strip"},
+			err:  true,
+			text: "Unsupported mode: strip",
+		},
+	})
+}
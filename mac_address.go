@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type MACAddressArgs struct {
+	Address string `json:"address" jsonschema:"A MAC address (EUI-48 or EUI-64) in colon (00:1a:2b:3c:4d:5e), hyphen (00-1A-2B-3C-4D-5E), dotted (001a.2b3c.4d5e), or bare hex notation"`
+}
+
+// parseMAC accepts everything net.ParseMAC does for 6- and 8-byte addresses,
+// plus bare hex digits with no separators.
+func parseMAC(s string) (net.HardwareAddr, error) {
+	if len(s) == 12 || len(s) == 16 {
+		if b, err := hex.DecodeString(s); err == nil {
+			return net.HardwareAddr(b), nil
+		}
+	}
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not in colon, hyphen, dotted, or bare hex notation", s)
+	}
+	if len(mac) != 6 && len(mac) != 8 {
+		return nil, fmt.Errorf("%s has %d octets; only EUI-48 (6) and EUI-64 (8) are supported", s, len(mac))
+	}
+	return mac, nil
+}
+
+func handleMACAddress(ctx context.Context, req *mcp.CallToolRequest, args MACAddressArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("mac_address called with address: %s", args.Address))
+
+	mac, err := parseMAC(strings.TrimSpace(args.Address))
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid MAC address: %v", err)), nil, nil
+	}
+
+	bare := hex.EncodeToString(mac)
+	groups := make([]string, 0, len(bare)/4)
+	for i := 0; i < len(bare); i += 4 {
+		groups = append(groups, bare[i:i+4])
+	}
+	canonical := mac.String()
+	hyphen := strings.ToUpper(strings.ReplaceAll(canonical, ":", "-"))
+	dotted := strings.Join(groups, ".")
+	oui := canonical[:8]
+
+	// The two low bits of the first octet are the individual/group bit and
+	// the universal/local bit.
+	multicast := mac[0]&0x01 != 0
+	local := mac[0]&0x02 != 0
+	broadcast := bare == strings.Repeat("f", len(bare))
+
+	kind, admin := "unicast", "globally administered"
+	if multicast {
+		kind = "multicast"
+	}
+	if broadcast {
+		kind = "broadcast"
+	}
+	if local {
+		admin = "locally administered"
+	}
+	eui := fmt.Sprintf("EUI-%d", len(mac)*8)
+
+	return textResult(fmt.Sprintf("%s\n%s, %s %s\nOUI: %s", canonical, eui, kind, admin, oui)), map[string]any{
+		"canonical":  canonical,
+		"hyphen":     hyphen,
+		"dotted":     dotted,
+		"bare":       bare,
+		"type":       eui,
+		"oui":        oui,
+		"multicast":  multicast,
+		"broadcast":  broadcast,
+		"local":      local,
+		"admin_type": strings.TrimSuffix(admin, " administered"),
+	}, nil
+}
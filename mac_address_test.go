@@ -0,0 +1,99 @@
+package main
+
+import "testing"
+
+func TestMACAddress(t *testing.T) {
+	const normalized = `{"canonical":"00:1a:2b:3c:4d:5e","hyphen":"00-1A-2B-3C-4D-5E","dotted":"001a.2b3c.4d5e",
+		"bare":"001a2b3c4d5e","type":"EUI-48","oui":"00:1a:2b"}`
+	const description = "00:1a:2b:3c:4d:5e\nEUI-48, unicast globally administered\nOUI: 00:1a:2b"
+
+	runToolCases(t, handleMACAddress, []toolCase[MACAddressArgs]{
+		{
+			name: "colon notation",
+			args: MACAddressArgs{Address: "00:1A:2B:3C:4D:5E"},
+			text: description,
+			out:  normalized,
+		},
+		{
+			name: "hyphen notation",
+			args: MACAddressArgs{Address: "00-1a-2b-3c-4d-5e"},
+			text: description,
+			out:  normalized,
+		},
+		{
+			name: "dotted notation",
+			args: MACAddressArgs{Address: "001a.2b3c.4d5e"},
+			text: description,
+			out:  normalized,
+		},
+		{
+			name: "bare hex",
+			args: MACAddressArgs{Address: " 001A2B3C4D5E "},
+			text: description,
+			out:  normalized,
+		},
+		{
+			name: "unicast flags",
+			args: MACAddressArgs{Address: "00:1a:2b:3c:4d:5e"},
+			out:  `{"multicast":false,"broadcast":false,"local":false,"admin_type":"globally"}`,
+		},
+		{
+			name: "multicast",
+			args: MACAddressArgs{Address: "01:00:5e:00:00:fb"},
+			text: "01:00:5e:00:00:fb\nEUI-48, multicast globally administered\nOUI: 01:00:5e",
+			out:  `{"multicast":true,"broadcast":false,"local":false}`,
+		},
+		{
+			name: "locally administered",
+			args: MACAddressArgs{Address: "02:42:ac:11:00:02"},
+			text: "02:42:ac:11:00:02\nEUI-48, unicast locally administered\nOUI: 02:42:ac",
+			out:  `{"multicast":false,"local":true,"admin_type":"locally"}`,
+		},
+		{
+			name: "broadcast",
+			args: MACAddressArgs{Address: "FF:FF:FF:FF:FF:FF"},
+			text: "ff:ff:ff:ff:ff:ff\nEUI-48, broadcast locally administered\nOUI: ff:ff:ff",
+			out:  `{"multicast":true,"broadcast":true,"local":true}`,
+		},
+		{
+			name: "EUI-64",
+			args: MACAddressArgs{Address: "00:1a:2b:ff:fe:3c:4d:5e"},
+			text: "00:1a:2b:ff:fe:3c:4d:5e\nEUI-64, unicast globally administered\nOUI: 00:1a:2b",
+			out:  `{"type":"EUI-64","dotted":"001a.2bff.fe3c.4d5e","bare":"001a2bfffe3c4d5e"}`,
+		},
+		{
+			name: "EUI-64 bare hex",
+			args: MACAddressArgs{Address: "001a2bfffe3c4d5e"},
+			out:  `{"canonical":"00:1a:2b:ff:fe:3c:4d:5e","type":"EUI-64"}`,
+		},
+		{
+			name: "too few octets",
+			args: MACAddressArgs{Address: "00:1a:2b:3c:4d"},
+			err:  true,
+			text: "Invalid MAC address: 00:1a:2b:3c:4d is not in colon, hyphen, dotted, or bare hex notation",
+		},
+		{
+			name: "too short bare hex",
+			args: MACAddressArgs{Address: "001a2b3c4d"},
+			err:  true,
+			text: "Invalid MAC address: 001a2b3c4d is not in colon, hyphen, dotted, or bare hex notation",
+		},
+		{
+			name: "InfiniBand length",
+			args: MACAddressArgs{Address: "00:1a:2b:3c:4d:5e:6f:70:81:92:a3:b4:c5:d6:e7:f8:09:1a:2b:3c"},
+			err:  true,
+			text: "Invalid MAC address: 00:1a:2b:3c:4d:5e:6f:70:81:92:a3:b4:c5:d6:e7:f8:09:1a:2b:3c has 20 octets; only EUI-48 (6) and EUI-64 (8) are supported",
+		},
+		{
+			name: "not hex",
+			args: MACAddressArgs{Address: "zz:1a:2b:3c:4d:5e"},
+			err:  true,
+			text: "Invalid MAC address: zz:1a:2b:3c:4d:5e is not in colon, hyphen, dotted, or bare hex notation",
+		},
+		{
+			name: "empty",
+			args: MACAddressArgs{Address: ""},
+			err:  true,
+		},
+	})
+}
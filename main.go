@@ -6,8 +6,10 @@ import (
 	"io"
 	"log"
 	"math"
+	"math/big"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,83 +21,314 @@ func logMsg(prefix, message string) {
 	fmt.Fprintf(os.Stderr, "[%s] %s %s\n", timestamp, prefix, message)
 }
 
+func textResult(text string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: text,
+			},
+		},
+	}
+}
+
+func errorResult(text string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: text,
+			},
+		},
+		IsError: true,
+	}
+}
+
+// cancelledResult reports that a tool stopped early because its context was
+// cancelled. Handlers with loops over caller-controlled input should check
+// ctx.Err() between iterations and return this result.
+func cancelledResult(err error) *mcp.CallToolResult {
+	return errorResult(fmt.Sprintf("Operation cancelled: %v", err))
+}
+
+// notifyProgress reports progress for a long-running tool call. It is a no-op
+// when the client did not supply a progress token with the request.
+func notifyProgress(ctx context.Context, req *mcp.CallToolRequest, progress, total float64, message string) {
+	if req == nil || req.Session == nil || req.Params == nil {
+		return
+	}
+	token := req.Params.GetProgressToken()
+	if token == nil {
+		return
+	}
+
+	err := req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: token,
+		Progress:      progress,
+		Total:         total,
+		Message:       message,
+	})
+	if err != nil {
+		logMsg("[WARN]", fmt.Sprintf("Failed to send progress notification: %v", err))
+	}
+}
+
 type WordCountArgs struct {
-	Text string `json:"text" jsonschema:"The text to analyze"`
+	Text                string   `json:"text,omitempty" jsonschema:"The text to analyze"`
+	Texts               []string `json:"texts,omitempty" jsonschema:"Several documents to analyze at once instead of text; each is counted separately and the totals are combined"`
+	NormalizeWhitespace bool     `json:"normalize_whitespace,omitempty" jsonschema:"Collapse runs of whitespace to single spaces and trim the ends before counting"`
 }
 
 type FormatCurrencyArgs struct {
 	Amount   float64 `json:"amount" jsonschema:"The numeric amount to format"`
-	Currency string  `json:"currency" jsonschema:"Currency code (USD, EUR, GBP, JPY)"`
+	Currency string  `json:"currency,omitempty" jsonschema:"Currency code (USD, EUR, GBP, JPY) in any case, or a currency symbol ($, €, £, ¥). Defaults to DEFAULT_CURRENCY when set"`
+	Rounding string  `json:"rounding,omitempty" jsonschema:"Rounding mode applied before formatting (half_up, half_even, or down)"`
 }
 
 type SlugifyArgs struct {
-	Text string `json:"text" jsonschema:"The text to convert to a URL-friendly slug"`
+	Text                string  `json:"text" jsonschema:"The text to convert to a URL-friendly slug"`
+	NormalizeWhitespace bool    `json:"normalize_whitespace,omitempty" jsonschema:"Collapse runs of whitespace to single spaces and trim the ends before slugifying"`
+	NoLeadingDigit      string  `json:"no_leading_digit,omitempty" jsonschema:"How to handle a slug starting with a digit: prefix (prepend leading_digit_prefix) or strip (remove the leading digits)"`
+	LeadingDigitPrefix  *string `json:"leading_digit_prefix,omitempty" jsonschema:"Prefix used by no_leading_digit=prefix (default n-)"`
+	CheckDuplicate      bool    `json:"check_duplicate,omitempty" jsonschema:"Report whether this slug was already generated earlier in the session"`
+	PreviousText        *string `json:"previous_text,omitempty" jsonschema:"An earlier version of the text; the result reports whether its slug differs from the new one"`
+	Variants            bool    `json:"variants,omitempty" jsonschema:"Also return labeled alternative slugs (keyword_first, short, no_stopwords) for A/B testing"`
 }
 
 type RomanNumeralArgs struct {
-	Number *int    `json:"number,omitempty" jsonschema:"Decimal number to convert to Roman (1-3999)"`
-	Roman  *string `json:"roman,omitempty" jsonschema:"Roman numeral to convert to decimal"`
+	Number  *int    `json:"number,omitempty" jsonschema:"Decimal number to convert to Roman (1-3999)"`
+	Roman   *string `json:"roman,omitempty" jsonschema:"Roman numeral to convert to decimal"`
+	Explain bool    `json:"explain,omitempty" jsonschema:"Include a breakdown of the symbols that make up the numeral"`
 }
 
 type TemperatureConvertArgs struct {
-	Value    float64 `json:"value" jsonschema:"The temperature value to convert"`
-	FromUnit string  `json:"from_unit" jsonschema:"Source temperature unit (celsius, fahrenheit, or kelvin)"`
-	ToUnit   string  `json:"to_unit" jsonschema:"Target temperature unit (celsius, fahrenheit, or kelvin)"`
+	Value     float64 `json:"value" jsonschema:"The temperature value to convert"`
+	FromUnit  string  `json:"from_unit,omitempty" jsonschema:"Source temperature unit (celsius, fahrenheit, or kelvin). Defaults to DEFAULT_TEMPERATURE_FROM_UNIT when set"`
+	ToUnit    string  `json:"to_unit,omitempty" jsonschema:"Target temperature unit (celsius, fahrenheit, or kelvin). Defaults to DEFAULT_TEMPERATURE_TO_UNIT when set"`
+	Precision *int    `json:"precision,omitempty" jsonschema:"Decimal places to round the result to (0-10, default 2)"`
 }
 
-func handleWordCount(ctx context.Context, req *mcp.CallToolRequest, args WordCountArgs) (*mcp.CallToolResult, any, error) {
-	logMsg("[TOOL]", fmt.Sprintf("word_count called with text length: %d", len(args.Text)))
+// TemperatureConvertOutput is the structured result of temperature_convert.
+// Handlers that return a concrete output type instead of any get an output
+// schema inferred from it, and the SDK validates each result against that
+// schema before sending it.
+type TemperatureConvertOutput struct {
+	Result float64 `json:"result" jsonschema:"The converted temperature, rounded to the requested precision"`
+}
 
+type wordStats struct {
+	Words                  int `json:"words" jsonschema:"Number of whitespace-separated words"`
+	Characters             int `json:"characters" jsonschema:"Number of bytes in the text"`
+	CharactersNoWhitespace int `json:"characters_no_whitespace" jsonschema:"Number of bytes excluding spaces and newlines"`
+	Lines                  int `json:"lines" jsonschema:"Number of lines (0 for empty text)"`
+}
+
+// wordCountOutput is word_count's structured result. The top-level counts
+// cover the single text, or all documents combined when texts is used.
+type wordCountOutput struct {
+	wordStats
+	Documents []wordStats `json:"documents,omitempty" jsonschema:"Per-document counts in input order, when texts is given"`
+	Totals    *wordStats  `json:"totals,omitempty" jsonschema:"Combined counts across documents, when texts is given"`
+}
+
+// add accumulates other into s.
+func (s *wordStats) add(other wordStats) {
+	s.Words += other.Words
+	s.Characters += other.Characters
+	s.CharactersNoWhitespace += other.CharactersNoWhitespace
+	s.Lines += other.Lines
+}
+
+func countWords(text string) wordStats {
 	words := 0
-	if len(strings.TrimSpace(args.Text)) > 0 {
-		words = len(strings.Fields(args.Text))
+	if len(strings.TrimSpace(text)) > 0 {
+		words = len(strings.Fields(text))
 	}
 
-	lines := strings.Count(args.Text, "\n") + 1
-	if args.Text == "" {
+	lines := strings.Count(text, "\n") + 1
+	if text == "" {
 		lines = 0
 	}
 
-	chars := len(args.Text)
-	charsNoSpaces := len(strings.ReplaceAll(strings.ReplaceAll(args.Text, " ", ""), "\n", ""))
+	return wordStats{
+		Words:                  words,
+		Characters:             len(text),
+		CharactersNoWhitespace: len(strings.ReplaceAll(strings.ReplaceAll(text, " ", ""), "\n", "")),
+		Lines:                  lines,
+	}
+}
+
+func (w wordStats) String() string {
+	return fmt.Sprintf("Words: %d\nCharacters: %d\nCharacters (no whitespace): %d\nLines: %d",
+		w.Words, w.Characters, w.CharactersNoWhitespace, w.Lines)
+}
+
+func handleWordCount(ctx context.Context, req *mcp.CallToolRequest, args WordCountArgs) (*mcp.CallToolResult, wordCountOutput, error) {
+	if len(args.Texts) > 0 {
+		return handleWordCountDocuments(ctx, req, args)
+	}
+
+	logMsg("[TOOL]", fmt.Sprintf("word_count called with text length: %d", len(args.Text)))
 
-	result := map[string]any{
-		"words":                    words,
-		"characters":               chars,
-		"characters_no_whitespace": charsNoSpaces,
-		"lines":                    lines,
+	text := args.Text
+	if args.NormalizeWhitespace {
+		text = normalizeWhitespace(text)
 	}
 
+	stats := countWords(text)
+
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			&mcp.TextContent{
-				Text: fmt.Sprintf("Words: %d\nCharacters: %d\nCharacters (no whitespace): %d\nLines: %d",
-					words, chars, charsNoSpaces, lines),
+				Text: stats.String(),
 			},
 		},
-	}, result, nil
+	}, wordCountOutput{wordStats: stats}, nil
+}
+
+// handleWordCountDocuments counts each of args.Texts, reporting progress
+// after every document.
+func handleWordCountDocuments(ctx context.Context, req *mcp.CallToolRequest, args WordCountArgs) (*mcp.CallToolResult, wordCountOutput, error) {
+	logMsg("[TOOL]", fmt.Sprintf("word_count called with %d documents", len(args.Texts)))
+
+	if args.Text != "" {
+		return errorResult("Please provide either 'text' or 'texts', not both"), wordCountOutput{}, nil
+	}
+
+	total := float64(len(args.Texts))
+	documents := make([]wordStats, 0, len(args.Texts))
+	var totals wordStats
+	var lines []string
+
+	for i, text := range args.Texts {
+		if err := ctx.Err(); err != nil {
+			return cancelledResult(err), wordCountOutput{}, nil
+		}
+
+		if args.NormalizeWhitespace {
+			text = normalizeWhitespace(text)
+		}
+		stats := countWords(text)
+		documents = append(documents, stats)
+		totals.add(stats)
+		lines = append(lines, fmt.Sprintf("Document %d: %d words, %d characters, %d lines",
+			i+1, stats.Words, stats.Characters, stats.Lines))
+
+		notifyProgress(ctx, req, float64(i+1), total, fmt.Sprintf("Counted document %d", i+1))
+	}
+
+	lines = append(lines, fmt.Sprintf("Total: %d words, %d characters, %d lines",
+		totals.Words, totals.Characters, totals.Lines))
+
+	return textResult(strings.Join(lines, "\n")),
+		wordCountOutput{wordStats: totals, Documents: documents, Totals: &totals}, nil
+}
+
+// roundDecimal rounds value to the given number of decimal places using the
+// named mode. The value is rounded as its shortest decimal representation, so
+// 2.675 is treated as exactly 2.675 rather than the nearby binary float
+// 2.67499999....
+//
+//   - half_up rounds ties away from zero (2.675 -> 2.68, -2.675 -> -2.68)
+//   - half_even rounds ties to the nearest even digit (2.675 -> 2.68, 0.125 -> 0.12)
+//   - down truncates toward zero (2.679 -> 2.67)
+func roundDecimal(value float64, decimals int, mode string) (float64, error) {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return 0, fmt.Errorf("cannot round value: %v", value)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	r.Mul(r, new(big.Rat).SetInt(scale))
+
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	twiceRem := new(big.Int).Abs(rem)
+	twiceRem.Lsh(twiceRem, 1)
+	cmp := twiceRem.Cmp(r.Denom())
+
+	var awayFromZero bool
+	switch mode {
+	case "half_up":
+		awayFromZero = cmp >= 0
+	case "half_even":
+		awayFromZero = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+	case "down":
+		awayFromZero = false
+	default:
+		return 0, fmt.Errorf("unsupported rounding mode: %s", mode)
+	}
+
+	if awayFromZero {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+
+	result, _ := new(big.Rat).SetFrac(q, scale).Float64()
+	return result, nil
+}
+
+type currencyInfo struct {
+	Symbol   string
+	Decimals int
+	// CashIncrement is the smallest cash denomination in common circulation.
+	CashIncrement float64
+}
+
+var currencies = map[string]currencyInfo{
+	"USD": {Symbol: "$", Decimals: 2, CashIncrement: 0.01},
+	"EUR": {Symbol: "€", Decimals: 2, CashIncrement: 0.01},
+	"GBP": {Symbol: "£", Decimals: 2, CashIncrement: 0.01},
+	"JPY": {Symbol: "¥", Decimals: 0, CashIncrement: 1},
+}
+
+// currencySymbols maps a symbol to the currency it most commonly denotes,
+// along with the other currencies that share it.
+var currencySymbols = map[string]struct {
+	Code  string
+	Other []string
+}{
+	"$": {Code: "USD", Other: []string{"CAD", "AUD", "NZD", "MXN"}},
+	"€": {Code: "EUR"},
+	"£": {Code: "GBP"},
+	"¥": {Code: "JPY", Other: []string{"CNY"}},
+}
+
+// normalizeCurrency resolves a currency code in any case, or a currency
+// symbol, to a supported currency code. For symbols shared by several
+// currencies it returns a note describing the assumption made.
+func normalizeCurrency(input string) (code, note string, ok bool) {
+	trimmed := strings.TrimSpace(input)
+
+	code = strings.ToUpper(trimmed)
+	if _, ok := currencies[code]; ok {
+		return code, "", true
+	}
+
+	sym, ok := currencySymbols[trimmed]
+	if !ok {
+		return "", "", false
+	}
+	if len(sym.Other) > 0 {
+		note = fmt.Sprintf("Symbol %q is also used by %s; assumed %s", trimmed, strings.Join(sym.Other, ", "), sym.Code)
+	}
+	return sym.Code, note, true
+}
+
+// formatMoney formats amount with the currency's symbol and decimal places.
+func formatMoney(amount float64, info currencyInfo) string {
+	return fmt.Sprintf("%s%.*f", info.Symbol, info.Decimals, amount)
 }
 
 func handleFormatCurrency(ctx context.Context, req *mcp.CallToolRequest, args FormatCurrencyArgs) (*mcp.CallToolResult, any, error) {
+	if args.Currency == "" {
+		args.Currency = config.DefaultCurrency
+	}
+
 	logMsg("[TOOL]", fmt.Sprintf("format_currency called: %.2f %s", args.Amount, args.Currency))
 
-	var symbol string
-	var decimals int
-
-	switch args.Currency {
-	case "USD":
-		symbol = "$"
-		decimals = 2
-	case "EUR":
-		symbol = "€"
-		decimals = 2
-	case "GBP":
-		symbol = "£"
-		decimals = 2
-	case "JPY":
-		symbol = "¥"
-		decimals = 0
-	default:
+	if args.Currency == "" {
+		return errorResult("Please provide 'currency'"), nil, nil
+	}
+
+	code, note, ok := normalizeCurrency(args.Currency)
+	if !ok {
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
@@ -105,12 +338,26 @@ func handleFormatCurrency(ctx context.Context, req *mcp.CallToolRequest, args Fo
 			IsError: true,
 		}, nil, nil
 	}
+	info := currencies[code]
 
-	var formatted string
-	if decimals == 0 {
-		formatted = fmt.Sprintf("%s%.0f", symbol, args.Amount)
-	} else {
-		formatted = fmt.Sprintf("%s%.2f", symbol, args.Amount)
+	amount := args.Amount
+	if args.Rounding != "" {
+		rounded, err := roundDecimal(amount, info.Decimals, args.Rounding)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		amount = rounded
+	}
+
+	formatted := formatMoney(amount, info)
+
+	result := map[string]any{"formatted": formatted, "currency": code}
+	if args.Rounding != "" {
+		result["rounded"] = amount
+		result["rounding"] = args.Rounding
+	}
+	if note != "" {
+		result["assumption"] = note
 	}
 
 	return &mcp.CallToolResult{
@@ -119,48 +366,171 @@ func handleFormatCurrency(ctx context.Context, req *mcp.CallToolRequest, args Fo
 				Text: formatted,
 			},
 		},
-	}, map[string]any{"formatted": formatted}, nil
+	}, result, nil
+}
+
+var slugSeparatorPattern = regexp.MustCompile("[^a-z0-9]+")
+
+func slugify(text string) string {
+	slug := strings.ToLower(text)
+	slug = strings.TrimSpace(slug)
+
+	slug = slugSeparatorPattern.ReplaceAllString(slug, "-")
+
+	return strings.Trim(slug, "-")
+}
+
+// applyNoLeadingDigit rewrites a slug that starts with a digit. The "prefix"
+// behavior prepends prefix ("123-test" -> "n-123-test"); the "strip" behavior
+// removes the leading digits ("123-test" -> "test"). It reports whether the
+// slug was changed.
+func applyNoLeadingDigit(slug, behavior, prefix string) (string, bool, error) {
+	if behavior != "prefix" && behavior != "strip" {
+		return "", false, fmt.Errorf("unsupported no_leading_digit behavior: %s", behavior)
+	}
+	if behavior == "prefix" {
+		// A prefix that is itself a digit, or that has nothing left once
+		// slugified, would leave the slug starting with a digit.
+		slugged := slugify(prefix)
+		if slugged == "" {
+			return "", false, fmt.Errorf("leading_digit_prefix must contain a letter: %q", prefix)
+		}
+		if prefix[0] >= '0' && prefix[0] <= '9' || slugged[0] >= '0' && slugged[0] <= '9' {
+			return "", false, fmt.Errorf("leading_digit_prefix must not start with a digit: %q", prefix)
+		}
+	}
+	if slug == "" || slug[0] < '0' || slug[0] > '9' {
+		return slug, false, nil
+	}
+
+	if behavior == "strip" {
+		return strings.TrimLeft(slug, "0123456789-"), true, nil
+	}
+	return prefix + slug, true, nil
+}
+
+// slugifyWithOptions runs text through the same pipeline as the slugify
+// tool: optional whitespace normalization, slugify, then the optional
+// no_leading_digit rewrite. It reports whether that rewrite changed the slug.
+func slugifyWithOptions(text string, args SlugifyArgs) (string, bool, error) {
+	if args.NormalizeWhitespace {
+		text = normalizeWhitespace(text)
+	}
+	slug := slugify(text)
+
+	if args.NoLeadingDigit == "" {
+		return slug, false, nil
+	}
+	prefix := "n-"
+	if args.LeadingDigitPrefix != nil {
+		prefix = *args.LeadingDigitPrefix
+	}
+	return applyNoLeadingDigit(slug, args.NoLeadingDigit, prefix)
 }
 
 func handleSlugify(ctx context.Context, req *mcp.CallToolRequest, args SlugifyArgs) (*mcp.CallToolResult, any, error) {
 	logMsg("[TOOL]", fmt.Sprintf("slugify called with text: %s", args.Text))
 
-	slug := strings.ToLower(args.Text)
-	slug = strings.TrimSpace(slug)
+	slug, transformed, err := slugifyWithOptions(args.Text, args)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	result := map[string]any{"slug": slug}
+	if args.NoLeadingDigit != "" {
+		result["transformed"] = transformed
+	}
 
-	reg := regexp.MustCompile("[^a-z0-9]+")
-	slug = reg.ReplaceAllString(slug, "-")
+	text := slug
+	if args.PreviousText != nil {
+		// The previous slug is only computed for comparison, so it is not
+		// recorded in the session's generated slugs.
+		previous, _, _ := slugifyWithOptions(*args.PreviousText, args)
+		changed := previous != slug
+		result["previous_slug"] = previous
+		result["changed"] = changed
+		if changed {
+			text += fmt.Sprintf("\nChanged from: %s", previous)
+		} else {
+			text += "\nUnchanged"
+		}
+	}
 
-	slug = strings.Trim(slug, "-")
+	if args.Variants {
+		variants, err := slugVariants(args.Text, args)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		result["variants"] = variants
+		for _, v := range variants {
+			text += fmt.Sprintf("\n%s: %s", v.Label, v.Slug)
+		}
+	}
+
+	// An empty slug names nothing, so it is not recorded.
+	seenBefore := false
+	if slug != "" {
+		seenBefore = generatedSlugs.Add(slug)
+	}
+	if args.CheckDuplicate {
+		result["seen_before"] = seenBefore
+	}
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			&mcp.TextContent{
-				Text: slug,
+				Text: text,
 			},
 		},
-	}, map[string]any{"slug": slug}, nil
+	}, result, nil
 }
 
-func intToRoman(num int) string {
+type romanComponent struct {
+	Symbol string `json:"symbol"`
+	Value  int    `json:"value"`
+}
+
+// romanComponents decomposes num into the ordered symbols that make up its
+// Roman numeral, e.g. 1994 becomes M, CM, XC, IV.
+func romanComponents(num int) []romanComponent {
 	if num < 1 || num > 3999 {
-		return ""
+		return nil
 	}
 
 	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
 	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
 
-	var result strings.Builder
+	var components []romanComponent
 	for i := 0; i < len(values); i++ {
 		for num >= values[i] {
-			result.WriteString(symbols[i])
+			components = append(components, romanComponent{Symbol: symbols[i], Value: values[i]})
 			num -= values[i]
 		}
 	}
 
+	return components
+}
+
+func intToRoman(num int) string {
+	var result strings.Builder
+	for _, c := range romanComponents(num) {
+		result.WriteString(c.Symbol)
+	}
+
 	return result.String()
 }
 
+// explainRoman renders a breakdown such as "1994 = M + CM + XC + IV (1000 + 900 + 90 + 4)".
+func explainRoman(num int, components []romanComponent) string {
+	symbols := make([]string, len(components))
+	values := make([]string, len(components))
+	for i, c := range components {
+		symbols[i] = c.Symbol
+		values[i] = fmt.Sprintf("%d", c.Value)
+	}
+
+	return fmt.Sprintf("%d = %s (%s)", num, strings.Join(symbols, " + "), strings.Join(values, " + "))
+}
+
 func romanToInt(s string) (int, error) {
 	s = strings.ToUpper(s)
 	romanMap := map[rune]int{
@@ -235,6 +605,12 @@ func handleRomanNumeral(ctx context.Context, req *mcp.CallToolRequest, args Roma
 		roman := intToRoman(num)
 		logMsg("[TOOL]", fmt.Sprintf("Converted %d to %s", num, roman))
 
+		if args.Explain {
+			components := romanComponents(num)
+			return textResult(roman + "\n" + explainRoman(num, components)),
+				map[string]any{"roman": roman, "breakdown": components}, nil
+		}
+
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
@@ -258,6 +634,15 @@ func handleRomanNumeral(ctx context.Context, req *mcp.CallToolRequest, args Roma
 
 	logMsg("[TOOL]", fmt.Sprintf("Converted %s to %d", *args.Roman, decimal))
 
+	if args.Explain {
+		components := romanComponents(decimal)
+		if components == nil {
+			return errorResult("Cannot explain a numeral outside the range 1-3999"), nil, nil
+		}
+		return textResult(fmt.Sprintf("%d\n%s", decimal, explainRoman(decimal, components))),
+			map[string]any{"decimal": decimal, "breakdown": components}, nil
+	}
+
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			&mcp.TextContent{
@@ -267,46 +652,146 @@ func handleRomanNumeral(ctx context.Context, req *mcp.CallToolRequest, args Roma
 	}, map[string]any{"decimal": decimal}, nil
 }
 
-func handleTemperatureConvert(ctx context.Context, req *mcp.CallToolRequest, args TemperatureConvertArgs) (*mcp.CallToolResult, any, error) {
+// temperatureUnitAliases maps common spellings, abbreviations, and
+// misspellings of temperature units to their canonical names. Keys are
+// lowercase with any degree symbol and "degrees" prefix already removed.
+var temperatureUnitAliases = map[string]string{
+	"c":          "celsius",
+	"celsius":    "celsius",
+	"celcius":    "celsius",
+	"celsuis":    "celsius",
+	"centigrade": "celsius",
+	"f":          "fahrenheit",
+	"fahrenheit": "fahrenheit",
+	"farenheit":  "fahrenheit",
+	"fahrenhiet": "fahrenheit",
+	"faranheit":  "fahrenheit",
+	"k":          "kelvin",
+	"kelvin":     "kelvin",
+	"kelvins":    "kelvin",
+	"kelven":     "kelvin",
+}
+
+// normalizeTemperatureUnit maps user input such as "C", "°C", "℃",
+// "Degrees Celsius", or "celcius" to a canonical unit name.
+func normalizeTemperatureUnit(unit string) (string, bool) {
+	u := strings.ToLower(strings.TrimSpace(unit))
+	u = strings.NewReplacer("℃", "c", "℉", "f", "°", "", "º", "").Replace(u)
+	for _, prefix := range []string{"degrees", "degree", "deg"} {
+		if rest, ok := strings.CutPrefix(u, prefix); ok {
+			u = rest
+			break
+		}
+	}
+	u = strings.Trim(u, ". ")
+
+	canonical, ok := temperatureUnitAliases[u]
+	return canonical, ok
+}
+
+// roundTo rounds value half away from zero to the given number of decimal
+// places, removing binary floating-point noise such as 36.669999999 from
+// converted values.
+func roundTo(value float64, precision int) float64 {
+	scale := math.Pow10(precision)
+	scaled := value * scale
+	if math.IsNaN(scaled) || math.IsInf(scaled, 0) {
+		// Values this large have no fractional digits left to round.
+		return value
+	}
+	return math.Round(scaled) / scale
+}
+
+// withDefaults fills omitted units from the server configuration.
+func (a TemperatureConvertArgs) withDefaults() TemperatureConvertArgs {
+	if a.FromUnit == "" {
+		a.FromUnit = config.DefaultFromUnit
+	}
+	if a.ToUnit == "" {
+		a.ToUnit = config.DefaultToUnit
+	}
+	return a
+}
+
+// Validate reports every problem with the arguments, after defaults have been
+// applied, without performing the conversion.
+func (a TemperatureConvertArgs) Validate() []string {
+	a = a.withDefaults()
+
+	var problems []string
+	if a.FromUnit == "" || a.ToUnit == "" {
+		problems = append(problems, "Please provide both 'from_unit' and 'to_unit'")
+	}
+	if a.Precision != nil && (*a.Precision < 0 || *a.Precision > 10) {
+		problems = append(problems, "Precision must be between 0 and 10")
+	}
+	for _, unit := range []string{a.FromUnit, a.ToUnit} {
+		if _, ok := normalizeTemperatureUnit(unit); unit != "" && !ok {
+			problems = append(problems, fmt.Sprintf("unknown unit: %s", unit))
+		}
+	}
+	return problems
+}
+
+func toCelsius(value float64, unit string) (float64, error) {
+	switch unit {
+	case "celsius":
+		return value, nil
+	case "fahrenheit":
+		return (value - 32) * 5 / 9, nil
+	case "kelvin":
+		return value - 273.15, nil
+	default:
+		return 0, fmt.Errorf("unknown unit: %s", unit)
+	}
+}
+
+func fromCelsius(value float64, unit string) (float64, error) {
+	switch unit {
+	case "celsius":
+		return value, nil
+	case "fahrenheit":
+		return value*9/5 + 32, nil
+	case "kelvin":
+		return value + 273.15, nil
+	default:
+		return 0, fmt.Errorf("unknown unit: %s", unit)
+	}
+}
+
+// handleTemperatureConvert converts via Celsius and rounds the result to the
+// requested precision. The returned value is the exact conversion rounded half
+// away from zero, so a round trip such as C -> F -> C returns the original
+// value to within one unit in the last requested decimal place.
+func handleTemperatureConvert(ctx context.Context, req *mcp.CallToolRequest, args TemperatureConvertArgs) (*mcp.CallToolResult, TemperatureConvertOutput, error) {
+	args = args.withDefaults()
+
 	logMsg("[TOOL]", fmt.Sprintf("temperature_convert called: %.2f %s to %s", args.Value, args.FromUnit, args.ToUnit))
 
-	if args.FromUnit == args.ToUnit {
+	if problems := args.Validate(); len(problems) > 0 {
+		return errorResult(problems[0]), TemperatureConvertOutput{}, nil
+	}
+
+	precision := 2
+	if args.Precision != nil {
+		precision = *args.Precision
+	}
+
+	fromUnit, _ := normalizeTemperatureUnit(args.FromUnit)
+	toUnit, _ := normalizeTemperatureUnit(args.ToUnit)
+
+	if fromUnit == toUnit {
+		value := roundTo(args.Value, precision)
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
-					Text: fmt.Sprintf("%.2f", args.Value),
+					Text: fmt.Sprintf("%.*f", precision, value),
 				},
 			},
-		}, map[string]any{"result": args.Value}, nil
-	}
-
-	toCelsius := func(value float64, unit string) (float64, error) {
-		switch unit {
-		case "celsius":
-			return value, nil
-		case "fahrenheit":
-			return (value - 32) * 5 / 9, nil
-		case "kelvin":
-			return value - 273.15, nil
-		default:
-			return 0, fmt.Errorf("unknown unit: %s", unit)
-		}
+		}, TemperatureConvertOutput{Result: value}, nil
 	}
 
-	fromCelsius := func(value float64, unit string) (float64, error) {
-		switch unit {
-		case "celsius":
-			return value, nil
-		case "fahrenheit":
-			return value*9/5 + 32, nil
-		case "kelvin":
-			return value + 273.15, nil
-		default:
-			return 0, fmt.Errorf("unknown unit: %s", unit)
-		}
-	}
-
-	celsius, err := toCelsius(args.Value, args.FromUnit)
+	celsius, err := toCelsius(args.Value, fromUnit)
 	if err != nil {
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -315,10 +800,10 @@ func handleTemperatureConvert(ctx context.Context, req *mcp.CallToolRequest, arg
 				},
 			},
 			IsError: true,
-		}, nil, nil
+		}, TemperatureConvertOutput{}, nil
 	}
 
-	result, err := fromCelsius(celsius, args.ToUnit)
+	result, err := fromCelsius(celsius, toUnit)
 	if err != nil {
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -327,7 +812,7 @@ func handleTemperatureConvert(ctx context.Context, req *mcp.CallToolRequest, arg
 				},
 			},
 			IsError: true,
-		}, nil, nil
+		}, TemperatureConvertOutput{}, nil
 	}
 
 	if math.IsNaN(result) || math.IsInf(result, 0) {
@@ -338,55 +823,521 @@ func handleTemperatureConvert(ctx context.Context, req *mcp.CallToolRequest, arg
 				},
 			},
 			IsError: true,
-		}, nil, nil
+		}, TemperatureConvertOutput{}, nil
 	}
 
+	result = roundTo(result, precision)
+
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			&mcp.TextContent{
-				Text: fmt.Sprintf("%.2f", result),
+				Text: fmt.Sprintf("%.*f", precision, result),
 			},
 		},
-	}, map[string]any{"result": result}, nil
+	}, TemperatureConvertOutput{Result: result}, nil
 }
 
-func main() {
-	logMsg("[MAIN]", "Starting stdio MCP server")
-
-	impl := &mcp.Implementation{
-		Name:    "sample-mcp-server-stdio",
-		Version: "1.0.0",
+func registerTools(server *mcp.Server) {
+	if config.MaxConcurrency > 0 {
+		limiter := newCallLimiter(config.MaxConcurrency, config.ConcurrencyPolicy)
+		server.AddReceivingMiddleware(limiter.middleware)
+		logMsg("[MAIN]", fmt.Sprintf("Tool calls limited to %d at once (%s)", config.MaxConcurrency, config.ConcurrencyPolicy))
 	}
 
-	server := mcp.NewServer(impl, nil)
-
-	logMsg("[MAIN]", "Registering tools")
-
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(server, "text", &mcp.Tool{
 		Name:        "word_count",
 		Description: "Analyze text and count words, characters, and lines",
 	}, handleWordCount)
 
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(server, "formatting", &mcp.Tool{
 		Name:        "format_currency",
 		Description: "Format a number as currency with proper symbol and decimal places",
 	}, handleFormatCurrency)
 
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(server, "text", &mcp.Tool{
 		Name:        "slugify",
 		Description: "Convert text to a URL-friendly slug (lowercase, hyphens, no special characters)",
 	}, handleSlugify)
 
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(server, "text", &mcp.Tool{
+		Name:        "slugify_batch",
+		Description: "Slugify a list of strings, making duplicates unique by appending -2, -3, and so on",
+	}, handleSlugifyBatch)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "safe_filename",
+		Description: "Turn a string into a file name that is valid on Windows, macOS, and Linux",
+	}, handleSafeFilename)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "parse_args",
+		Description: "Split a command line into arguments with POSIX shell quoting rules, or quote arguments into a safe command line",
+	}, handleParseArgs)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "normalize_whitespace",
+		Description: "Collapse runs of whitespace (spaces, tabs, newlines) into single spaces and trim the ends",
+	}, handleNormalizeWhitespace)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "remove_diacritics",
+		Description: "Strip accents and other diacritics from Latin letters (naïve café -> naive cafe), optionally from every script",
+	}, handleRemoveDiacritics)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "line_endings",
+		Description: "Detect LF, CRLF, and CR line endings in text, including mixed files, or convert them all to one style",
+	}, handleLineEndings)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "count_occurrences",
+		Description: "Count occurrences of a substring or regular expression in text, optionally overlapping or ignoring case",
+	}, handleCountOccurrences)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "highlight",
+		Description: "Wrap case-insensitive matches of search terms in markers and count matches per term",
+	}, handleHighlight)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "regex_from_examples",
+		Description: "Generate a simple anchored regular expression that matches every given example string",
+	}, handleRegexFromExamples)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "regex_test",
+		Description: "Test a regular expression against sample strings and report each match with its capture groups",
+	}, handleRegexTest)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "redact",
+		Description: "Mask emails, phone numbers, and card-like digit runs in text",
+	}, handleRedact)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "title_case",
+		Description: "Convert text to title case following AP-style rules for small words",
+	}, handleTitleCase)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "entropy",
+		Description: "Compute the Shannon entropy of text in bits per character and in total",
+	}, handleEntropy)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "compress_ratio",
+		Description: "Compress text with gzip, flate, or zlib and report the original size, compressed size, and ratio",
+	}, handleCompressRatio)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "chunk_text",
+		Description: "Split text into overlapping chunks for LLM context windows, preferring paragraph and sentence boundaries",
+	}, handleChunkText)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "token_count",
+		Description: "Estimate how many LLM tokens text will use, based on a characters-per-token heuristic",
+	}, handleTokenCount)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "simhash",
+		Description: "Compute a SimHash fingerprint of text for near-duplicate detection, or compare two fingerprints",
+	}, handleSimHash)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "syllables",
+		Description: "Estimate the syllable count of each word in English text and the total, using a vowel-group heuristic",
+	}, handleSyllables)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "anagram",
+		Description: "Check whether two strings are anagrams or group a list of words into anagram sets",
+	}, handleAnagram)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "set_ops",
+		Description: "Compute the union, intersection, difference, or symmetric difference of two string lists",
+	}, handleSetOps)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "common_string",
+		Description: "Find the longest common substring or longest common subsequence of two strings",
+	}, handleCommonString)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "sort_lines",
+		Description: "Sort the lines of text lexically or numerically, optionally reversed, case-insensitive, or deduplicated",
+	}, handleSortLines)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "transpose",
+		Description: "Transpose delimited tabular text, swapping rows and columns",
+	}, handleTranspose)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "render_table",
+		Description: "Render delimited rows as an aligned ASCII or Unicode box table, sizing columns by terminal display width",
+	}, handleRenderTable)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "toc",
+		Description: "Generate a nested table of contents with anchor links from markdown headings",
+	}, handleTOC)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "grapheme_count",
+		Description: "Count user-perceived characters (grapheme clusters) in text alongside runes and bytes",
+	}, handleGraphemeCount)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "unicode_stats",
+		Description: "Count characters by Unicode category and script to spot mixed-script or unusual input",
+	}, handleUnicodeStats)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "phonetic_code",
+		Description: "Compute the Soundex or Metaphone phonetic code of a word for sound-alike name matching",
+	}, handlePhoneticCode)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "extract_numbers",
+		Description: "Find integers, decimals, percentages, and currency amounts in text with their positions",
+	}, handleExtractNumbers)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "zero_width",
+		Description: "Hide a short secret in cover text using zero-width characters, or recover a hidden secret",
+	}, handleZeroWidth)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "display_width",
+		Description: "Measure the terminal column width of text, counting wide CJK and emoji characters as 2 and combining marks as 0",
+	}, handleDisplayWidth)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "pad_text",
+		Description: "Pad text to a display width with left, right, or center alignment, optionally truncating with an ellipsis",
+	}, handlePadText)
+
+	addTool(server, "network", &mcp.Tool{
+		Name:        "ip_subnet",
+		Description: "Compute the network, broadcast, usable host range, host count, and netmask of an IPv4 or IPv6 CIDR",
+	}, handleIPSubnet)
+
+	addTool(server, "network", &mcp.Tool{
+		Name:        "ip_in_range",
+		Description: "Check whether an IP address falls within any of a list of CIDR ranges",
+	}, handleIPInRange)
+
+	addTool(server, "network", &mcp.Tool{
+		Name:        "ip_normalize",
+		Description: "Expand or compress an IPv6 address, or report an IPv4 address as an integer and hex",
+	}, handleIPNormalize)
+
+	addTool(server, "network", &mcp.Tool{
+		Name:        "mac_address",
+		Description: "Validate and normalize a MAC address, extract its OUI, and report its multicast and locally administered bits",
+	}, handleMACAddress)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "validate_hostname",
+		Description: "Check a hostname against RFC 1123 rules, optionally punycode-encoding internationalized labels",
+	}, handleValidateHostname)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "detect_confusables",
+		Description: "Flag homograph spoofing: mixed-script lookalike characters (such as Cyrillic а among Latin letters) and invisible characters",
+	}, handleDetectConfusables)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "password_strength",
+		Description: "Score a password's strength from its length, character variety, and common patterns, with suggestions. Runs entirely offline",
+	}, handlePasswordStrength)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "passphrase",
+		Description: "Generate a memorable diceware-style passphrase from a built-in wordlist and report its entropy",
+	}, handlePassphrase)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "phone",
+		Description: "Normalize a phone number to E.164 format and report its country and type",
+	}, handlePhone)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "national_id",
+		Description: "Validate and mask a national identifier (US SSN, UK National Insurance number, Canadian SIN, Spanish DNI/NIE, Dutch BSN)",
+	}, handleNationalID)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "json_schema_validate",
+		Description: "Validate a JSON document against a JSON Schema and list every violation with its path",
+	}, handleJSONSchemaValidate)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "json_diff",
+		Description: "Compare two JSON documents structurally and list added, removed, and changed paths, ignoring key order",
+	}, handleJSONDiff)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "json_merge",
+		Description: "Deep-merge two JSON objects, resolving conflicts right-wins, left-wins, or by concatenating arrays",
+	}, handleJSONMerge)
+
+	addTool(server, "validation", &mcp.Tool{
+		Name:        "semver",
+		Description: "Parse semantic versions, compare them by precedence, or check them against a range constraint",
+	}, handleSemver)
+
+	addTool(server, "formatting", &mcp.Tool{
+		Name:        "xml_format",
+		Description: "Validate that XML is well-formed and pretty-print or minify it",
+	}, handleXMLFormat)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "jwt_decode",
+		Description: "Decode a JWT's header and payload for inspection. Does NOT verify the signature",
+	}, handleJWTDecode)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "qr_code",
+		Description: "Encode text as a QR code rendered with Unicode blocks or ASCII for terminals",
+	}, handleQRCode)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "identicon",
+		Description: "Generate a deterministic identicon grid for an email address or username",
+	}, handleIdenticon)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "string_to_color",
+		Description: "Map a string to a stable hex color by hashing it to a hue with fixed saturation and lightness",
+	}, handleStringToColor)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "check_digit",
+		Description: "Compute or verify EAN-13 and UPC-A barcode check digits",
+	}, handleCheckDigit)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "checksum",
+		Description: "Compute CRC32, CRC16, Adler-32, or Fletcher checksums of text or hex-encoded bytes",
+	}, handleChecksum)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "ulid",
+		Description: "Generate time-sortable ULIDs or parse a ULID into its timestamp and randomness",
+	}, handleULID)
+
+	addTool(server, "encoding", &mcp.Tool{
+		Name:        "escape_string",
+		Description: "Escape a string for embedding in Go, JSON, shell, SQL, or HTML, or unescape it again",
+	}, handleEscapeString)
+
+	addTool(server, "conversion", &mcp.Tool{
 		Name:        "roman_numeral",
 		Description: "Convert between decimal numbers (1-3999) and Roman numerals",
 	}, handleRomanNumeral)
 
-	mcp.AddTool(server, &mcp.Tool{
+	addTool(server, "math", &mcp.Tool{
+		Name:        "roman_math",
+		Description: "Add, subtract, multiply, or divide two Roman numerals",
+	}, handleRomanMath)
+
+	addTool(server, "conversion", &mcp.Tool{
+		Name:        "number_system",
+		Description: "Convert an integer to Roman numerals, English words, binary, hex, or an ordinal, or all at once",
+	}, handleNumberSystem)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "aggregate",
+		Description: "Compute the sum, mean, min, max, and running totals of a column of delimited numeric data",
+	}, handleAggregate)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "proportions",
+		Description: "Express each number in a list as a percentage of the total, optionally rounded to sum to exactly 100",
+	}, handleProportions)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "fraction",
+		Description: "Convert fractions and mixed numbers to decimals, or approximate a decimal as a fraction",
+	}, handleFraction)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "bigmath",
+		Description: "Arbitrary-precision integer math: modular exponentiation, modular inverse, and gcd on decimal strings",
+	}, handleBigMath)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "sequence",
+		Description: "Compute the nth Fibonacci, triangular, factorial, or Catalan number, optionally with all earlier terms",
+	}, handleSequence)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "weighted_choice",
+		Description: "Randomly draw one or more options with probability proportional to their weights, with or without replacement",
+	}, handleWeightedChoice)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "calc",
+		Description: "Evaluate an arithmetic expression with + - * / ^ and parentheses using standard operator precedence",
+	}, handleCalc)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "geometry",
+		Description: "Compute the area and perimeter of a circle, rectangle, or triangle",
+	}, handleGeometry)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "geo_distance",
+		Description: "Compute the great-circle distance and initial bearing between two latitude/longitude points",
+	}, handleGeoDistance)
+
+	addTool(server, "math", &mcp.Tool{
+		Name:        "bmi",
+		Description: "Compute Body Mass Index and its WHO category from metric or imperial weight and height",
+	}, handleBMI)
+
+	addTool(server, "conversion", &mcp.Tool{
+		Name:        "spreadsheet_column",
+		Description: "Convert between spreadsheet column letters (A, AA, XFD) and 1-based column numbers",
+	}, handleSpreadsheetColumn)
+
+	addTool(server, "conversion", &mcp.Tool{
 		Name:        "temperature_convert",
 		Description: "Convert temperatures between Celsius, Fahrenheit, and Kelvin",
 	}, handleTemperatureConvert)
 
+	addTool(server, "conversion", &mcp.Tool{
+		Name:        "feels_like",
+		Description: "Compute the apparent temperature from wind chill or heat index",
+	}, handleFeelsLike)
+
+	addTool(server, "conversion", &mcp.Tool{
+		Name:        "angle_convert",
+		Description: "Convert angles between degrees, radians, gradians, and turns, optionally normalized into one full turn",
+	}, handleAngleConvert)
+
+	addTool(server, "conversion", &mcp.Tool{
+		Name:        "cooking_convert",
+		Description: "Convert cooking measurements between cups, spoons, milliliters, and liters, or to and from weight given a density",
+	}, handleCookingConvert)
+
+	addTool(server, "conversion", &mcp.Tool{
+		Name:        "chmod",
+		Description: "Convert Unix permissions between octal (755) and symbolic (rwxr-xr-x) forms and describe what they allow",
+	}, handleChmod)
+
+	addTool(server, "formatting", &mcp.Tool{
+		Name:        "format_number",
+		Description: "Format a number using fixed decimals, significant figures, scientific, or engineering notation",
+	}, handleFormatNumber)
+
+	addTool(server, "text", &mcp.Tool{
+		Name:        "word_count_files",
+		Description: "Count words, characters, and lines across multiple files, reporting progress per file",
+	}, handleWordCountFiles)
+
+	addTool(server, "finance", &mcp.Tool{
+		Name:        "interest",
+		Description: "Calculate simple or compound interest for a principal, annual rate, and time period",
+	}, handleInterest)
+
+	addTool(server, "finance", &mcp.Tool{
+		Name:        "amortization",
+		Description: "Compute the monthly payment and amortization schedule of a fixed-rate loan",
+	}, handleAmortization)
+
+	addTool(server, "finance", &mcp.Tool{
+		Name:        "convert_currency",
+		Description: "Convert an amount between currencies using a caller-supplied exchange rate or rate table, formatted for the target currency",
+	}, handleConvertCurrency)
+
+	addTool(server, "finance", &mcp.Tool{
+		Name:        "round_cash",
+		Description: "Round an amount to the nearest cash denomination for a currency (e.g. 0.05 for Swiss-style rounding)",
+	}, handleRoundCash)
+
+	addTool(server, "date", &mcp.Tool{
+		Name:        "business_days",
+		Description: "Count working days between two dates, excluding weekends and optional holidays",
+	}, handleBusinessDays)
+
+	addTool(server, "date", &mcp.Tool{
+		Name:        "business_hours",
+		Description: "Compute the working time between two timestamps within daily business hours, skipping weekends and holidays",
+	}, handleBusinessHours)
+
+	addTool(server, "date", &mcp.Tool{
+		Name:        "next_occurrence",
+		Description: "List the next occurrences of a daily, weekly, or monthly recurrence rule after a reference time",
+	}, handleNextOccurrence)
+
+	addTool(server, "date", &mcp.Tool{
+		Name:        "date_facts",
+		Description: "Report the day of week, ISO week, day of year, leap year, and days in month for a date",
+	}, handleDateFacts)
+
+	addTool(server, "meta", &mcp.Tool{
+		Name:        "list_tools",
+		Description: "List the tools provided by this server, optionally filtered by category",
+	}, handleListTools)
+}
+
+func registerResources(server *mcp.Server) {
+	server.AddResource(&mcp.Resource{
+		URI:         generatedSlugsURI,
+		Name:        "generated-slugs",
+		Description: "Slugs generated by slugify and slugify_batch during this session, oldest first",
+		MIMEType:    "application/json",
+	}, handleGeneratedSlugsResource)
+}
+
+// newServer creates a server from the current config, with the audit log
+// enabled when configured and every tool and resource registered. The
+// returned function closes the audit log.
+func newServer() (*mcp.Server, func(), error) {
+	impl := &mcp.Implementation{
+		Name:    "sample-mcp-server-stdio",
+		Version: "1.0.0",
+	}
+
+	server := mcp.NewServer(impl, nil)
+
+	closeServer := func() {}
+	if config.AuditFile != "" {
+		audit, err := openAuditLog(config.AuditFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		closeServer = func() { audit.Close() }
+		server.AddReceivingMiddleware(audit.middleware)
+		logMsg("[MAIN]", fmt.Sprintf("Audit log enabled: %s", config.AuditFile))
+	}
+
+	logMsg("[MAIN]", "Registering tools")
+	registerTools(server)
+	registerResources(server)
+
+	return server, closeServer, nil
+}
+
+func main() {
+	logMsg("[MAIN]", "Starting stdio MCP server")
+
+	cfg, err := loadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatalf("[ERROR] Invalid configuration: %v", err)
+	}
+	config = cfg
+
+	server, closeServer, err := newServer()
+	if err != nil {
+		log.Fatalf("[ERROR] Failed to open audit log: %v", err)
+	}
+	defer closeServer()
+
 	logMsg("[MAIN]", "Starting server on stdio")
 
 	if err := server.Run(context.Background(), &mcp.StdioTransport{}); err != nil && err != io.EOF {
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRomanNumeralExplain(t *testing.T) {
+	runToolCases(t, handleRomanNumeral, []toolCase[RomanNumeralArgs]{
+		{
+			name: "number",
+			args: RomanNumeralArgs{Number: ptr(1994), Explain: true},
+			text: "MCMXCIV\n1994 = M + CM + XC + IV (1000 + 900 + 90 + 4)",
+			out:  `{"roman":"MCMXCIV","breakdown":[{"symbol":"M","value":1000},{"symbol":"CM","value":900},{"symbol":"XC","value":90},{"symbol":"IV","value":4}]}`,
+		},
+		{
+			name: "numeral",
+			args: RomanNumeralArgs{Roman: ptr("mmxxiv"), Explain: true},
+			text: "2024\n2024 = M + M + X + X + IV (1000 + 1000 + 10 + 10 + 4)",
+			out:  `{"decimal":2024}`,
+		},
+		{name: "without explain", args: RomanNumeralArgs{Number: ptr(4)}, text: "IV", out: `{"roman":"IV"}`},
+		{name: "numeral out of range", args: RomanNumeralArgs{Roman: ptr("MMMMM"), Explain: true}, err: true, text: "Cannot explain a numeral outside the range 1-3999"},
+		{name: "number out of range", args: RomanNumeralArgs{Number: ptr(4000), Explain: true}, err: true, text: "Number must be between 1 and 3999"},
+	})
+}
+
+func TestRomanComponentsReconstruct(t *testing.T) {
+	for _, n := range []int{1, 4, 9, 14, 40, 90, 400, 444, 1994, 2024, 3888, 3999} {
+		components := romanComponents(n)
+		sum := 0
+		var numeral strings.Builder
+		for _, c := range components {
+			sum += c.Value
+			numeral.WriteString(c.Symbol)
+		}
+		if sum != n {
+			t.Errorf("components of %d sum to %d", n, sum)
+		}
+		if numeral.String() != intToRoman(n) {
+			t.Errorf("components of %d spell %s, want %s", n, numeral.String(), intToRoman(n))
+		}
+		if back, err := romanToInt(numeral.String()); err != nil || back != n {
+			t.Errorf("%s parses as %d, %v; want %d", numeral.String(), back, err, n)
+		}
+	}
+}
+
+func TestFormatCurrencyRounding(t *testing.T) {
+	runToolCases(t, handleFormatCurrency, []toolCase[FormatCurrencyArgs]{
+		{name: "2.675 half_up", args: FormatCurrencyArgs{Amount: 2.675, Currency: "USD", Rounding: "half_up"}, text: "$2.68", out: `{"formatted":"$2.68","rounded":2.68,"rounding":"half_up"}`},
+		{name: "2.675 half_even", args: FormatCurrencyArgs{Amount: 2.675, Currency: "USD", Rounding: "half_even"}, text: "$2.68", out: `{"rounded":2.68}`},
+		{name: "2.675 down", args: FormatCurrencyArgs{Amount: 2.675, Currency: "USD", Rounding: "down"}, text: "$2.67", out: `{"rounded":2.67}`},
+		{name: "0.125 half_up", args: FormatCurrencyArgs{Amount: 0.125, Currency: "EUR", Rounding: "half_up"}, text: "€0.13", out: `{"rounded":0.13}`},
+		{name: "0.125 half_even", args: FormatCurrencyArgs{Amount: 0.125, Currency: "EUR", Rounding: "half_even"}, text: "€0.12", out: `{"rounded":0.12}`},
+		{name: "0.125 down", args: FormatCurrencyArgs{Amount: 0.125, Currency: "EUR", Rounding: "down"}, text: "€0.12", out: `{"rounded":0.12}`},
+		{name: "yen", args: FormatCurrencyArgs{Amount: 2.5, Currency: "JPY", Rounding: "half_even"}, text: "¥2", out: `{"rounded":2}`},
+		{name: "unknown mode", args: FormatCurrencyArgs{Amount: 1, Currency: "USD", Rounding: "up"}, err: true, text: "unsupported rounding mode: up"},
+	})
+}
+
+func TestTemperatureConvertPrecision(t *testing.T) {
+	runToolCases(t, handleTemperatureConvert, []toolCase[TemperatureConvertArgs]{
+		{name: "default precision", args: TemperatureConvertArgs{Value: 100, FromUnit: "celsius", ToUnit: "fahrenheit"}, text: "212.00", out: `{"result":212}`},
+		{name: "rounded", args: TemperatureConvertArgs{Value: 37.7, FromUnit: "celsius", ToUnit: "fahrenheit", Precision: ptr(1)}, text: "99.9", out: `{"result":99.9}`},
+		{name: "zero precision", args: TemperatureConvertArgs{Value: 0, FromUnit: "fahrenheit", ToUnit: "celsius", Precision: ptr(0)}, text: "-18", out: `{"result":-18}`},
+		{name: "same unit", args: TemperatureConvertArgs{Value: 21.456, FromUnit: "kelvin", ToUnit: "kelvin", Precision: ptr(1)}, text: "21.5", out: `{"result":21.5}`},
+		{name: "large value", args: TemperatureConvertArgs{Value: 1e300, FromUnit: "kelvin", ToUnit: "celsius", Precision: ptr(10)}, out: `{"result":1e300}`},
+		{name: "overflow", args: TemperatureConvertArgs{Value: 1e308, FromUnit: "celsius", ToUnit: "fahrenheit"}, err: true, text: "Temperature conversion resulted in invalid value"},
+		{name: "precision too high", args: TemperatureConvertArgs{Value: 1, FromUnit: "celsius", ToUnit: "kelvin", Precision: ptr(11)}, err: true, text: "Precision must be between 0 and 10"},
+	})
+}
+
+func TestTemperatureConvertRoundTrip(t *testing.T) {
+	convert := func(value float64, from, to string, precision int) float64 {
+		t.Helper()
+		args := TemperatureConvertArgs{Value: value, FromUnit: from, ToUnit: to, Precision: ptr(precision)}
+		result, out := callTool(t, handleTemperatureConvert, args)
+		if result.IsError {
+			t.Fatalf("converting %g %s to %s failed: %v", value, from, to, resultText(result))
+		}
+		return numberField(t, out, "result")
+	}
+
+	for _, precision := range []int{0, 2, 6, 10} {
+		for _, c := range []float64{-273.15, -40, 0, 21.3, 36.6, 100, 1234.5678} {
+			f := convert(c, "celsius", "fahrenheit", precision)
+			back := convert(f, "fahrenheit", "celsius", precision)
+			want := roundTo(c, precision)
+			if tolerance := math.Pow10(-precision) + 1e-9; math.Abs(back-want) > tolerance {
+				t.Errorf("precision %d: %g C -> %g F -> %g C, want within %g of %g", precision, c, f, back, tolerance, want)
+			}
+		}
+	}
+}
+
+func TestRoundTo(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision int
+		want      float64
+	}{
+		{2.345, 2, 2.35},
+		{-2.5, 0, -3},
+		{1234.5678, 1, 1234.6},
+		{1e308, 10, 1e308},
+		{-1e308, 2, -1e308},
+	}
+	for _, tt := range tests {
+		if got := roundTo(tt.value, tt.precision); got != tt.want {
+			t.Errorf("roundTo(%g, %d) = %g, want %g", tt.value, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeTemperatureUnit(t *testing.T) {
+	tests := map[string]string{
+		"C":               "celsius",
+		"c":               "celsius",
+		"°C":              "celsius",
+		"℃":               "celsius",
+		"ºc":              "celsius",
+		"Celsius":         "celsius",
+		"degrees Celsius": "celsius",
+		"deg C":           "celsius",
+		"celcius":         "celsius",
+		"centigrade":      "celsius",
+		"F":               "fahrenheit",
+		"°F":              "fahrenheit",
+		"℉":               "fahrenheit",
+		"Degrees F.":      "fahrenheit",
+		"farenheit":       "fahrenheit",
+		"K":               "kelvin",
+		"kelvins":         "kelvin",
+		" Kelvin ":        "kelvin",
+	}
+	for input, want := range tests {
+		if got, ok := normalizeTemperatureUnit(input); !ok || got != want {
+			t.Errorf("normalizeTemperatureUnit(%q) = %q, %t; want %q", input, got, ok, want)
+		}
+	}
+	for _, input := range []string{"", "rankine", "degrees", "cc"} {
+		if got, ok := normalizeTemperatureUnit(input); ok {
+			t.Errorf("normalizeTemperatureUnit(%q) = %q, want no match", input, got)
+		}
+	}
+}
+
+func TestTemperatureConvertAliases(t *testing.T) {
+	runToolCases(t, handleTemperatureConvert, []toolCase[TemperatureConvertArgs]{
+		{name: "symbols", args: TemperatureConvertArgs{Value: 100, FromUnit: "°C", ToUnit: "℉"}, text: "212.00", out: `{"result":212}`},
+		{name: "words", args: TemperatureConvertArgs{Value: 0, FromUnit: "degrees Celsius", ToUnit: "K"}, text: "273.15", out: `{"result":273.15}`},
+		{name: "misspelling", args: TemperatureConvertArgs{Value: 32, FromUnit: "farenheit", ToUnit: "celcius"}, text: "0.00"},
+		{name: "unknown", args: TemperatureConvertArgs{Value: 1, FromUnit: "rankine", ToUnit: "C"}, err: true, text: "unknown unit: rankine"},
+	})
+}
+
+func TestFormatCurrencyCodes(t *testing.T) {
+	runToolCases(t, handleFormatCurrency, []toolCase[FormatCurrencyArgs]{
+		{name: "lowercase code", args: FormatCurrencyArgs{Amount: 12.5, Currency: "eur"}, text: "€12.50", out: `{"formatted":"€12.50","currency":"EUR"}`},
+		{name: "padded mixed case", args: FormatCurrencyArgs{Amount: 3, Currency: " gBp "}, text: "£3.00", out: `{"currency":"GBP"}`},
+		{name: "unique symbol", args: FormatCurrencyArgs{Amount: 9.99, Currency: "€"}, text: "€9.99", out: `{"currency":"EUR"}`},
+		{
+			name: "shared symbol",
+			args: FormatCurrencyArgs{Amount: 5, Currency: "$"},
+			text: "$5.00",
+			out:  `{"currency":"USD","assumption":"Symbol \"$\" is also used by CAD, AUD, NZD, MXN; assumed USD"}`,
+		},
+		{name: "yen symbol", args: FormatCurrencyArgs{Amount: 1200, Currency: "¥"}, text: "¥1200", out: `{"currency":"JPY","assumption":"Symbol \"¥\" is also used by CNY; assumed JPY"}`},
+		{name: "unsupported", args: FormatCurrencyArgs{Amount: 1, Currency: "chf"}, err: true, text: "Unsupported currency: chf"},
+	})
+}
+
+func TestFormatCurrencyNoAssumptionForCodes(t *testing.T) {
+	_, out := callTool(t, handleFormatCurrency, FormatCurrencyArgs{Amount: 1, Currency: "usd"})
+	if _, ok := out.(map[string]any)["assumption"]; ok {
+		t.Errorf("currency code reported an assumption: %s", compactJSON(out))
+	}
+}
+
+func TestSlugifyNoLeadingDigit(t *testing.T) {
+	runToolCases(t, handleSlugify, []toolCase[SlugifyArgs]{
+		{
+			name: "prefix",
+			args: SlugifyArgs{Text: "123 test", NoLeadingDigit: "prefix"},
+			text: "n-123-test",
+			out:  `{"slug":"n-123-test","transformed":true}`,
+		},
+		{
+			name: "strip",
+			args: SlugifyArgs{Text: "123 test", NoLeadingDigit: "strip"},
+			text: "test",
+			out:  `{"slug":"test","transformed":true}`,
+		},
+		{
+			name: "custom prefix",
+			args: SlugifyArgs{Text: "123 test", NoLeadingDigit: "prefix", LeadingDigitPrefix: ptr("item-")},
+			text: "item-123-test",
+			out:  `{"slug":"item-123-test","transformed":true}`,
+		},
+		{
+			name: "no leading digit",
+			args: SlugifyArgs{Text: "test 123", NoLeadingDigit: "strip"},
+			text: "test-123",
+			out:  `{"slug":"test-123","transformed":false}`,
+		},
+		{
+			name: "option omitted",
+			args: SlugifyArgs{Text: "123 test"},
+			text: "123-test",
+			out:  `{"slug":"123-test"}`,
+		},
+		{name: "unknown behavior", args: SlugifyArgs{Text: "123 test", NoLeadingDigit: "drop"}, err: true, text: "unsupported no_leading_digit behavior: drop"},
+		{name: "digit prefix", args: SlugifyArgs{Text: "123 test", NoLeadingDigit: "prefix", LeadingDigitPrefix: ptr("9-")}, err: true, text: `leading_digit_prefix must not start with a digit: "9-"`},
+		{name: "empty prefix", args: SlugifyArgs{Text: "123 test", NoLeadingDigit: "prefix", LeadingDigitPrefix: ptr("--")}, err: true, text: `leading_digit_prefix must contain a letter: "--"`},
+	})
+}
+
+func TestWordCountTexts(t *testing.T) {
+	runToolCases(t, handleWordCount, []toolCase[WordCountArgs]{
+		{
+			name: "mixed documents",
+			args: WordCountArgs{Texts: []string{"one two", "", "  ", "a\nb c\n"}},
+			text: "Document 1: 2 words, 7 characters, 1 lines\nDocument 2: 0 words, 0 characters, 0 lines\nDocument 3: 0 words, 2 characters, 1 lines\nDocument 4: 3 words, 6 characters, 3 lines\nTotal: 5 words, 15 characters, 5 lines",
+			out: `{
+				"words": 5, "characters": 15, "characters_no_whitespace": 9, "lines": 5,
+				"documents": [
+					{"words":2,"characters":7,"characters_no_whitespace":6,"lines":1},
+					{"words":0,"characters":0,"characters_no_whitespace":0,"lines":0},
+					{"words":0,"characters":2,"characters_no_whitespace":0,"lines":1},
+					{"words":3,"characters":6,"characters_no_whitespace":3,"lines":3}
+				],
+				"totals": {"words":5,"characters":15,"characters_no_whitespace":9,"lines":5}
+			}`,
+		},
+		{
+			name: "only empty documents",
+			args: WordCountArgs{Texts: []string{"", ""}},
+			text: "Document 1: 0 words, 0 characters, 0 lines\nDocument 2: 0 words, 0 characters, 0 lines\nTotal: 0 words, 0 characters, 0 lines",
+			out:  `{"documents":[{"words":0,"characters":0,"characters_no_whitespace":0,"lines":0},{"words":0,"characters":0,"characters_no_whitespace":0,"lines":0}],"totals":{"words":0,"characters":0,"characters_no_whitespace":0,"lines":0}}`,
+		},
+		{
+			name: "normalized documents",
+			args: WordCountArgs{Texts: []string{" a  b ", ""}, NormalizeWhitespace: true},
+			out:  `{"documents":[{"words":2,"characters":3,"characters_no_whitespace":2,"lines":1},{"words":0,"characters":0,"characters_no_whitespace":0,"lines":0}]}`,
+		},
+		{
+			name: "single text",
+			args: WordCountArgs{Text: "one two"},
+			text: "Words: 2\nCharacters: 7\nCharacters (no whitespace): 6\nLines: 1",
+			out:  `{"words":2,"characters":7,"characters_no_whitespace":6,"lines":1}`,
+		},
+		{name: "text and texts", args: WordCountArgs{Text: "a", Texts: []string{"b"}}, err: true, text: "Please provide either 'text' or 'texts', not both"},
+	})
+}
+
+func TestSlugifyPreviousText(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+
+	runToolCases(t, handleSlugify, []toolCase[SlugifyArgs]{
+		{
+			name: "cosmetic change",
+			args: SlugifyArgs{Text: "Hello,  World!", PreviousText: ptr("hello world")},
+			text: "hello-world\nUnchanged",
+			out:  `{"slug":"hello-world","previous_slug":"hello-world","changed":false}`,
+		},
+		{
+			name: "real change",
+			args: SlugifyArgs{Text: "Hello Go", PreviousText: ptr("Hello World")},
+			text: "hello-go\nChanged from: hello-world",
+			out:  `{"slug":"hello-go","previous_slug":"hello-world","changed":true}`,
+		},
+		{
+			name: "same options for both",
+			args: SlugifyArgs{Text: "123 Go", PreviousText: ptr("123 Go!"), NoLeadingDigit: "strip"},
+			text: "go\nUnchanged",
+			out:  `{"slug":"go","previous_slug":"go","changed":false,"transformed":true}`,
+		},
+	})
+
+	_, out := callTool(t, handleSlugify, SlugifyArgs{Text: "Hello World"})
+	if _, ok := out.(map[string]any)["changed"]; ok {
+		t.Errorf("changed reported without previous_text: %s", compactJSON(out))
+	}
+	if got, want := generatedSlugs.List(), []string{"hello-world", "hello-go", "go"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("generated slugs = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type NationalIDArgs struct {
+	Value   string `json:"value" jsonschema:"The identifier; spaces, hyphens, and dots are ignored. It is never logged or echoed back unmasked"`
+	Country string `json:"country" jsonschema:"ISO 3166 country code: US (SSN/ITIN), GB (National Insurance number), CA (SIN), ES (DNI/NIE), or NL (BSN)"`
+}
+
+// nationalIDFormat describes one country's identifier. Shape is checked
+// first and a mismatch is an error; check then applies the finer rules
+// (reserved ranges, check digits) and returns the detected format name, or
+// a reason the number is invalid.
+type nationalIDFormat struct {
+	Name   string
+	Shape  *regexp.Regexp
+	Expect string // description of Shape for error messages
+	Keep   int    // trailing characters left visible when masked
+	Check  func(id string) (format string, reason string)
+	Format func(id string) string
+}
+
+var nationalIDFormats = map[string]nationalIDFormat{
+	"US": {
+		Name:   "SSN",
+		Shape:  regexp.MustCompile(`^\d{9}$`),
+		Expect: "9 digits",
+		Keep:   4,
+		Check:  checkUSSSN,
+		Format: func(id string) string { return id[:3] + "-" + id[3:5] + "-" + id[5:] },
+	},
+	"GB": {
+		Name:   "National Insurance number",
+		Shape:  regexp.MustCompile(`^[A-Z]{2}\d{6}[A-D]$`),
+		Expect: "two letters, six digits, and a suffix letter A-D",
+		Keep:   3,
+		Check:  checkGBNINO,
+		Format: func(id string) string { return id[:2] + " " + id[2:4] + " " + id[4:6] + " " + id[6:8] + " " + id[8:] },
+	},
+	"CA": {
+		Name:   "SIN",
+		Shape:  regexp.MustCompile(`^\d{9}$`),
+		Expect: "9 digits",
+		Keep:   3,
+		Check:  checkCASIN,
+		Format: func(id string) string { return id[:3] + "-" + id[3:6] + "-" + id[6:] },
+	},
+	"ES": {
+		Name:   "DNI",
+		Shape:  regexp.MustCompile(`^([XYZ]\d{7}|\d{8})[A-Z]$`),
+		Expect: "8 digits and a letter (DNI), or X, Y, or Z, 7 digits, and a letter (NIE)",
+		Keep:   4,
+		Check:  checkESDNI,
+		Format: func(id string) string { return id },
+	},
+	"NL": {
+		Name:   "BSN",
+		Shape:  regexp.MustCompile(`^\d{9}$`),
+		Expect: "9 digits",
+		Keep:   3,
+		Check:  checkNLBSN,
+		Format: func(id string) string { return id },
+	},
+}
+
+func checkUSSSN(id string) (string, string) {
+	area, group, serial := id[:3], id[3:5], id[5:]
+	if area[0] == '9' {
+		// Area 9xx is never an SSN, but ITINs use it with these groups.
+		if g, _ := strconv.Atoi(group); (g >= 50 && g <= 65) || (g >= 70 && g <= 88) || (g >= 90 && g <= 92) || g >= 94 {
+			return "ITIN", ""
+		}
+		return "SSN", "area numbers 900-999 are not assigned to SSNs"
+	}
+	switch {
+	case area == "000" || area == "666":
+		return "SSN", fmt.Sprintf("area number %s is never assigned", area)
+	case group == "00":
+		return "SSN", "group number 00 is never assigned"
+	case serial == "0000":
+		return "SSN", "serial number 0000 is never assigned"
+	}
+	return "SSN", ""
+}
+
+func checkGBNINO(id string) (string, string) {
+	prefix := id[:2]
+	switch {
+	case strings.ContainsRune("DFIQUV", rune(prefix[0])):
+		return "NINO", fmt.Sprintf("first letter %c is not used", prefix[0])
+	case strings.ContainsRune("DFIOQUV", rune(prefix[1])):
+		return "NINO", fmt.Sprintf("second letter %c is not used", prefix[1])
+	}
+	switch prefix {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return "NINO", fmt.Sprintf("prefix %s is not allocated", prefix)
+	}
+	return "NINO", ""
+}
+
+// luhnValid reports whether a string of digits passes the Luhn checksum.
+func luhnValid(digits string) bool {
+	sum := 0
+	for i := range len(digits) {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func checkCASIN(id string) (string, string) {
+	switch id[0] {
+	case '0', '8':
+		return "SIN", fmt.Sprintf("SINs never start with %c", id[0])
+	}
+	if !luhnValid(id) {
+		return "SIN", "Luhn check digit does not match"
+	}
+	if id[0] == '9' {
+		return "SIN (temporary resident)", ""
+	}
+	return "SIN", ""
+}
+
+func checkESDNI(id string) (string, string) {
+	format, digits := "DNI", id[:8]
+	if i := strings.IndexByte("XYZ", id[0]); i >= 0 {
+		format, digits = "NIE", strconv.Itoa(i)+id[1:8]
+	}
+	n, _ := strconv.Atoi(digits)
+	if want := "TRWAGMYFPDXBNJZSQVHLCKE"[n%23]; id[8] != want {
+		return format, fmt.Sprintf("control letter should be %c", want)
+	}
+	return format, ""
+}
+
+func checkNLBSN(id string) (string, string) {
+	// The "elfproef": weights 9 down to 2 on the first eight digits and -1
+	// on the last must sum to a multiple of 11.
+	sum := 0
+	for i := range 8 {
+		sum += int(id[i]-'0') * (9 - i)
+	}
+	sum -= int(id[8] - '0')
+	if sum%11 != 0 {
+		return "BSN", "11-proof check digit does not match"
+	}
+	return "BSN", ""
+}
+
+// maskIdentifier replaces every letter and digit of formatted with '*'
+// except the last keep, leaving separators in place.
+func maskIdentifier(formatted string, keep int) string {
+	b := []byte(formatted)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] == ' ' || b[i] == '-' {
+			continue
+		}
+		if keep > 0 {
+			keep--
+			continue
+		}
+		b[i] = '*'
+	}
+	return string(b)
+}
+
+func handleNationalID(ctx context.Context, req *mcp.CallToolRequest, args NationalIDArgs) (*mcp.CallToolResult, any, error) {
+	// The identifier itself is sensitive, so only its length is logged.
+	logMsg("[TOOL]", fmt.Sprintf("national_id called: country=%q value length=%d", args.Country, len(args.Value)))
+
+	country := strings.ToUpper(strings.TrimSpace(args.Country))
+	format, ok := nationalIDFormats[country]
+	if !ok {
+		supported := make([]string, 0, len(nationalIDFormats))
+		for code := range nationalIDFormats {
+			supported = append(supported, code)
+		}
+		sort.Strings(supported)
+		return errorResult(fmt.Sprintf("Unsupported country: %s (use %s)", args.Country, strings.Join(supported, ", "))), nil, nil
+	}
+
+	id := strings.ToUpper(strings.NewReplacer(" ", "", "-", "", ".", "").Replace(strings.TrimSpace(args.Value)))
+	if !format.Shape.MatchString(id) {
+		return errorResult(fmt.Sprintf("Malformed %s %s: expected %s", country, format.Name, format.Expect)), nil, nil
+	}
+
+	detected, reason := format.Check(id)
+	masked := maskIdentifier(format.Format(id), format.Keep)
+	valid := reason == ""
+
+	text := fmt.Sprintf("Valid %s %s: %s", country, detected, masked)
+	result := map[string]any{
+		"country": country,
+		"format":  detected,
+		"valid":   valid,
+		"masked":  masked,
+	}
+	if !valid {
+		text = fmt.Sprintf("Invalid %s %s (%s): %s", country, detected, reason, masked)
+		result["reason"] = reason
+	}
+
+	return textResult(text), result, nil
+}
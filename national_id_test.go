@@ -0,0 +1,164 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNationalID(t *testing.T) {
+	runToolCases(t, handleNationalID, []toolCase[NationalIDArgs]{
+		{
+			name: "well-formed US SSN",
+			args: NationalIDArgs{Value: "123-45-6789", Country: "us"},
+			text: "Valid US SSN: ***-**-6789",
+			out:  `{"country":"US","format":"SSN","valid":true,"masked":"***-**-6789"}`,
+		},
+		{
+			name: "US SSN without separators",
+			args: NationalIDArgs{Value: " 123456789 ", Country: "US"},
+			text: "Valid US SSN: ***-**-6789",
+		},
+		{
+			name: "US SSN never-assigned area",
+			args: NationalIDArgs{Value: "000-12-3456", Country: "US"},
+			text: "Invalid US SSN (area number 000 is never assigned): ***-**-3456",
+			out:  `{"format":"SSN","valid":false,"reason":"area number 000 is never assigned","masked":"***-**-3456"}`,
+		},
+		{
+			name: "US SSN group 00",
+			args: NationalIDArgs{Value: "123-00-4567", Country: "US"},
+			out:  `{"valid":false,"reason":"group number 00 is never assigned"}`,
+		},
+		{
+			name: "US ITIN",
+			args: NationalIDArgs{Value: "912-70-1234", Country: "US"},
+			text: "Valid US ITIN: ***-**-1234",
+			out:  `{"format":"ITIN","valid":true}`,
+		},
+		{
+			name: "malformed US SSN",
+			args: NationalIDArgs{Value: "123-45-67890", Country: "US"},
+			err:  true,
+			text: "Malformed US SSN: expected 9 digits",
+		},
+		{
+			name: "US SSN with letters",
+			args: NationalIDArgs{Value: "ABC-DE-FGHI", Country: "US"},
+			err:  true,
+			text: "Malformed US SSN: expected 9 digits",
+		},
+		{
+			name: "GB National Insurance number",
+			args: NationalIDArgs{Value: "ab 12 34 56 c", Country: "GB"},
+			text: "Valid GB NINO: ** ** ** 56 C",
+			out:  `{"country":"GB","format":"NINO","valid":true,"masked":"** ** ** 56 C"}`,
+		},
+		{
+			name: "GB unused prefix letter",
+			args: NationalIDArgs{Value: "QQ123456C", Country: "GB"},
+			out:  `{"valid":false,"reason":"first letter Q is not used"}`,
+		},
+		{
+			name: "CA SIN",
+			args: NationalIDArgs{Value: "130 692 544", Country: "CA"},
+			text: "Valid CA SIN: ***-***-544",
+			out:  `{"format":"SIN","valid":true,"masked":"***-***-544"}`,
+		},
+		{
+			name: "CA temporary resident SIN",
+			args: NationalIDArgs{Value: "925-558-504", Country: "CA"},
+			out:  `{"format":"SIN (temporary resident)","valid":true}`,
+		},
+		{
+			name: "CA SIN failing Luhn",
+			args: NationalIDArgs{Value: "130 692 545", Country: "CA"},
+			text: "Invalid CA SIN (Luhn check digit does not match): ***-***-545",
+			out:  `{"valid":false,"reason":"Luhn check digit does not match"}`,
+		},
+		{
+			name: "ES DNI",
+			args: NationalIDArgs{Value: "12345678Z", Country: "ES"},
+			text: "Valid ES DNI: *****678Z",
+			out:  `{"format":"DNI","valid":true,"masked":"*****678Z"}`,
+		},
+		{
+			name: "ES NIE",
+			args: NationalIDArgs{Value: "X-1234567-L", Country: "ES"},
+			out:  `{"format":"NIE","valid":true,"masked":"*****567L"}`,
+		},
+		{
+			name: "ES wrong control letter",
+			args: NationalIDArgs{Value: "12345678A", Country: "ES"},
+			out:  `{"valid":false,"reason":"control letter should be Z"}`,
+		},
+		{
+			name: "NL BSN",
+			args: NationalIDArgs{Value: "111.222.333", Country: "NL"},
+			text: "Valid NL BSN: ******333",
+			out:  `{"format":"BSN","valid":true,"masked":"******333"}`,
+		},
+		{
+			name: "NL BSN failing 11-proof",
+			args: NationalIDArgs{Value: "111222334", Country: "NL"},
+			out:  `{"valid":false,"reason":"11-proof check digit does not match"}`,
+		},
+		{
+			name: "unsupported country",
+			args: NationalIDArgs{Value: "1234567890123", Country: "FR"},
+			err:  true,
+			text: "Unsupported country: FR (use CA, ES, GB, NL, US)",
+		},
+	})
+}
+
+func TestNationalIDNeverEchoesValue(t *testing.T) {
+	for _, args := range []NationalIDArgs{
+		{Value: "123-45-6789", Country: "US"},
+		{Value: "000-12-3456", Country: "US"},
+		{Value: "130692544", Country: "CA"},
+		{Value: "111222333", Country: "NL"},
+	} {
+		result, out := callTool(t, handleNationalID, args)
+		digits := strings.ReplaceAll(args.Value, "-", "")
+		if strings.Contains(resultText(result), digits) || strings.Contains(resultText(result), args.Value) {
+			t.Errorf("%s: text %q contains the raw value", args.Country, resultText(result))
+		}
+		if s := compactJSON(out); strings.Contains(s, digits) || strings.Contains(s, args.Value) {
+			t.Errorf("%s: output %s contains the raw value", args.Country, s)
+		}
+	}
+}
+
+func TestMaskIdentifier(t *testing.T) {
+	tests := []struct {
+		formatted string
+		keep      int
+		want      string
+	}{
+		{"123-45-6789", 4, "***-**-6789"},
+		{"AB 12 34 56 C", 3, "** ** ** 56 C"},
+		{"12345678Z", 4, "*****678Z"},
+		{"12", 4, "12"},
+		{"123", 0, "***"},
+	}
+	for _, tt := range tests {
+		if got := maskIdentifier(tt.formatted, tt.keep); got != tt.want {
+			t.Errorf("maskIdentifier(%q, %d) = %q, want %q", tt.formatted, tt.keep, got, tt.want)
+		}
+	}
+}
+
+func TestLuhnValid(t *testing.T) {
+	for digits, want := range map[string]bool{
+		"130692544":        true,
+		"130692545":        false,
+		"4111111111111111": true,
+		"0":                true,
+		"79927398713":      true,
+		"79927398710":      false,
+	} {
+		if got := luhnValid(digits); got != want {
+			t.Errorf("luhnValid(%q) = %v, want %v", digits, got, want)
+		}
+	}
+}
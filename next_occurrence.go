@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type NextOccurrenceArgs struct {
+	Frequency  string `json:"frequency" jsonschema:"How often the event recurs: daily, weekly, or monthly"`
+	Interval   int    `json:"interval,omitempty" jsonschema:"Number of days, weeks, or months between occurrences, 1-10000 (default 1)"`
+	Weekday    string `json:"weekday,omitempty" jsonschema:"Day of the week for weekly rules (e.g. monday). Defaults to the start date's weekday"`
+	DayOfMonth int    `json:"day_of_month,omitempty" jsonschema:"Day of the month for monthly rules (1-31). Defaults to the start date's day; months without that day are skipped"`
+	Start      string `json:"start" jsonschema:"First possible occurrence (RFC3339 or YYYY-MM-DD); its time of day is kept for every occurrence"`
+	Now        string `json:"now,omitempty" jsonschema:"Reference time (RFC3339 or YYYY-MM-DD); only occurrences after it are returned. Defaults to the current time"`
+	Count      int    `json:"count,omitempty" jsonschema:"Number of occurrences to return (1-100, default 1)"`
+}
+
+// maxRecurrenceSteps bounds the search for occurrences, which matters for
+// rules such as "the 30th of every 12th month starting in February" that never
+// produce a date.
+const maxRecurrenceSteps = 10000
+
+// recurrence is a validated daily, weekly, or monthly rule anchored at its
+// first occurrence.
+type recurrence struct {
+	frequency  string
+	interval   int
+	dayOfMonth int
+	start      time.Time
+	anchor     time.Time
+}
+
+// parseTimestamp accepts an RFC3339 timestamp or a plain YYYY-MM-DD date,
+// which is taken as midnight UTC.
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q (expected RFC3339 or YYYY-MM-DD)", s)
+}
+
+// parseWeekday maps a weekday name or its three-letter abbreviation to a
+// time.Weekday.
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// occurrence returns the k-th candidate of the rule. Monthly candidates that
+// fall on a day the month does not have, or before the start, are reported as
+// not ok.
+func (r recurrence) occurrence(k int) (time.Time, bool) {
+	switch r.frequency {
+	case "daily":
+		return r.anchor.AddDate(0, 0, k*r.interval), true
+	case "weekly":
+		return r.anchor.AddDate(0, 0, 7*k*r.interval), true
+	default:
+		a := r.anchor
+		t := time.Date(a.Year(), a.Month()+time.Month(k*r.interval), r.dayOfMonth,
+			a.Hour(), a.Minute(), a.Second(), a.Nanosecond(), a.Location())
+		return t, t.Day() == r.dayOfMonth && !t.Before(r.start)
+	}
+}
+
+// firstStepAfter estimates the index of a candidate shortly before now, so
+// that rules starting long ago are not walked from the beginning.
+func (r recurrence) firstStepAfter(now time.Time) int {
+	if !now.After(r.anchor) {
+		return 0
+	}
+	var k int
+	switch r.frequency {
+	case "daily":
+		k = daysBetween(r.anchor, now) / r.interval
+	case "weekly":
+		k = daysBetween(r.anchor, now) / 7 / r.interval
+	default:
+		months := (now.Year()-r.anchor.Year())*12 + int(now.Month()-r.anchor.Month())
+		k = months / r.interval
+	}
+	return max(k-1, 0)
+}
+
+// daysBetween counts the calendar days from a to b, reading both in a's
+// location. Unlike time.Duration, it does not saturate for spans of several
+// centuries.
+func daysBetween(a, b time.Time) int {
+	b = b.In(a.Location())
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int((to.Unix() - from.Unix()) / (24 * 60 * 60))
+}
+
+const maxRecurrenceInterval = 10000
+
+func handleNextOccurrence(ctx context.Context, req *mcp.CallToolRequest, args NextOccurrenceArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("next_occurrence called: %s every %d from %s", args.Frequency, args.Interval, args.Start))
+
+	r := recurrence{frequency: strings.ToLower(args.Frequency), interval: args.Interval}
+	if r.interval == 0 {
+		r.interval = 1
+	}
+	// The cap keeps k*interval and the month arithmetic well inside int.
+	if r.interval < 1 || r.interval > maxRecurrenceInterval {
+		return errorResult(fmt.Sprintf("Interval must be between 1 and %d", maxRecurrenceInterval)), nil, nil
+	}
+
+	count := args.Count
+	if count == 0 {
+		count = 1
+	}
+	if count < 1 || count > 100 {
+		return errorResult("Count must be between 1 and 100"), nil, nil
+	}
+
+	start, err := parseTimestamp(args.Start)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid start: %v", err)), nil, nil
+	}
+	r.start = start
+	r.anchor = start
+
+	now := time.Now()
+	if args.Now != "" {
+		if now, err = parseTimestamp(args.Now); err != nil {
+			return errorResult(fmt.Sprintf("Invalid now: %v", err)), nil, nil
+		}
+	}
+
+	switch r.frequency {
+	case "daily":
+		if args.Weekday != "" || args.DayOfMonth != 0 {
+			return errorResult("Daily rules do not accept 'weekday' or 'day_of_month'"), nil, nil
+		}
+	case "weekly":
+		if args.DayOfMonth != 0 {
+			return errorResult("Weekly rules do not accept 'day_of_month'"), nil, nil
+		}
+		if args.Weekday != "" {
+			day, ok := parseWeekday(args.Weekday)
+			if !ok {
+				return errorResult(fmt.Sprintf("Unknown weekday: %s", args.Weekday)), nil, nil
+			}
+			r.anchor = start.AddDate(0, 0, (int(day)-int(start.Weekday())+7)%7)
+		}
+	case "monthly":
+		if args.Weekday != "" {
+			return errorResult("Monthly rules do not accept 'weekday'"), nil, nil
+		}
+		r.dayOfMonth = start.Day()
+		if args.DayOfMonth != 0 {
+			if args.DayOfMonth < 1 || args.DayOfMonth > 31 {
+				return errorResult("Day of month must be between 1 and 31"), nil, nil
+			}
+			r.dayOfMonth = args.DayOfMonth
+		}
+	default:
+		return errorResult(fmt.Sprintf("Unsupported frequency: %s (use daily, weekly, or monthly)", args.Frequency)), nil, nil
+	}
+
+	var occurrences []string
+	for k, steps := r.firstStepAfter(now), 0; len(occurrences) < count && steps < maxRecurrenceSteps; k, steps = k+1, steps+1 {
+		t, ok := r.occurrence(k)
+		if ok && t.After(now) {
+			occurrences = append(occurrences, t.Format(time.RFC3339))
+		}
+	}
+	if len(occurrences) == 0 {
+		return errorResult("The rule produces no occurrences after the reference time"), nil, nil
+	}
+
+	return textResult(strings.Join(occurrences, "\n")),
+		map[string]any{
+			"occurrences": occurrences,
+			"frequency":   r.frequency,
+			"interval":    r.interval,
+			"now":         now.Format(time.RFC3339),
+		}, nil
+}
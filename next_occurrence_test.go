@@ -0,0 +1,63 @@
+package main
+
+import "testing"
+
+func TestNextOccurrence(t *testing.T) {
+	runToolCases(t, handleNextOccurrence, []toolCase[NextOccurrenceArgs]{
+		{
+			name: "weekly every Monday",
+			args: NextOccurrenceArgs{Frequency: "weekly", Weekday: "monday", Start: "2026-10-01", Now: "2026-10-14", Count: 3},
+			text: "2026-10-19T00:00:00Z\n2026-10-26T00:00:00Z\n2026-11-02T00:00:00Z",
+			out:  `{"occurrences":["2026-10-19T00:00:00Z","2026-10-26T00:00:00Z","2026-11-02T00:00:00Z"],"frequency":"weekly","interval":1,"now":"2026-10-14T00:00:00Z"}`,
+		},
+		{
+			name: "monthly on the 15th",
+			args: NextOccurrenceArgs{Frequency: "Monthly", DayOfMonth: 15, Start: "2026-01-15T09:00:00Z", Now: "2026-10-14T12:00:00Z", Count: 3},
+			text: "2026-10-15T09:00:00Z\n2026-11-15T09:00:00Z\n2026-12-15T09:00:00Z",
+			out:  `{"frequency":"monthly","interval":1}`,
+		},
+		{
+			name: "monthly skips short months",
+			args: NextOccurrenceArgs{Frequency: "monthly", Start: "2026-01-31", Now: "2026-01-31", Count: 3},
+			text: "2026-03-31T00:00:00Z\n2026-05-31T00:00:00Z\n2026-07-31T00:00:00Z",
+		},
+		{
+			name: "daily interval",
+			args: NextOccurrenceArgs{Frequency: "daily", Interval: 3, Start: "2026-10-01", Now: "2026-10-05", Count: 2},
+			text: "2026-10-07T00:00:00Z\n2026-10-10T00:00:00Z",
+			out:  `{"interval":3}`,
+		},
+		{
+			name: "start after now",
+			args: NextOccurrenceArgs{Frequency: "weekly", Weekday: "fri", Start: "2026-12-01", Now: "2026-10-14"},
+			text: "2026-12-04T00:00:00Z",
+		},
+		{
+			name: "daily from centuries ago",
+			args: NextOccurrenceArgs{Frequency: "daily", Start: "1500-01-01", Now: "2026-10-14"},
+			text: "2026-10-15T00:00:00Z",
+		},
+		{
+			name: "fortnightly from centuries ago",
+			args: NextOccurrenceArgs{Frequency: "weekly", Interval: 2, Start: "1800-01-06", Now: "2026-10-14", Count: 2},
+			text: "2026-10-26T00:00:00Z\n2026-11-09T00:00:00Z",
+		},
+		{name: "no occurrences", args: NextOccurrenceArgs{Frequency: "monthly", Interval: 12, DayOfMonth: 30, Start: "2026-02-01", Now: "2026-01-01"}, err: true, text: "The rule produces no occurrences after the reference time"},
+		{name: "weekday on monthly", args: NextOccurrenceArgs{Frequency: "monthly", Weekday: "monday", Start: "2026-01-01"}, err: true, text: "Monthly rules do not accept 'weekday'"},
+		{name: "day on weekly", args: NextOccurrenceArgs{Frequency: "weekly", DayOfMonth: 15, Start: "2026-01-01"}, err: true, text: "Weekly rules do not accept 'day_of_month'"},
+		{name: "options on daily", args: NextOccurrenceArgs{Frequency: "daily", Weekday: "monday", Start: "2026-01-01"}, err: true, text: "Daily rules do not accept 'weekday' or 'day_of_month'"},
+		{name: "unknown weekday", args: NextOccurrenceArgs{Frequency: "weekly", Weekday: "funday", Start: "2026-01-01"}, err: true, text: "Unknown weekday: funday"},
+		{name: "day out of range", args: NextOccurrenceArgs{Frequency: "monthly", DayOfMonth: 32, Start: "2026-01-01"}, err: true, text: "Day of month must be between 1 and 31"},
+		{name: "yearly", args: NextOccurrenceArgs{Frequency: "yearly", Start: "2026-01-01"}, err: true, text: "Unsupported frequency: yearly (use daily, weekly, or monthly)"},
+		{name: "negative interval", args: NextOccurrenceArgs{Frequency: "daily", Interval: -1, Start: "2026-01-01"}, err: true, text: "Interval must be between 1 and 10000"},
+		{name: "interval too large", args: NextOccurrenceArgs{Frequency: "monthly", Interval: 10001, Start: "2026-01-01"}, err: true, text: "Interval must be between 1 and 10000"},
+		{name: "overflowing interval", args: NextOccurrenceArgs{Frequency: "weekly", Interval: 1 << 62, Start: "2026-01-01"}, err: true, text: "Interval must be between 1 and 10000"},
+		{
+			name: "largest interval",
+			args: NextOccurrenceArgs{Frequency: "daily", Interval: 10000, Start: "2026-01-01", Now: "2026-01-01", Count: 2},
+			out:  `{"occurrences":["2053-05-19T00:00:00Z","2080-10-04T00:00:00Z"]}`,
+		},
+		{name: "count too high", args: NextOccurrenceArgs{Frequency: "daily", Count: 101, Start: "2026-01-01"}, err: true, text: "Count must be between 1 and 100"},
+		{name: "bad start", args: NextOccurrenceArgs{Frequency: "daily", Start: "soon"}, err: true, text: `Invalid start: invalid date "soon" (expected RFC3339 or YYYY-MM-DD)`},
+	})
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type NormalizeWhitespaceArgs struct {
+	Text string `json:"text" jsonschema:"The text to normalize"`
+}
+
+// normalizeWhitespace collapses every run of whitespace (spaces, tabs, and
+// any style of line break) into a single space and trims both ends.
+func normalizeWhitespace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func handleNormalizeWhitespace(ctx context.Context, req *mcp.CallToolRequest, args NormalizeWhitespaceArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("normalize_whitespace called with text length: %d", len(args.Text)))
+
+	normalized := normalizeWhitespace(args.Text)
+
+	return textResult(normalized), map[string]any{
+		"text":    normalized,
+		"changed": normalized != args.Text,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestNormalizeWhitespace(t *testing.T) {
+	runToolCases(t, handleNormalizeWhitespace, []toolCase[NormalizeWhitespaceArgs]{
+		{name: "doubled spaces", args: NormalizeWhitespaceArgs{Text: "hello  world   again"}, text: "hello world again", out: `{"text":"hello world again","changed":true}`},
+		{name: "tabs", args: NormalizeWhitespaceArgs{Text: "a\tb\t\tc"}, text: "a b c", out: `{"changed":true}`},
+		{name: "mixed newlines", args: NormalizeWhitespaceArgs{Text: "one\r\ntwo\nthree\rfour"}, text: "one two three four"},
+		{name: "trimmed ends", args: NormalizeWhitespaceArgs{Text: " \n padded \t "}, text: "padded"},
+		{name: "already normal", args: NormalizeWhitespaceArgs{Text: "fine as is"}, text: "fine as is", out: `{"changed":false}`},
+		{name: "only whitespace", args: NormalizeWhitespaceArgs{Text: " \t\r\n "}, out: `{"text":"","changed":true}`},
+	})
+}
+
+func TestNormalizeWhitespaceFlags(t *testing.T) {
+	runToolCases(t, handleWordCount, []toolCase[WordCountArgs]{
+		{name: "word_count raw", args: WordCountArgs{Text: "a  b\t\tc\r\n"}, out: `{"words":3,"characters":9,"lines":2}`},
+		{name: "word_count normalized", args: WordCountArgs{Text: "a  b\t\tc\r\n", NormalizeWhitespace: true}, out: `{"words":3,"characters":5,"characters_no_whitespace":3,"lines":1}`},
+	})
+	runToolCases(t, handleSlugify, []toolCase[SlugifyArgs]{
+		{name: "slugify normalized", args: SlugifyArgs{Text: "  Hello \t  World \n", NormalizeWhitespace: true}, text: "hello-world"},
+	})
+}
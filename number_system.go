@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type NumberSystemArgs struct {
+	Number int64  `json:"number" jsonschema:"The integer to convert"`
+	To     string `json:"to,omitempty" jsonschema:"Representation to produce: roman, words, binary, hex, ordinal, or all (default all)"`
+}
+
+var numberSystems = []string{"roman", "words", "binary", "hex", "ordinal"}
+
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	tensWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleWords = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// hundredsToWords spells out 1-999.
+func hundredsToWords(n uint64) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100]+" hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		parts = append(parts, smallNumberWords[n])
+	case n%10 == 0:
+		parts = append(parts, tensWords[n/10])
+	default:
+		parts = append(parts, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+	return strings.Join(parts, " ")
+}
+
+// numberToWords spells out an integer in English using the short scale, e.g.
+// 1234 becomes "one thousand two hundred thirty-four".
+func numberToWords(n int64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+
+	magnitude := uint64(n)
+	prefix := ""
+	if n < 0 {
+		magnitude = -magnitude
+		prefix = "minus "
+	}
+
+	var groups []string
+	for scale := 0; magnitude > 0; scale++ {
+		if group := magnitude % 1000; group > 0 {
+			words := hundredsToWords(group)
+			if scaleWords[scale] != "" {
+				words += " " + scaleWords[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		magnitude /= 1000
+	}
+	return prefix + strings.Join(groups, " ")
+}
+
+// ordinalSuffix returns the English ordinal form of n, such as "1st", "12th",
+// or "23rd".
+func ordinalSuffix(n int64) string {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	suffix := "th"
+	if abs%100 < 11 || abs%100 > 13 {
+		switch abs % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.FormatInt(n, 10) + suffix
+}
+
+// numberRepresentation converts n into the named system. Roman numerals only
+// cover 1-3999, so other values report an error for that system.
+func numberRepresentation(n int64, system string) (string, error) {
+	switch system {
+	case "roman":
+		if n < 1 || n > 3999 {
+			return "", fmt.Errorf("Roman numerals only cover 1-3999")
+		}
+		return intToRoman(int(n)), nil
+	case "words":
+		return numberToWords(n), nil
+	case "binary":
+		return strconv.FormatInt(n, 2), nil
+	case "hex":
+		return strconv.FormatInt(n, 16), nil
+	case "ordinal":
+		return ordinalSuffix(n), nil
+	}
+	return "", fmt.Errorf("unsupported representation: %s", system)
+}
+
+func handleNumberSystem(ctx context.Context, req *mcp.CallToolRequest, args NumberSystemArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("number_system called: %d to %q", args.Number, args.To))
+
+	to := strings.ToLower(args.To)
+	if to == "" {
+		to = "all"
+	}
+
+	if to != "all" {
+		value, err := numberRepresentation(args.Number, to)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Cannot convert %d: %v", args.Number, err)), nil, nil
+		}
+		return textResult(value), map[string]any{"number": args.Number, to: value}, nil
+	}
+
+	structured := map[string]any{"number": args.Number}
+	var lines []string
+	for _, system := range numberSystems {
+		value, err := numberRepresentation(args.Number, system)
+		if err != nil {
+			structured[system] = nil
+			lines = append(lines, fmt.Sprintf("%s: (%v)", system, err))
+			continue
+		}
+		structured[system] = value
+		lines = append(lines, fmt.Sprintf("%s: %s", system, value))
+	}
+
+	return textResult(strings.Join(lines, "\n")), structured, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberSystem(t *testing.T) {
+	runToolCases(t, handleNumberSystem, []toolCase[NumberSystemArgs]{
+		{name: "roman", args: NumberSystemArgs{Number: 1994, To: "roman"}, text: "MCMXCIV", out: `{"number":1994,"roman":"MCMXCIV"}`},
+		{name: "words", args: NumberSystemArgs{Number: 1994, To: "words"}, text: "one thousand nine hundred ninety-four", out: `{"words":"one thousand nine hundred ninety-four"}`},
+		{name: "binary", args: NumberSystemArgs{Number: 1994, To: "binary"}, text: "11111001010", out: `{"binary":"11111001010"}`},
+		{name: "hex", args: NumberSystemArgs{Number: 1994, To: "HEX"}, text: "7ca", out: `{"hex":"7ca"}`},
+		{name: "ordinal", args: NumberSystemArgs{Number: 1994, To: "ordinal"}, text: "1994th", out: `{"ordinal":"1994th"}`},
+		{
+			name: "all",
+			args: NumberSystemArgs{Number: 1994},
+			text: "roman: MCMXCIV\nwords: one thousand nine hundred ninety-four\nbinary: 11111001010\nhex: 7ca\nordinal: 1994th",
+			out:  `{"number":1994,"roman":"MCMXCIV","words":"one thousand nine hundred ninety-four","binary":"11111001010","hex":"7ca","ordinal":"1994th"}`,
+		},
+		{
+			name: "all outside roman range",
+			args: NumberSystemArgs{Number: -12, To: "all"},
+			text: "roman: (Roman numerals only cover 1-3999)\nwords: minus twelve\nbinary: -1100\nhex: -c\nordinal: -12th",
+			out:  `{"number":-12,"roman":null,"words":"minus twelve"}`,
+		},
+		{name: "zero", args: NumberSystemArgs{Number: 0, To: "words"}, text: "zero"},
+		{name: "teens", args: NumberSystemArgs{Number: 113, To: "ordinal"}, text: "113th"},
+		{name: "twenty-first", args: NumberSystemArgs{Number: 21, To: "ordinal"}, text: "21st"},
+		{name: "hundred and second", args: NumberSystemArgs{Number: 102, To: "ordinal"}, text: "102nd"},
+		{name: "negative third", args: NumberSystemArgs{Number: -3, To: "ordinal"}, text: "-3rd"},
+		{name: "round scales", args: NumberSystemArgs{Number: 2_000_030_000, To: "words"}, text: "two billion thirty thousand"},
+		{
+			name: "min int64",
+			args: NumberSystemArgs{Number: math.MinInt64, To: "words"},
+			text: "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight",
+		},
+		{name: "roman out of range", args: NumberSystemArgs{Number: 4000, To: "roman"}, err: true, text: "Cannot convert 4000: Roman numerals only cover 1-3999"},
+		{name: "unknown", args: NumberSystemArgs{Number: 5, To: "octal"}, err: true, text: "Cannot convert 5: unsupported representation: octal"},
+	})
+}
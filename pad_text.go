@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type PadTextArgs struct {
+	Text     string  `json:"text" jsonschema:"The text to pad"`
+	Width    int     `json:"width" jsonschema:"Target display width in terminal columns (1-1000)"`
+	Align    string  `json:"align,omitempty" jsonschema:"Alignment: left, right, or center (default left)"`
+	Fill     string  `json:"fill,omitempty" jsonschema:"Single one-column fill character (default space)"`
+	Truncate bool    `json:"truncate,omitempty" jsonschema:"Shorten text wider than width, ending it with the ellipsis"`
+	Ellipsis *string `json:"ellipsis,omitempty" jsonschema:"Marker appended to truncated text (default …; may be empty)"`
+}
+
+// truncateToWidth returns the longest prefix of s that fits in width columns,
+// never splitting a wide character or a zero-width-joiner sequence, along with
+// the prefix's width.
+func truncateToWidth(s string, width int) (string, int) {
+	used := 0
+	joined := false
+	for i, r := range s {
+		if joined || r == zeroWidthJoiner {
+			joined = r == zeroWidthJoiner
+			continue
+		}
+		w := runeWidth(r)
+		if used+w > width {
+			return s[:i], used
+		}
+		used += w
+	}
+	return s, used
+}
+
+func handlePadText(ctx context.Context, req *mcp.CallToolRequest, args PadTextArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("pad_text called: width=%d align=%q", args.Width, args.Align))
+
+	if args.Width < 1 || args.Width > 1000 {
+		return errorResult("Width must be between 1 and 1000"), nil, nil
+	}
+
+	align := args.Align
+	if align == "" {
+		align = "left"
+	}
+	if align != "left" && align != "right" && align != "center" {
+		return errorResult(fmt.Sprintf("Unsupported alignment: %s (use left, right, or center)", args.Align)), nil, nil
+	}
+
+	fill := args.Fill
+	if fill == "" {
+		fill = " "
+	}
+	if len([]rune(fill)) != 1 || runeWidth([]rune(fill)[0]) != 1 {
+		return errorResult("Fill must be a single one-column character"), nil, nil
+	}
+
+	ellipsis := "…"
+	if args.Ellipsis != nil {
+		ellipsis = *args.Ellipsis
+	}
+
+	text := args.Text
+	width := displayWidth(text)
+	truncated := false
+	if width > args.Width {
+		if !args.Truncate {
+			return textResult(text), map[string]any{
+				"text":      text,
+				"width":     width,
+				"truncated": false,
+				"overflow":  width - args.Width,
+			}, nil
+		}
+		ellipsisWidth := displayWidth(ellipsis)
+		if ellipsisWidth > args.Width {
+			return errorResult("Ellipsis is wider than the target width"), nil, nil
+		}
+		text, width = truncateToWidth(text, args.Width-ellipsisWidth)
+		text += ellipsis
+		width += ellipsisWidth
+		truncated = true
+	}
+
+	padding := args.Width - width
+	var left, right int
+	switch align {
+	case "left":
+		right = padding
+	case "right":
+		left = padding
+	case "center":
+		left = padding / 2
+		right = padding - left
+	}
+	padded := strings.Repeat(fill, left) + text + strings.Repeat(fill, right)
+
+	return textResult(padded), map[string]any{
+		"text":      padded,
+		"width":     args.Width,
+		"truncated": truncated,
+	}, nil
+}
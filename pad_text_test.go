@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestPadText(t *testing.T) {
+	runToolCases(t, handlePadText, []toolCase[PadTextArgs]{
+		{name: "left", args: PadTextArgs{Text: "abc", Width: 6}, text: "abc   ", out: `{"text":"abc   ","width":6,"truncated":false}`},
+		{name: "right", args: PadTextArgs{Text: "abc", Width: 6, Align: "right", Fill: "."}, text: "...abc"},
+		{name: "center", args: PadTextArgs{Text: "abc", Width: 6, Align: "center"}, text: " abc  "},
+		{name: "wide characters", args: PadTextArgs{Text: "日本", Width: 6, Align: "center", Fill: "-"}, text: "-日本-", out: `{"width":6}`},
+		{name: "exact width", args: PadTextArgs{Text: "日本語", Width: 6, Align: "right"}, text: "日本語"},
+		{
+			name: "truncate",
+			args: PadTextArgs{Text: "hello world", Width: 8, Truncate: true},
+			text: "hello w…",
+			out:  `{"text":"hello w…","width":8,"truncated":true}`,
+		},
+		{name: "truncate custom ellipsis", args: PadTextArgs{Text: "hello world", Width: 8, Truncate: true, Ellipsis: ptr("...")}, text: "hello..."},
+		{name: "truncate wide", args: PadTextArgs{Text: "日本語テキスト", Width: 7, Truncate: true}, text: "日本語…", out: `{"truncated":true}`},
+		{name: "truncate wide then pad", args: PadTextArgs{Text: "日本語", Width: 4, Truncate: true}, text: "日… "},
+		{name: "truncate keeps ZWJ sequence", args: PadTextArgs{Text: "\U0001F468\u200d\U0001F469\u200d\U0001F467abc", Width: 3, Truncate: true, Ellipsis: ptr("")}, text: "\U0001F468\u200d\U0001F469\u200d\U0001F467a"},
+		{
+			name: "overflow without truncate",
+			args: PadTextArgs{Text: "hello world", Width: 5},
+			text: "hello world",
+			out:  `{"text":"hello world","width":11,"truncated":false,"overflow":6}`,
+		},
+		{name: "zero width", args: PadTextArgs{Text: "abc", Width: 0}, err: true, text: "Width must be between 1 and 1000"},
+		{name: "unknown alignment", args: PadTextArgs{Text: "abc", Width: 6, Align: "justify"}, err: true, text: "Unsupported alignment: justify (use left, right, or center)"},
+		{name: "long fill", args: PadTextArgs{Text: "abc", Width: 6, Fill: "ab"}, err: true, text: "Fill must be a single one-column character"},
+		{name: "wide fill", args: PadTextArgs{Text: "abc", Width: 6, Fill: "日"}, err: true, text: "Fill must be a single one-column character"},
+		{name: "ellipsis too wide", args: PadTextArgs{Text: "abc", Width: 1, Truncate: true, Ellipsis: ptr("...")}, err: true, text: "Ellipsis is wider than the target width"},
+	})
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ParseArgsArgs struct {
+	Mode    string   `json:"mode,omitempty" jsonschema:"split (default) to turn a command line into arguments, or join to quote arguments into a command line"`
+	Command string   `json:"command,omitempty" jsonschema:"The command line to split"`
+	Args    []string `json:"args,omitempty" jsonschema:"The arguments to join"`
+}
+
+// splitCommandLine splits s into words the way a POSIX shell does before
+// expansion: whitespace separates words, single quotes keep everything
+// literally, double quotes keep everything except backslash escapes of
+// \ " $ ` and newline, and an unquoted backslash escapes the next character.
+// Variables, globs, and other expansions are left as literal text.
+func splitCommandLine(s string) ([]string, error) {
+	args := []string{}
+	var word strings.Builder
+	inWord := false
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				args = append(args, word.String())
+				word.Reset()
+				inWord = false
+			}
+
+		case r == '\\':
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("trailing backslash at position %d", i+1)
+			}
+			i++
+			// Backslash-newline is a line continuation and disappears.
+			if runes[i] != '\n' {
+				word.WriteRune(runes[i])
+				inWord = true
+			}
+
+		case r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated single quote at position %d", i+1)
+			}
+			word.WriteString(string(runes[i+1 : end]))
+			inWord = true
+			i = end
+
+		case r == '"':
+			start := i
+			closed := false
+			for i++; i < len(runes); i++ {
+				c := runes[i]
+				if c == '"' {
+					closed = true
+					break
+				}
+				if c == '\\' && i+1 < len(runes) && strings.ContainsRune("\\\"$`\n", runes[i+1]) {
+					i++
+					if runes[i] != '\n' {
+						word.WriteRune(runes[i])
+					}
+					continue
+				}
+				word.WriteRune(c)
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated double quote at position %d", start+1)
+			}
+			inWord = true
+
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		args = append(args, word.String())
+	}
+	return args, nil
+}
+
+// shellQuote quotes arg for a POSIX shell. Arguments made only of characters
+// with no special meaning are left bare; anything else is single-quoted, with
+// each embedded single quote closed, backslash-escaped, and reopened.
+func shellQuote(arg string) string {
+	if arg == "" {
+		return "''"
+	}
+	safe := true
+	for _, r := range arg {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("@%+=:,./_-", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+func handleParseArgs(ctx context.Context, req *mcp.CallToolRequest, args ParseArgsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("parse_args called: mode=%q", args.Mode))
+
+	switch args.Mode {
+	case "", "split":
+		if args.Args != nil {
+			return errorResult("'args' cannot be used with split mode"), nil, nil
+		}
+		argv, err := splitCommandLine(args.Command)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Cannot parse command: %v", err)), nil, nil
+		}
+		lines := make([]string, len(argv))
+		for i, a := range argv {
+			lines[i] = fmt.Sprintf("[%d] %s", i, a)
+		}
+		return textResult(strings.Join(lines, "\n")), map[string]any{"args": argv, "count": len(argv)}, nil
+
+	case "join":
+		if args.Command != "" {
+			return errorResult("'command' cannot be used with join mode"), nil, nil
+		}
+		quoted := make([]string, len(args.Args))
+		for i, a := range args.Args {
+			quoted[i] = shellQuote(a)
+		}
+		command := strings.Join(quoted, " ")
+		return textResult(command), map[string]any{"command": command}, nil
+
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+}
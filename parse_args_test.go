@@ -0,0 +1,123 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	runToolCases(t, handleParseArgs, []toolCase[ParseArgsArgs]{
+		{
+			name: "quoted argument with spaces",
+			args: ParseArgsArgs{Command: `git commit -m "fix the bug"`},
+			text: "[0] git\n[1] commit\n[2] -m\n[3] fix the bug",
+			out:  `{"args":["git","commit","-m","fix the bug"],"count":4}`,
+		},
+		{
+			name: "escaped quotes",
+			args: ParseArgsArgs{Mode: "split", Command: `echo "say \"hi\"" 'it'\''s'`},
+			out:  `{"args":["echo","say \"hi\"","it's"]}`,
+		},
+		{
+			name: "escaped space",
+			args: ParseArgsArgs{Command: `a\ b   c`},
+			out:  `{"args":["a b","c"],"count":2}`,
+		},
+		{
+			name: "single quotes are literal",
+			args: ParseArgsArgs{Command: `'$HOME \x'`},
+			out:  `{"args":["$HOME \\x"]}`,
+		},
+		{
+			name: "double quotes keep other backslashes",
+			args: ParseArgsArgs{Command: `"a\nb" "\$x"`},
+			out:  `{"args":["a\\nb","$x"]}`,
+		},
+		{
+			name: "quoted part joins its word",
+			args: ParseArgsArgs{Command: `--name="John Doe" x`},
+			out:  `{"args":["--name=John Doe","x"]}`,
+		},
+		{
+			name: "empty quoted argument",
+			args: ParseArgsArgs{Command: `a "" b`},
+			out:  `{"args":["a","","b"],"count":3}`,
+		},
+		{
+			name: "line continuation",
+			args: ParseArgsArgs{Command: "ls \\\n-l"},
+			out:  `{"args":["ls","-l"]}`,
+		},
+		{
+			name: "empty command",
+			args: ParseArgsArgs{Command: "  "},
+			out:  `{"args":[],"count":0}`,
+		},
+		{
+			name: "unterminated double quote",
+			args: ParseArgsArgs{Command: `echo "unterminated`},
+			err:  true,
+			text: "Cannot parse command: unterminated double quote at position 6",
+		},
+		{
+			name: "unterminated single quote",
+			args: ParseArgsArgs{Command: `echo 'x`},
+			err:  true,
+			text: "Cannot parse command: unterminated single quote at position 6",
+		},
+		{
+			name: "trailing backslash",
+			args: ParseArgsArgs{Command: `a\`},
+			err:  true,
+			text: "Cannot parse command: trailing backslash at position 2",
+		},
+		{
+			name: "join",
+			args: ParseArgsArgs{Mode: "join", Args: []string{"echo", "hello world", "it's", "", "--x=1", "$HOME"}},
+			text: `echo 'hello world' 'it'\''s' '' --x=1 '$HOME'`,
+			out:  `{"command":"echo 'hello world' 'it'\\''s' '' --x=1 '$HOME'"}`,
+		},
+		{
+			name: "args in split mode",
+			args: ParseArgsArgs{Command: "a", Args: []string{"b"}},
+			err:  true,
+			text: "'args' cannot be used with split mode",
+		},
+		{
+			name: "command in join mode",
+			args: ParseArgsArgs{Mode: "join", Command: "a", Args: []string{"b"}},
+			err:  true,
+			text: "'command' cannot be used with join mode",
+		},
+		{
+			name: "unsupported mode",
+			args: ParseArgsArgs{Mode: "eval"},
+			err:  true,
+			text: "Unsupported mode: eval",
+		},
+	})
+}
+
+func TestParseArgsRoundTrip(t *testing.T) {
+	argvs := [][]string{
+		{"echo", "hello world"},
+		{"it's", `"quoted"`, `back\slash`, ""},
+		{"$(rm -rf /)", "*.go", "a;b", "tab\there", "new\nline"},
+		{"日本語", "-flag=value with spaces"},
+	}
+	for _, argv := range argvs {
+		quoted := make([]string, len(argv))
+		for i, a := range argv {
+			quoted[i] = shellQuote(a)
+		}
+		command := strings.Join(quoted, " ")
+		got, err := splitCommandLine(command)
+		if err != nil {
+			t.Fatalf("splitCommandLine(%q): %v", command, err)
+		}
+		if !slices.Equal(got, argv) {
+			t.Errorf("round trip of %q through %q = %q", argv, command, got)
+		}
+	}
+}
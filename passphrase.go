@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	mrand "math/rand/v2"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type PassphraseArgs struct {
+	Words      *int    `json:"words,omitempty" jsonschema:"Number of words (3-20, default 6)"`
+	Separator  *string `json:"separator,omitempty" jsonschema:"Text placed between words (default -; may be empty)"`
+	Capitalize bool    `json:"capitalize,omitempty" jsonschema:"Capitalize the first letter of each word"`
+	Seed       *uint64 `json:"seed,omitempty" jsonschema:"Seed for a deterministic pseudo-random sequence, for testing only; without it words are drawn with crypto/rand"`
+}
+
+// passphraseWords is the diceware-style list passphrases are drawn from:
+// 1024 distinct, lowercase, everyday words of at most eight letters, so each
+// word adds exactly 10 bits of entropy.
+var passphraseWords = []string{
+	"abacus", "able", "acid", "acorn", "acre", "acrobat", "actor", "adapt",
+	"admiral", "adobe", "aerial", "agent", "agile", "airport", "aisle",
+	"alarm", "album", "alcove", "alert", "alfalfa", "alien", "alley", "allow",
+	"almanac", "almond", "alpha", "alpine", "amber", "amble", "ample",
+	"amulet", "anchor", "angle", "ankle", "anthem", "antler", "anvil", "apex",
+	"apple", "apricot", "apron", "aqua", "arbor", "arcade", "arch", "archer",
+	"arctic", "arena", "argue", "armada", "armor", "army", "aroma", "arrow",
+	"artist", "ashen", "aspen", "atlas", "atom", "attic", "audio", "august",
+	"aurora", "autumn", "avenue", "avocado", "awake", "award", "axis", "bacon",
+	"badge", "badger", "bagel", "baker", "balance", "balcony", "ballad",
+	"bamboo", "banana", "band", "banjo", "banner", "barcode", "barley", "barn",
+	"barrel", "basalt", "basil", "basin", "basket", "batch", "baton", "bauble",
+	"beach", "beacon", "beagle", "beam", "bean", "bear", "beaver", "bedrock",
+	"beehive", "beetle", "bell", "belt", "bench", "berry", "bicycle", "bingo",
+	"birch", "bird", "biscuit", "bison", "blade", "blanket", "blaze", "blend",
+	"blimp", "blink", "bliss", "block", "bloom", "blossom", "blue", "blush",
+	"board", "boat", "bobcat", "bonfire", "bonus", "book", "boost", "boot",
+	"border", "bottle", "boulder", "bounce", "bouquet", "bowl", "boxcar",
+	"bramble", "brave", "bread", "breeze", "brick", "bridge", "brief",
+	"bright", "brisk", "bronze", "brook", "broom", "brownie", "brush",
+	"bubble", "bucket", "buckle", "buddy", "budget", "buffalo", "bugle",
+	"build", "bundle", "bunny", "burrito", "burrow", "butter", "button",
+	"buzzer", "cabana", "cabbage", "cabin", "cable", "caboose", "cactus",
+	"cadet", "cake", "calypso", "camel", "camera", "camp", "canal", "canary",
+	"candle", "candy", "cannon", "canoe", "canvas", "canyon", "cape",
+	"captain", "caramel", "caravan", "carbon", "cargo", "carpet", "carrot",
+	"cartoon", "cashew", "castle", "catalog", "catnip", "cavern", "cedar",
+	"celery", "cellar", "cello", "chalk", "chapter", "charm", "cheese",
+	"cherry", "chess", "chief", "chimney", "chorus", "cider", "cinema",
+	"circle", "citrus", "civic", "clamp", "clay", "clever", "cliff", "climb",
+	"clipper", "clock", "cloud", "clover", "coach", "coast", "coaster",
+	"cobalt", "cobble", "cobweb", "cocoa", "coconut", "cocoon", "comet",
+	"comfort", "compass", "condor", "copper", "coral", "corner", "cornet",
+	"cotton", "couch", "country", "cousin", "cover", "cowboy", "coyote",
+	"cradle", "crane", "crater", "crayon", "cream", "credit", "creek",
+	"cricket", "crimson", "crisp", "crown", "crumpet", "crystal", "cube",
+	"cupcake", "curtain", "cushion", "custard", "cycle", "cypress", "dahlia",
+	"daisy", "dance", "dawn", "decade", "deck", "deer", "delta", "denim",
+	"desert", "desk", "detail", "dewdrop", "dial", "diary", "diesel", "dinner",
+	"dipper", "disco", "dock", "dolphin", "domain", "domino", "donkey",
+	"doodle", "dove", "dragon", "drama", "drawer", "dream", "drift", "drum",
+	"duck", "dune", "dusk", "dynamo", "eagle", "early", "earth", "easel",
+	"echo", "eclipse", "edge", "elbow", "elder", "elegant", "elk", "ember",
+	"emblem", "emerald", "empire", "engine", "enjoy", "entry", "envoy", "epic",
+	"equal", "escape", "estate", "ethic", "event", "exact", "exit", "expert",
+	"fable", "fabric", "falafel", "falcon", "fancy", "farm", "faucet", "feast",
+	"feather", "fence", "fennel", "fern", "ferret", "ferry", "fiber", "fiddle",
+	"field", "fig", "film", "finch", "firefly", "firm", "fjord", "flag",
+	"flame", "flannel", "flash", "fleet", "flint", "flipper", "float", "flock",
+	"flora", "flour", "flute", "focus", "foggy", "forest", "forge", "fossil",
+	"fox", "frame", "freckle", "fresco", "fresh", "frost", "fruit", "fudge",
+	"funnel", "gadfly", "gadget", "galaxy", "galleon", "garden", "garlic",
+	"garnet", "gazebo", "gazelle", "gecko", "gem", "genius", "gentle",
+	"geyser", "giant", "ginger", "gingham", "giraffe", "glacier", "glad",
+	"glass", "glide", "glimmer", "globe", "glove", "goat", "goblet", "golden",
+	"gondola", "goose", "gopher", "gospel", "gourd", "grace", "grain",
+	"granite", "granola", "grape", "graph", "grass", "gravel", "gravy",
+	"green", "grid", "grill", "grove", "guitar", "gulf", "gumdrop", "gust",
+	"habit", "hairpin", "halibut", "hammer", "hammock", "hamster", "harbor",
+	"harmony", "harpoon", "harvest", "hazel", "health", "heart", "hedge",
+	"helmet", "hemlock", "herb", "heron", "hickory", "hiking", "hill",
+	"hilltop", "hinge", "hobby", "hockey", "honey", "hoop", "horizon",
+	"hornet", "horse", "hotdog", "hotel", "hound", "hubcap", "humble",
+	"hummus", "hunter", "husky", "iceberg", "icicle", "icon", "igloo",
+	"iguana", "image", "impact", "inch", "index", "indigo", "ink", "inkwell",
+	"inlet", "insect", "island", "ivory", "ivy", "jackal", "jacket", "jaguar",
+	"jasmine", "jazz", "jeans", "jelly", "jester", "jetty", "jewel", "jigsaw",
+	"jockey", "jolly", "journal", "journey", "joy", "jubilee", "judge",
+	"juice", "jukebox", "jumbo", "jungle", "juniper", "jury", "kale", "kayak",
+	"kernel", "kestrel", "kettle", "kidney", "kind", "kingdom", "kite",
+	"kitten", "kiwi", "knee", "knight", "knot", "koala", "label", "ladder",
+	"ladybug", "lagoon", "lake", "lamp", "lantern", "laptop", "large", "lasso",
+	"latch", "lava", "lawn", "layer", "leaf", "ledge", "legend", "lemon",
+	"lemur", "lentil", "letter", "level", "lilac", "lily", "lime", "linen",
+	"lion", "lively", "lizard", "llama", "lobby", "lobster", "locket", "lodge",
+	"lollipop", "lotus", "lucky", "lumber", "lunar", "lunch", "lyric",
+	"macaroni", "magenta", "magnet", "magpie", "mammoth", "manatee",
+	"mandolin", "mango", "maple", "marathon", "marble", "march", "marigold",
+	"market", "marsh", "mascot", "mason", "meadow", "meatball", "medal",
+	"melody", "melon", "mentor", "meringue", "merry", "mesa", "metal",
+	"meteor", "metro", "middle", "minnow", "mint", "mirror", "mitten", "model",
+	"modest", "molasses", "mongoose", "monkey", "monsoon", "moonbeam", "moose",
+	"morning", "mosaic", "moss", "motor", "mountain", "mudslide", "muffin",
+	"mulberry", "mural", "museum", "music", "muskrat", "mustard", "napkin",
+	"narrow", "native", "nature", "navy", "nectar", "needle", "nest", "nickel",
+	"nightcap", "noble", "noodle", "north", "notebook", "notepad", "nougat",
+	"novel", "nugget", "nutmeg", "nutshell", "oasis", "oat", "oatmeal",
+	"ocean", "octave", "octopus", "okra", "olive", "omega", "omelet", "onion",
+	"opal", "opera", "orange", "orbit", "orca", "orchard", "orchid", "organ",
+	"ostrich", "otter", "outlet", "outpost", "oval", "oven", "owl", "oxygen",
+	"oyster", "paddle", "pagoda", "palace", "palm", "pancake", "panda",
+	"panel", "panther", "paper", "paprika", "parade", "parakeet", "parcel",
+	"parrot", "parsley", "parsnip", "pasta", "pastel", "pathway", "patio",
+	"peach", "peacock", "peanut", "pearl", "pebble", "pecan", "pelican",
+	"pencil", "pepper", "petal", "piano", "pickle", "picnic", "pigeon",
+	"pillow", "pilot", "pine", "pinecone", "pinwheel", "pioneer", "pirate",
+	"pixel", "pizza", "planet", "platypus", "plaza", "plover", "plum",
+	"pocket", "poem", "polar", "pony", "popcorn", "poppy", "porridge",
+	"portal", "postcard", "potato", "pottery", "prairie", "pretzel",
+	"primrose", "prism", "pudding", "pulse", "pumpkin", "puppet", "puzzle",
+	"pyramid", "quail", "quarry", "quartz", "queen", "quest", "quick", "quiet",
+	"quill", "quilt", "quiver", "quokka", "rabbit", "raccoon", "radar",
+	"radio", "radish", "raft", "ragtime", "rain", "rainbow", "raisin", "ranch",
+	"raven", "ravioli", "redwood", "reef", "reindeer", "relay", "remedy",
+	"rhubarb", "ribbon", "rice", "riddle", "ridge", "ringtone", "ripple",
+	"river", "robin", "robot", "rocket", "rodeo", "roof", "rooster", "rose",
+	"rosebud", "rover", "rowboat", "ruby", "rudder", "rugby", "ruler",
+	"saddle", "saffron", "saguaro", "sail", "sailboat", "salad", "salmon",
+	"salsa", "sand", "sandal", "sandbox", "sapphire", "sardine", "satin",
+	"saucer", "sawdust", "scallop", "scarf", "school", "scooter", "scout",
+	"seagull", "seahorse", "seashell", "season", "seed", "sequel", "sesame",
+	"shadow", "shamrock", "shell", "shelter", "sherbet", "shoelace", "shore",
+	"siesta", "signal", "silk", "silver", "siren", "sketch", "skiff",
+	"skylark", "sled", "slope", "smile", "snow", "snowman", "socket", "sofa",
+	"solar", "sonnet", "sorbet", "spark", "sparrow", "spice", "spider",
+	"spinach", "spiral", "sponge", "spoon", "spring", "sprinkle", "sprout",
+	"spruce", "square", "squash", "squirrel", "stable", "stadium", "staple",
+	"stardust", "starfish", "statue", "steam", "stencil", "stingray", "stone",
+	"storm", "story", "stream", "studio", "sugar", "summit", "sunbeam",
+	"sundial", "sunset", "surf", "sushi", "swallow", "swan", "sweater",
+	"syrup", "table", "tablet", "taco", "tadpole", "tail", "talent",
+	"tamarind", "tango", "tanker", "tapestry", "target", "teacup", "teapot",
+	"teardrop", "temple", "tennis", "tent", "thermos", "thimble", "thistle",
+	"thunder", "ticket", "tiger", "timber", "tiptoe", "toast", "toboggan",
+	"toffee", "tomato", "toolbox", "topaz", "torch", "tornado", "tortoise",
+	"totem", "toucan", "tower", "tractor", "trail", "travel", "treasure",
+	"treetop", "trellis", "tribe", "tricycle", "trophy", "trout", "truffle",
+	"trumpet", "tugboat", "tulip", "tuna", "tundra", "tunnel", "turkey",
+	"turnip", "turtle", "tuxedo", "tweed", "twig", "ukulele", "umbrella",
+	"unicorn", "union", "unit", "upland", "urban", "utensil", "valley",
+	"vanilla", "vapor", "vault", "velvet", "venture", "verse", "vessel",
+	"vest", "vineyard", "violet", "violin", "visor", "vista", "vivid",
+	"volcano", "voyage", "wafer", "waffle", "wagon", "walkway", "wallaby",
+	"walnut", "walrus", "wander", "warbler", "warden", "warthog", "wasabi",
+	"water", "wave", "wax", "weasel", "weekend", "wheat", "whisker", "whistle",
+	"wigwam", "wildcat", "willow", "windmill", "window", "winter", "wizard",
+	"wombat", "wonder", "wool", "world", "wreath", "yacht", "yard", "yarn",
+	"yearbook", "yeast", "yellow", "yodel", "yogurt", "yonder", "yoyo",
+	"zebra", "zenith", "zephyr", "zero", "zigzag", "zinc", "zipper", "zone",
+	"zucchini",
+}
+
+// cryptoIntN returns a uniformly distributed int in [0, n) from crypto/rand.
+func cryptoIntN(n int) int {
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(fmt.Sprintf("crypto/rand failed: %v", err))
+	}
+	return int(v.Int64())
+}
+
+func handlePassphrase(ctx context.Context, req *mcp.CallToolRequest, args PassphraseArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("passphrase called: seeded=%t", args.Seed != nil))
+
+	count := 6
+	if args.Words != nil {
+		count = *args.Words
+		if count < 3 || count > 20 {
+			return errorResult("Words must be between 3 and 20"), nil, nil
+		}
+	}
+	separator := "-"
+	if args.Separator != nil {
+		separator = *args.Separator
+		if utf8.RuneCountInString(separator) > 5 {
+			return errorResult("Separator must be at most 5 characters"), nil, nil
+		}
+	}
+
+	intN := cryptoIntN
+	if args.Seed != nil {
+		intN = mrand.New(mrand.NewPCG(*args.Seed, 0)).IntN
+	}
+
+	words := make([]string, count)
+	for i := range words {
+		word := passphraseWords[intN(len(passphraseWords))]
+		if args.Capitalize {
+			r, size := utf8.DecodeRuneInString(word)
+			word = string(unicode.ToUpper(r)) + word[size:]
+		}
+		words[i] = word
+	}
+	passphrase := strings.Join(words, separator)
+
+	// Entropy counts only the random word choices; the separator and
+	// capitalization are fixed, so they add nothing an attacker must guess.
+	bitsPerWord := math.Log2(float64(len(passphraseWords)))
+	entropy := roundTo(bitsPerWord*float64(count), 2)
+
+	return textResult(passphrase), map[string]any{
+		"passphrase":    passphrase,
+		"words":         count,
+		"wordlist_size": len(passphraseWords),
+		"entropy_bits":  entropy,
+		"seeded":        args.Seed != nil,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestPassphrase(t *testing.T) {
+	runToolCases(t, handlePassphrase, []toolCase[PassphraseArgs]{
+		{
+			name: "defaults",
+			args: PassphraseArgs{},
+			out:  `{"words":6,"wordlist_size":1024,"entropy_bits":60,"seeded":false}`,
+		},
+		{
+			name: "word count",
+			args: PassphraseArgs{Words: ptr(4)},
+			out:  `{"words":4,"entropy_bits":40}`,
+		},
+		{
+			name: "maximum words",
+			args: PassphraseArgs{Words: ptr(20), Seed: ptr[uint64](1)},
+			out:  `{"words":20,"entropy_bits":200,"seeded":true}`,
+		},
+		{
+			name: "too few words",
+			args: PassphraseArgs{Words: ptr(2)},
+			err:  true,
+			text: "Words must be between 3 and 20",
+		},
+		{
+			name: "too many words",
+			args: PassphraseArgs{Words: ptr(21)},
+			err:  true,
+			text: "Words must be between 3 and 20",
+		},
+		{
+			name: "separator too long",
+			args: PassphraseArgs{Separator: ptr("------")},
+			err:  true,
+			text: "Separator must be at most 5 characters",
+		},
+	})
+}
+
+func TestPassphraseWords(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      PassphraseArgs
+		separator string
+		words     int
+	}{
+		{"default separator", PassphraseArgs{}, "-", 6},
+		{"space separator", PassphraseArgs{Words: ptr(4), Separator: ptr(" ")}, " ", 4},
+		{"multi-character separator", PassphraseArgs{Words: ptr(3), Separator: ptr(" :: "), Capitalize: true}, " :: ", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, out := callTool(t, handlePassphrase, tt.args)
+			text := resultText(result)
+			if got := out.(map[string]any)["passphrase"]; got != text {
+				t.Errorf("passphrase %q differs from text %q", got, text)
+			}
+			words := strings.Split(text, tt.separator)
+			if len(words) != tt.words {
+				t.Fatalf("%q has %d words, want %d", text, len(words), tt.words)
+			}
+			for _, w := range words {
+				want := w
+				if tt.args.Capitalize {
+					if !unicode.IsUpper([]rune(w)[0]) {
+						t.Errorf("word %q is not capitalized", w)
+					}
+					want = strings.ToLower(w)
+				}
+				if !slices.Contains(passphraseWords, want) {
+					t.Errorf("word %q is not in the wordlist", w)
+				}
+			}
+		})
+	}
+}
+
+func TestPassphraseSeed(t *testing.T) {
+	args := PassphraseArgs{Words: ptr(8), Seed: ptr[uint64](42)}
+	first, _ := callTool(t, handlePassphrase, args)
+	second, _ := callTool(t, handlePassphrase, args)
+	if resultText(first) != resultText(second) {
+		t.Errorf("same seed gave %q and %q", resultText(first), resultText(second))
+	}
+	args.Seed = ptr[uint64](43)
+	other, _ := callTool(t, handlePassphrase, args)
+	if resultText(other) == resultText(first) {
+		t.Errorf("different seeds gave the same passphrase %q", resultText(first))
+	}
+
+	joined, _ := callTool(t, handlePassphrase, PassphraseArgs{Words: ptr(8), Seed: ptr[uint64](42), Separator: ptr("")})
+	if want := strings.ReplaceAll(resultText(first), "-", ""); resultText(joined) != want {
+		t.Errorf("empty separator gave %q, want %q", resultText(joined), want)
+	}
+}
+
+func TestPassphraseWordlist(t *testing.T) {
+	if len(passphraseWords) != 1024 {
+		t.Errorf("wordlist has %d words, want 1024", len(passphraseWords))
+	}
+	if bits := math.Log2(float64(len(passphraseWords))); bits != 10 {
+		t.Errorf("each word adds %v bits, want 10", bits)
+	}
+	seen := map[string]bool{}
+	for _, w := range passphraseWords {
+		if seen[w] {
+			t.Errorf("duplicate word %q", w)
+		}
+		seen[w] = true
+		if len(w) == 0 || len(w) > 8 || strings.ToLower(w) != w || strings.IndexFunc(w, func(r rune) bool { return r < 'a' || r > 'z' }) >= 0 {
+			t.Errorf("word %q is not a lowercase word of at most eight letters", w)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type PasswordStrengthArgs struct {
+	Password string `json:"password" jsonschema:"The password to analyze. It is checked locally and never logged or sent anywhere"`
+}
+
+// commonPasswordWords are frequent passwords and password fragments. Matching
+// is case-insensitive and undoes common character substitutions first.
+var commonPasswordWords = []string{
+	"password", "passwd", "qwerty", "letmein", "welcome", "admin", "administrator",
+	"login", "master", "dragon", "monkey", "football", "baseball", "soccer",
+	"hockey", "iloveyou", "sunshine", "princess", "shadow", "superman", "batman",
+	"trustno", "secret", "starwars", "whatever", "freedom", "flower", "hello",
+	"charlie", "michael", "jordan", "jennifer", "hunter", "ranger", "summer",
+	"winter", "spring", "autumn", "change", "default", "guest", "root", "user",
+	"test", "love", "angel", "lovely", "cookie", "cheese", "computer", "internet",
+	"google", "pepper", "ginger", "orange", "banana", "purple", "killer", "tiger",
+	"matrix", "access", "money", "mustang", "maggie", "buster", "daniel", "thomas",
+	"robert", "ashley", "nicole", "jessica", "pokemon", "qazwsx", "zaq1",
+}
+
+// commonPasswordRanks maps each common word to its 1-based position in the
+// list, which stands in for how early an attacker would try it.
+var commonPasswordRanks = func() map[string]int {
+	ranks := make(map[string]int, len(commonPasswordWords))
+	for i, w := range commonPasswordWords {
+		ranks[w] = i + 1
+	}
+	return ranks
+}()
+
+// leetSubstitutions undoes the digit and symbol swaps people use to disguise
+// dictionary words.
+var leetSubstitutions = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "8", "b", "9", "g", "@", "a", "$", "s", "!", "i", "|", "l",
+)
+
+var keyboardRows = []string{"`1234567890-=", "qwertyuiop[]\\", "asdfghjkl;'", "zxcvbnm,./"}
+
+type passwordPattern struct {
+	Type  string `json:"type" jsonschema:"dictionary, sequence, keyboard, repeat, or year"`
+	Match string `json:"match"`
+	Start int    `json:"start" jsonschema:"Rune offset of the match"`
+	bits  float64
+}
+
+// patternFinder collects non-overlapping weak patterns; earlier finds claim
+// their characters so later, weaker checks cannot count them again.
+type patternFinder struct {
+	runes    []rune
+	lower    []rune
+	covered  []bool
+	patterns []passwordPattern
+}
+
+func (f *patternFinder) add(kind string, start, end int, bits float64) {
+	for i := start; i < end; i++ {
+		if f.covered[i] {
+			return
+		}
+	}
+	for i := start; i < end; i++ {
+		f.covered[i] = true
+	}
+	f.patterns = append(f.patterns, passwordPattern{Type: kind, Match: string(f.runes[start:end]), Start: start, bits: bits})
+}
+
+func (f *patternFinder) findDictionaryWords() {
+	// Every substitution replaces one character with one, so offsets in
+	// the unleeted text match the password's.
+	unleeted := []rune(leetSubstitutions.Replace(string(f.lower)))
+	// Longer words first, so "password" wins over "pass"-like fragments.
+	for length := len(unleeted); length >= 3; length-- {
+		for start := 0; start+length <= len(unleeted); start++ {
+			if rank, ok := commonPasswordRanks[string(unleeted[start:start+length])]; ok {
+				// A dictionary word costs about as much to guess as its rank
+				// in the list, doubled for a possible capital.
+				f.add("dictionary", start, start+length, math.Log2(float64(rank)*2))
+			}
+		}
+	}
+}
+
+func (f *patternFinder) findKeyboardRuns() {
+	n := len(f.lower)
+	for start := 0; start+2 < n; start++ {
+		for _, row := range keyboardRows {
+			end := start
+			for end < n {
+				i := strings.IndexRune(row, f.lower[end])
+				if i < 0 || (end > start && (i == 0 || rune(row[i-1]) != f.lower[end-1])) {
+					break
+				}
+				end++
+			}
+			if end-start >= 4 {
+				f.add("keyboard", start, end, math.Log2(float64(len(keyboardRows)*13))+math.Log2(float64(end-start)))
+			}
+		}
+	}
+}
+
+func (f *patternFinder) findRepeats() {
+	n := len(f.lower)
+	for start := 0; start < n; {
+		end := start + 1
+		for end < n && f.lower[end] == f.lower[start] {
+			end++
+		}
+		if end-start >= 3 {
+			f.add("repeat", start, end, math.Log2(95)+math.Log2(float64(end-start)))
+		}
+		start = end
+	}
+}
+
+func (f *patternFinder) findSequences() {
+	n := len(f.lower)
+	for start := 0; start+2 < n; {
+		step := f.lower[start+1] - f.lower[start]
+		end := start + 1
+		for end < n && (step == 1 || step == -1) && f.lower[end]-f.lower[end-1] == step && isASCIIAlnum(f.lower[end]) {
+			end++
+		}
+		if end-start >= 3 && isASCIIAlnum(f.lower[start]) {
+			f.add("sequence", start, end, math.Log2(36*2)+math.Log2(float64(end-start)))
+			start = end
+			continue
+		}
+		start++
+	}
+}
+
+func (f *patternFinder) findYears() {
+	n := len(f.lower)
+	for start := 0; start+4 <= n; start++ {
+		year := string(f.lower[start : start+4])
+		if (strings.HasPrefix(year, "19") || strings.HasPrefix(year, "20")) && isDigits(year) {
+			f.add("year", start, start+4, math.Log2(150))
+		}
+	}
+}
+
+func isASCIIAlnum(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+var passwordStrengthLabels = []string{"very weak", "weak", "fair", "strong", "very strong"}
+
+func handlePasswordStrength(ctx context.Context, req *mcp.CallToolRequest, args PasswordStrengthArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("password_strength called with a %d-character password", utf8.RuneCountInString(args.Password)))
+
+	runes := []rune(args.Password)
+	if len(runes) == 0 || len(runes) > 256 {
+		return errorResult("Password must be between 1 and 256 characters"), nil, nil
+	}
+	classes := map[string]bool{}
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			classes["lowercase"] = true
+		case r >= 'A' && r <= 'Z':
+			classes["uppercase"] = true
+		case r >= '0' && r <= '9':
+			classes["digits"] = true
+		case r < utf8.RuneSelf:
+			classes["symbols"] = true
+		default:
+			classes["other"] = true
+		}
+	}
+	pool := 0
+	for class, size := range map[string]int{"lowercase": 26, "uppercase": 26, "digits": 10, "symbols": 33, "other": 100} {
+		if classes[class] {
+			pool += size
+		}
+	}
+
+	f := &patternFinder{
+		runes:    runes,
+		lower:    []rune(strings.Map(unicode.ToLower, args.Password)),
+		covered:  make([]bool, len(runes)),
+		patterns: []passwordPattern{},
+	}
+	f.findDictionaryWords()
+	f.findKeyboardRuns()
+	f.findRepeats()
+	f.findSequences()
+	f.findYears()
+
+	// Characters inside a pattern cost only the pattern's guesses; the rest
+	// are charged the full alphabet they were drawn from.
+	entropy := 0.0
+	for _, p := range f.patterns {
+		entropy += p.bits
+	}
+	for _, c := range f.covered {
+		if !c {
+			entropy += math.Log2(float64(pool))
+		}
+	}
+	entropy = roundTo(entropy, 1)
+
+	score := 4
+	switch {
+	case entropy < 28:
+		score = 0
+	case entropy < 40:
+		score = 1
+	case entropy < 60:
+		score = 2
+	case entropy < 80:
+		score = 3
+	}
+
+	suggestions := []string{}
+	if len(runes) < 12 {
+		suggestions = append(suggestions, "Use at least 12 characters; length adds more strength than anything else")
+	}
+	if len(runes) < 20 && score < 4 {
+		for _, class := range []string{"uppercase", "lowercase", "digits", "symbols"} {
+			if !classes[class] {
+				suggestions = append(suggestions, "Add "+class)
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for _, p := range f.patterns {
+		if seen[p.Type] {
+			continue
+		}
+		seen[p.Type] = true
+		switch p.Type {
+		case "dictionary":
+			suggestions = append(suggestions, fmt.Sprintf("Avoid common passwords and words such as %q, even with letters swapped for digits or symbols", p.Match))
+		case "sequence":
+			suggestions = append(suggestions, fmt.Sprintf("Avoid sequences such as %q", p.Match))
+		case "keyboard":
+			suggestions = append(suggestions, fmt.Sprintf("Avoid keyboard patterns such as %q", p.Match))
+		case "repeat":
+			suggestions = append(suggestions, fmt.Sprintf("Avoid repeated characters such as %q", p.Match))
+		case "year":
+			suggestions = append(suggestions, "Avoid years and dates, which are easy to guess")
+		}
+	}
+
+	classList := []string{}
+	for _, class := range []string{"lowercase", "uppercase", "digits", "symbols", "other"} {
+		if classes[class] {
+			classList = append(classList, class)
+		}
+	}
+
+	text := fmt.Sprintf("Strength: %s (%d/4)\nEstimated entropy: %.1f bits", passwordStrengthLabels[score], score, entropy)
+	for _, s := range suggestions {
+		text += "\n- " + s
+	}
+
+	return textResult(text), map[string]any{
+		"score":             score,
+		"label":             passwordStrengthLabels[score],
+		"entropy_bits":      entropy,
+		"length":            len(runes),
+		"character_classes": classList,
+		"patterns":          f.patterns,
+		"suggestions":       suggestions,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPasswordStrength(t *testing.T) {
+	runToolCases(t, handlePasswordStrength, []toolCase[PasswordStrengthArgs]{
+		{
+			name: "weak",
+			args: PasswordStrengthArgs{Password: "password123"},
+			text: "Strength: very weak (0/4)\nEstimated entropy: 8.8 bits\n" +
+				"- Use at least 12 characters; length adds more strength than anything else\n" +
+				"- Add uppercase\n" +
+				"- Add symbols\n" +
+				"- Avoid common passwords and words such as \"password\", even with letters swapped for digits or symbols\n" +
+				"- Avoid sequences such as \"123\"",
+			out: `{"score":0,"label":"very weak","entropy_bits":8.8,"length":11,"character_classes":["lowercase","digits"],
+				"patterns":[{"type":"dictionary","match":"password","start":0},{"type":"sequence","match":"123","start":8}]}`,
+		},
+		{
+			name: "strong",
+			args: PasswordStrengthArgs{Password: "x7#Qm!2vLp9$Rz&4"},
+			text: "Strength: very strong (4/4)\nEstimated entropy: 105.1 bits",
+			out: `{"score":4,"label":"very strong","length":16,"character_classes":["lowercase","uppercase","digits","symbols"],
+				"patterns":[],"suggestions":[]}`,
+		},
+		{
+			name: "long passphrase",
+			args: PasswordStrengthArgs{Password: "correct-Horse7-battery!staple"},
+			out:  `{"score":4,"entropy_bits":190.5,"patterns":[]}`,
+		},
+		{
+			name: "substitutions undone",
+			args: PasswordStrengthArgs{Password: "P@ssw0rd"},
+			out:  `{"score":0,"entropy_bits":1,"patterns":[{"type":"dictionary","match":"P@ssw0rd","start":0}]}`,
+		},
+		{
+			name:     "keyboard run",
+			args:     PasswordStrengthArgs{Password: "qwertyuiop"},
+			contains: []string{"Avoid keyboard patterns such as \"uiop\""},
+			out:      `{"patterns":[{"type":"dictionary","match":"qwerty","start":0},{"type":"keyboard","match":"uiop","start":6}]}`,
+		},
+		{
+			name:     "repeat",
+			args:     PasswordStrengthArgs{Password: "aaaaaa"},
+			contains: []string{"Avoid repeated characters such as \"aaaaaa\""},
+			out:      `{"patterns":[{"type":"repeat","match":"aaaaaa","start":0}]}`,
+		},
+		{
+			name:     "sequence and year",
+			args:     PasswordStrengthArgs{Password: "abcdef1990"},
+			contains: []string{"Avoid sequences such as \"abcdef\"", "Avoid years and dates, which are easy to guess"},
+			out:      `{"patterns":[{"type":"sequence","match":"abcdef","start":0},{"type":"year","match":"1990","start":6}]}`,
+		},
+		{
+			name: "empty",
+			args: PasswordStrengthArgs{Password: ""},
+			err:  true,
+			text: "Password must be between 1 and 256 characters",
+		},
+		{
+			name: "too long",
+			args: PasswordStrengthArgs{Password: strings.Repeat("x", 257)},
+			err:  true,
+			text: "Password must be between 1 and 256 characters",
+		},
+	})
+}
+
+func TestPasswordStrengthOrdering(t *testing.T) {
+	// Each password should be estimated no stronger than the next.
+	passwords := []string{"password", "password123", "Summer2024!", "tR7#kq2!Lz", "x7#Qm!2vLp9$Rz&4"}
+	prev := -1.0
+	for _, p := range passwords {
+		_, out := callTool(t, handlePasswordStrength, PasswordStrengthArgs{Password: p})
+		entropy := numberField(t, out, "entropy_bits")
+		if entropy < prev {
+			t.Errorf("%q has %.1f bits, less than the weaker password before it (%.1f)", p, entropy, prev)
+		}
+		prev = entropy
+	}
+}
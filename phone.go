@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type PhoneArgs struct {
+	Number string `json:"number" jsonschema:"The phone number in national or international format"`
+	Region string `json:"region,omitempty" jsonschema:"Default ISO 3166 region code for numbers without a country code (e.g. US, GB). Defaults to US"`
+}
+
+// phoneRegion describes the numbering rules this tool knows for a region.
+// It is a deliberately small subset of the full ITU/libphonenumber metadata:
+// enough to normalize common numbers and reject obviously invalid ones.
+type phoneRegion struct {
+	CallingCode    string
+	TrunkPrefix    string
+	Lengths        []int    // allowed national significant number lengths
+	MobilePrefixes []string // national number prefixes assigned to mobiles; nil when indistinguishable
+}
+
+var phoneRegions = map[string]phoneRegion{
+	"US": {CallingCode: "1", TrunkPrefix: "1", Lengths: []int{10}},
+	"CA": {CallingCode: "1", TrunkPrefix: "1", Lengths: []int{10}},
+	"GB": {CallingCode: "44", TrunkPrefix: "0", Lengths: []int{9, 10}, MobilePrefixes: []string{"7"}},
+	"DE": {CallingCode: "49", TrunkPrefix: "0", Lengths: []int{6, 7, 8, 9, 10, 11}, MobilePrefixes: []string{"15", "16", "17"}},
+	"FR": {CallingCode: "33", TrunkPrefix: "0", Lengths: []int{9}, MobilePrefixes: []string{"6", "7"}},
+	"ES": {CallingCode: "34", Lengths: []int{9}, MobilePrefixes: []string{"6", "7"}},
+	"IN": {CallingCode: "91", TrunkPrefix: "0", Lengths: []int{10}, MobilePrefixes: []string{"6", "7", "8", "9"}},
+	"JP": {CallingCode: "81", TrunkPrefix: "0", Lengths: []int{9, 10}, MobilePrefixes: []string{"70", "80", "90"}},
+	"AU": {CallingCode: "61", TrunkPrefix: "0", Lengths: []int{9}, MobilePrefixes: []string{"4"}},
+}
+
+// lookupCallingCode finds the region for the calling code at the start of
+// digits, returning the region code and the remaining national number. NANP
+// numbers (+1) are reported as US.
+func lookupCallingCode(digits string) (string, string, bool) {
+	codes := make([]string, 0, len(phoneRegions))
+	for code := range phoneRegions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for n := 1; n <= 3 && n <= len(digits); n++ {
+		for _, code := range codes {
+			if phoneRegions[code].CallingCode == digits[:n] {
+				if digits[:n] == "1" {
+					code = "US"
+				}
+				return code, digits[n:], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func validNationalNumber(info phoneRegion, national string) bool {
+	lengthOK := false
+	for _, l := range info.Lengths {
+		if len(national) == l {
+			lengthOK = true
+		}
+	}
+	if !lengthOK {
+		return false
+	}
+
+	if info.CallingCode == "1" {
+		// NANP: area code and exchange both start with 2-9.
+		return national[0] >= '2' && national[3] >= '2'
+	}
+	return national[0] != '0'
+}
+
+func phoneType(info phoneRegion, national string) string {
+	if info.MobilePrefixes == nil {
+		return "fixed_line_or_mobile"
+	}
+	for _, p := range info.MobilePrefixes {
+		if strings.HasPrefix(national, p) {
+			return "mobile"
+		}
+	}
+	return "fixed_line"
+}
+
+func handlePhone(ctx context.Context, req *mcp.CallToolRequest, args PhoneArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("phone called with region: %q", args.Region))
+
+	region := strings.ToUpper(strings.TrimSpace(args.Region))
+	if region == "" {
+		region = "US"
+	}
+	if _, ok := phoneRegions[region]; !ok {
+		return errorResult(fmt.Sprintf("Unsupported region: %s", args.Region)), nil, nil
+	}
+
+	raw := strings.TrimSpace(args.Number)
+	international := strings.HasPrefix(raw, "+")
+
+	var digits strings.Builder
+	for _, r := range raw {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case strings.ContainsRune(" -.()/+", r):
+		default:
+			return errorResult(fmt.Sprintf("Invalid character in phone number: %q", r)), nil, nil
+		}
+	}
+	number := digits.String()
+
+	if !international {
+		if rest, ok := strings.CutPrefix(number, "00"); ok {
+			international, number = true, rest
+		} else if rest, ok := strings.CutPrefix(number, "011"); ok && phoneRegions[region].CallingCode == "1" {
+			international, number = true, rest
+		}
+	}
+
+	national := number
+	if international {
+		var ok bool
+		region, national, ok = lookupCallingCode(number)
+		if !ok {
+			return errorResult(fmt.Sprintf("Unknown or unsupported country calling code in: %s", args.Number)), nil, nil
+		}
+	}
+
+	info := phoneRegions[region]
+	if info.TrunkPrefix != "" && !validNationalNumber(info, national) {
+		national = strings.TrimPrefix(national, info.TrunkPrefix)
+	}
+
+	if !validNationalNumber(info, national) {
+		return errorResult(fmt.Sprintf("Invalid phone number for region %s: %s", region, args.Number)), nil, nil
+	}
+
+	e164 := "+" + info.CallingCode + national
+	kind := phoneType(info, national)
+
+	return textResult(e164), map[string]any{
+		"e164":            e164,
+		"valid":           true,
+		"country":         region,
+		"calling_code":    info.CallingCode,
+		"national_number": national,
+		"type":            kind,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import "testing"
+
+func TestPhone(t *testing.T) {
+	runToolCases(t, handlePhone, []toolCase[PhoneArgs]{
+		{
+			name: "US national format",
+			args: PhoneArgs{Number: "(415) 555-2671"},
+			text: "+14155552671",
+			out:  `{"e164":"+14155552671","valid":true,"country":"US","calling_code":"1","national_number":"4155552671","type":"fixed_line_or_mobile"}`,
+		},
+		{
+			name: "US trunk prefix",
+			args: PhoneArgs{Number: "1-415-555-2671", Region: "us"},
+			text: "+14155552671",
+		},
+		{
+			name: "GB mobile",
+			args: PhoneArgs{Number: "07400 123456", Region: "GB"},
+			text: "+447400123456",
+			out:  `{"country":"GB","national_number":"7400123456","type":"mobile"}`,
+		},
+		{
+			name: "GB fixed line",
+			args: PhoneArgs{Number: "020 7946 0958", Region: "GB"},
+			out:  `{"e164":"+442079460958","type":"fixed_line"}`,
+		},
+		{
+			name: "international overrides region",
+			args: PhoneArgs{Number: "+33 6 12 34 56 78", Region: "US"},
+			text: "+33612345678",
+			out:  `{"country":"FR","calling_code":"33","type":"mobile"}`,
+		},
+		{
+			name: "00 exit code",
+			args: PhoneArgs{Number: "0049 170 1234567", Region: "FR"},
+			out:  `{"e164":"+491701234567","country":"DE","type":"mobile"}`,
+		},
+		{
+			name: "011 exit code from NANP",
+			args: PhoneArgs{Number: "011 61 412 345 678"},
+			out:  `{"e164":"+61412345678","country":"AU"}`,
+		},
+		{name: "short string", args: PhoneArgs{Number: "12345"}, err: true, text: "Invalid phone number for region US: 12345"},
+		{name: "NANP area code", args: PhoneArgs{Number: "115-555-2671"}, err: true, text: "Invalid phone number for region US: 115-555-2671"},
+		{name: "letters", args: PhoneArgs{Number: "555-CALL-NOW"}, err: true, text: `Invalid character in phone number: 'C'`},
+		{name: "unknown calling code", args: PhoneArgs{Number: "+999 1234567"}, err: true, text: "Unknown or unsupported country calling code in: +999 1234567"},
+		{name: "unsupported region", args: PhoneArgs{Number: "0412 345 678", Region: "NZ"}, err: true, text: "Unsupported region: NZ"},
+	})
+}
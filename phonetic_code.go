@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type PhoneticCodeArgs struct {
+	Word      string `json:"word" jsonschema:"The word or name to encode (letters A-Z; apostrophes and hyphens are ignored)"`
+	Algorithm string `json:"algorithm,omitempty" jsonschema:"soundex or metaphone (default soundex)"`
+}
+
+// normalizePhoneticInput upper-cases word and drops apostrophes and hyphens,
+// rejecting anything else that is not an ASCII letter.
+func normalizePhoneticInput(word string) (string, error) {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(word) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		case r == '\'' || r == '-':
+		default:
+			return "", fmt.Errorf("word must contain only letters A-Z, found %q", r)
+		}
+	}
+	if b.Len() == 0 {
+		return "", fmt.Errorf("word must contain at least one letter")
+	}
+	return strings.ToUpper(b.String()), nil
+}
+
+var soundexDigits = map[byte]byte{
+	'B': '1', 'F': '1', 'P': '1', 'V': '1',
+	'C': '2', 'G': '2', 'J': '2', 'K': '2', 'Q': '2', 'S': '2', 'X': '2', 'Z': '2',
+	'D': '3', 'T': '3',
+	'L': '4',
+	'M': '5', 'N': '5',
+	'R': '6',
+}
+
+// soundex computes the American Soundex code: the first letter followed by
+// three digits for the consonant groups that follow, padded with zeros.
+// Adjacent letters with the same digit are coded once, even across H or W;
+// a vowel between them separates them.
+func soundex(word string) string {
+	code := []byte{word[0]}
+	last := soundexDigits[word[0]]
+	for i := 1; i < len(word) && len(code) < 4; i++ {
+		c := word[i]
+		if c == 'H' || c == 'W' {
+			continue
+		}
+		digit, ok := soundexDigits[c]
+		if !ok {
+			last = 0 // vowels separate repeated digits
+			continue
+		}
+		if digit != last {
+			code = append(code, digit)
+		}
+		last = digit
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
+
+func isMetaphoneVowel(c byte) bool {
+	return strings.IndexByte("AEIOU", c) >= 0
+}
+
+// metaphone computes Lawrence Philips' original Metaphone code. Vowels are
+// kept only as the first letter, and "0" stands for the "th" sound.
+func metaphone(word string) string {
+	prefix := word[:min(2, len(word))]
+	switch {
+	case prefix == "AE" || prefix == "GN" || prefix == "KN" || prefix == "PN" || prefix == "WR":
+		word = word[1:]
+	case word[0] == 'X':
+		word = "S" + word[1:]
+	case strings.HasPrefix(word, "WH"):
+		word = "W" + word[2:]
+	}
+
+	at := func(i int) byte {
+		if i < 0 || i >= len(word) {
+			return 0
+		}
+		return word[i]
+	}
+	next := func(i int, s string) bool { return strings.HasPrefix(word[i+1:], s) }
+	frontVowel := func(c byte) bool { return c == 'E' || c == 'I' || c == 'Y' }
+
+	var code strings.Builder
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		if c == at(i-1) && c != 'C' {
+			continue
+		}
+
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if i == 0 {
+				code.WriteByte(c)
+			}
+		case 'B':
+			if !(at(i-1) == 'M' && i == len(word)-1) {
+				code.WriteByte('B')
+			}
+		case 'C':
+			switch {
+			case next(i, "IA") || (at(i+1) == 'H' && at(i-1) != 'S'):
+				code.WriteByte('X')
+			case frontVowel(at(i + 1)):
+				if at(i-1) != 'S' {
+					code.WriteByte('S')
+				}
+			default:
+				code.WriteByte('K')
+			}
+		case 'D':
+			if at(i+1) == 'G' && frontVowel(at(i+2)) {
+				code.WriteByte('J')
+			} else {
+				code.WriteByte('T')
+			}
+		case 'G':
+			switch {
+			case at(i+1) == 'H' && i+2 < len(word) && !isMetaphoneVowel(at(i+2)):
+				// Silent as in "night".
+			case at(i+1) == 'N' && (i+2 == len(word) || (next(i, "NED") && i+4 == len(word))):
+				// Silent as in "sign" and "signed".
+			case at(i-1) == 'D' && frontVowel(at(i+1)):
+				// Already coded as J by the D in "edge".
+			case frontVowel(at(i+1)) && at(i-1) != 'G':
+				code.WriteByte('J')
+			default:
+				code.WriteByte('K')
+			}
+		case 'H':
+			if strings.IndexByte("CSPTG", at(i-1)) >= 0 {
+				break
+			}
+			if isMetaphoneVowel(at(i-1)) && !isMetaphoneVowel(at(i+1)) {
+				break
+			}
+			code.WriteByte('H')
+		case 'K':
+			if at(i-1) != 'C' {
+				code.WriteByte('K')
+			}
+		case 'P':
+			if at(i+1) == 'H' {
+				code.WriteByte('F')
+			} else {
+				code.WriteByte('P')
+			}
+		case 'Q':
+			code.WriteByte('K')
+		case 'S':
+			if at(i+1) == 'H' || next(i, "IO") || next(i, "IA") {
+				code.WriteByte('X')
+			} else {
+				code.WriteByte('S')
+			}
+		case 'T':
+			switch {
+			case next(i, "IA") || next(i, "IO"):
+				code.WriteByte('X')
+			case at(i+1) == 'H':
+				code.WriteByte('0')
+			case next(i, "CH"):
+				// Silent as in "watch".
+			default:
+				code.WriteByte('T')
+			}
+		case 'V':
+			code.WriteByte('F')
+		case 'W', 'Y':
+			if isMetaphoneVowel(at(i + 1)) {
+				code.WriteByte(c)
+			}
+		case 'X':
+			code.WriteString("KS")
+		case 'Z':
+			code.WriteByte('S')
+		default: // F, J, L, M, N, R
+			code.WriteByte(c)
+		}
+	}
+	return code.String()
+}
+
+func handlePhoneticCode(ctx context.Context, req *mcp.CallToolRequest, args PhoneticCodeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("phonetic_code called: %q algorithm=%q", args.Word, args.Algorithm))
+
+	word, err := normalizePhoneticInput(args.Word)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid word: %v", err)), nil, nil
+	}
+
+	algorithm := strings.ToLower(args.Algorithm)
+	var code string
+	switch algorithm {
+	case "", "soundex":
+		algorithm = "soundex"
+		code = soundex(word)
+	case "metaphone":
+		code = metaphone(word)
+	default:
+		return errorResult(fmt.Sprintf("Unsupported algorithm: %s (use soundex or metaphone)", args.Algorithm)), nil, nil
+	}
+
+	return textResult(code), map[string]any{"code": code, "algorithm": algorithm}, nil
+}
@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestPhoneticCode(t *testing.T) {
+	runToolCases(t, handlePhoneticCode, []toolCase[PhoneticCodeArgs]{
+		{name: "soundex robert", args: PhoneticCodeArgs{Word: "Robert"}, text: "R163", out: `{"code":"R163","algorithm":"soundex"}`},
+		{name: "soundex rupert", args: PhoneticCodeArgs{Word: "Rupert", Algorithm: "soundex"}, text: "R163", out: `{"code":"R163","algorithm":"soundex"}`},
+		{name: "soundex h between same codes", args: PhoneticCodeArgs{Word: "Ashcraft"}, text: "A261"},
+		{name: "soundex vowel separates codes", args: PhoneticCodeArgs{Word: "Tymczak"}, text: "T522"},
+		{name: "soundex first letter code dropped", args: PhoneticCodeArgs{Word: "Pfister"}, text: "P236"},
+		{name: "soundex ignores punctuation", args: PhoneticCodeArgs{Word: "O'Brien"}, text: "O165"},
+		{name: "metaphone th", args: PhoneticCodeArgs{Word: "Smith", Algorithm: "metaphone"}, text: "SM0", out: `{"code":"SM0","algorithm":"metaphone"}`},
+		{name: "metaphone ph", args: PhoneticCodeArgs{Word: "Philip", Algorithm: "metaphone"}, text: "FLP"},
+		{name: "metaphone silent letters", args: PhoneticCodeArgs{Word: "Knight", Algorithm: "Metaphone"}, text: "NT", out: `{"algorithm":"metaphone"}`},
+		{name: "metaphone initial x", args: PhoneticCodeArgs{Word: "Xavier", Algorithm: "metaphone"}, text: "SFR"},
+		{name: "metaphone soft c", args: PhoneticCodeArgs{Word: "Science", Algorithm: "metaphone"}, text: "SNS"},
+		{name: "digit", args: PhoneticCodeArgs{Word: "Jose2"}, err: true, text: "Invalid word: word must contain only letters A-Z, found '2'"},
+		{name: "empty", args: PhoneticCodeArgs{}, err: true, text: "Invalid word: word must contain at least one letter"},
+		{name: "punctuation only", args: PhoneticCodeArgs{Word: "--"}, err: true, text: "Invalid word: word must contain at least one letter"},
+		{name: "unknown algorithm", args: PhoneticCodeArgs{Word: "Lee", Algorithm: "nysiis"}, err: true, text: "Unsupported algorithm: nysiis (use soundex or metaphone)"},
+	})
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ProportionsArgs struct {
+	Values                []float64 `json:"values" jsonschema:"The numbers to express as percentages of their total"`
+	Decimals              *int      `json:"decimals,omitempty" jsonschema:"Round percentages to this many decimals (0-10) so that they sum to exactly 100, using the largest-remainder method"`
+	AllowNonPositiveTotal bool      `json:"allow_nonpositive_total,omitempty" jsonschema:"Accept a zero or negative total instead of reporting an error; a zero total gives 0% for every value"`
+}
+
+// largestRemainder rounds percentages to the given number of decimals so that
+// they still sum to exactly 100. Every value is first rounded down, then the
+// units left over go to the values with the largest discarded remainders,
+// earlier values winning ties. All percentages must be non-negative.
+func largestRemainder(percentages []float64, decimals int) []float64 {
+	scale := math.Pow10(decimals)
+	units := make([]int64, len(percentages))
+	remainders := make([]float64, len(percentages))
+	left := int64(math.Round(100 * scale))
+	for i, p := range percentages {
+		scaled := p * scale
+		units[i] = int64(math.Floor(scaled))
+		remainders[i] = scaled - float64(units[i])
+		left -= units[i]
+	}
+
+	order := make([]int, len(percentages))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; left > 0 && i < len(order); i++ {
+		units[order[i]]++
+		left--
+	}
+
+	rounded := make([]float64, len(percentages))
+	for i, u := range units {
+		rounded[i] = float64(u) / scale
+	}
+	return rounded
+}
+
+func handleProportions(ctx context.Context, req *mcp.CallToolRequest, args ProportionsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("proportions called with %d values", len(args.Values)))
+
+	if len(args.Values) == 0 {
+		return errorResult("At least one value is required"), nil, nil
+	}
+
+	total := 0.0
+	hasNegative := false
+	for _, v := range args.Values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errorResult("Values must be finite numbers"), nil, nil
+		}
+		total += v
+		hasNegative = hasNegative || v < 0
+	}
+	if math.IsInf(total, 0) {
+		return errorResult("Total overflows float64"), nil, nil
+	}
+	if total <= 0 && !args.AllowNonPositiveTotal {
+		return errorResult(fmt.Sprintf("Total must be positive, got %g (set allow_nonpositive_total to accept it)", total)), nil, nil
+	}
+
+	percentages := make([]float64, len(args.Values))
+	if total != 0 {
+		for i, v := range args.Values {
+			percentages[i] = v / total * 100
+			if math.IsInf(percentages[i], 0) {
+				return errorResult(fmt.Sprintf("The share of %g overflows float64", v)), nil, nil
+			}
+		}
+	}
+
+	if args.Decimals != nil {
+		decimals := *args.Decimals
+		if decimals < 0 || decimals > 10 {
+			return errorResult("Decimals must be between 0 and 10"), nil, nil
+		}
+		if hasNegative || total <= 0 {
+			return errorResult("Rounding to a sum of 100 requires non-negative values with a positive total"), nil, nil
+		}
+		percentages = largestRemainder(percentages, decimals)
+	}
+
+	lines := make([]string, len(args.Values))
+	for i, v := range args.Values {
+		lines[i] = fmt.Sprintf("%g: %s%%", v, strconv.FormatFloat(percentages[i], 'f', -1, 64))
+	}
+
+	return textResult(fmt.Sprintf("Total: %g\n%s", total, strings.Join(lines, "\n"))),
+		map[string]any{
+			"total":       total,
+			"percentages": percentages,
+		}, nil
+}
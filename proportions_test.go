@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestProportions(t *testing.T) {
+	runToolCases(t, handleProportions, []toolCase[ProportionsArgs]{
+		{
+			name: "unrounded",
+			args: ProportionsArgs{Values: []float64{1, 3}},
+			text: "Total: 4\n1: 25%\n3: 75%",
+			out:  `{"total":4,"percentages":[25,75]}`,
+		},
+		{
+			name: "rounded thirds",
+			args: ProportionsArgs{Values: []float64{1, 1, 1}, Decimals: ptr(0)},
+			text: "Total: 3\n1: 34%\n1: 33%\n1: 33%",
+			out:  `{"total":3,"percentages":[34,33,33]}`,
+		},
+		{
+			name: "largest remainder wins",
+			args: ProportionsArgs{Values: []float64{1, 1, 4}, Decimals: ptr(0)},
+			out:  `{"percentages":[17,17,66]}`,
+		},
+		{
+			name: "one decimal",
+			args: ProportionsArgs{Values: []float64{2, 2, 2}, Decimals: ptr(1)},
+			out:  `{"percentages":[33.4,33.3,33.3]}`,
+		},
+		{
+			name: "zero total allowed",
+			args: ProportionsArgs{Values: []float64{0, 0}, AllowNonPositiveTotal: true},
+			out:  `{"total":0,"percentages":[0,0]}`,
+		},
+		{
+			name: "negative total allowed",
+			args: ProportionsArgs{Values: []float64{1, -3}, AllowNonPositiveTotal: true},
+			out:  `{"total":-2,"percentages":[-50,150]}`,
+		},
+		{name: "no values", args: ProportionsArgs{}, err: true, text: "At least one value is required"},
+		{name: "zero total", args: ProportionsArgs{Values: []float64{0, 0}}, err: true, text: "Total must be positive, got 0 (set allow_nonpositive_total to accept it)"},
+		{name: "negative total", args: ProportionsArgs{Values: []float64{1, -3}}, err: true, text: "Total must be positive, got -2 (set allow_nonpositive_total to accept it)"},
+		{name: "rounding negatives", args: ProportionsArgs{Values: []float64{-1, 3}, Decimals: ptr(0)}, err: true, text: "Rounding to a sum of 100 requires non-negative values with a positive total"},
+		{name: "decimals out of range", args: ProportionsArgs{Values: []float64{1}, Decimals: ptr(11)}, err: true, text: "Decimals must be between 0 and 10"},
+		{name: "infinite value", args: ProportionsArgs{Values: []float64{math.Inf(1)}}, err: true, text: "Values must be finite numbers"},
+		{name: "total overflow", args: ProportionsArgs{Values: []float64{1e308, 1e308}}, err: true, text: "Total overflows float64"},
+		{name: "share overflow", args: ProportionsArgs{Values: []float64{1e308, -1e308, 1e-10}, AllowNonPositiveTotal: true}, err: true, text: "The share of 1e+308 overflows float64"},
+	})
+}
+
+func TestProportionsSumTo100(t *testing.T) {
+	sets := [][]float64{
+		{1, 1, 1},
+		{1, 2, 3, 4, 5, 6, 7},
+		{0.1, 0.2, 0.7},
+		{13, 17, 19, 23, 29},
+		{1, 0, 0, 998},
+		{5},
+	}
+	for _, values := range sets {
+		for decimals := 0; decimals <= 3; decimals++ {
+			result, out := callTool(t, handleProportions, ProportionsArgs{Values: values, Decimals: ptr(decimals)})
+			if result.IsError {
+				t.Fatalf("%v: %s", values, resultText(result))
+			}
+			scale := math.Pow10(decimals)
+			var units int64
+			for _, p := range out.(map[string]any)["percentages"].([]any) {
+				f, err := p.(json.Number).Float64()
+				if err != nil {
+					t.Fatal(err)
+				}
+				scaled := f * scale
+				if math.Abs(scaled-math.Round(scaled)) > 1e-6 {
+					t.Errorf("%v with %d decimals: %g has too many decimals", values, decimals, f)
+				}
+				units += int64(math.Round(scaled))
+			}
+			if want := int64(100 * scale); units != want {
+				t.Errorf("%v with %d decimals: percentages sum to %g, want 100", values, decimals, float64(units)/scale)
+			}
+		}
+	}
+}
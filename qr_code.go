@@ -0,0 +1,562 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type QRCodeArgs struct {
+	Text            string `json:"text" jsonschema:"The text to encode"`
+	ErrorCorrection string `json:"error_correction,omitempty" jsonschema:"Error correction level (L, M, Q, or H). Defaults to M"`
+	Render          string `json:"render,omitempty" jsonschema:"Output style (unicode for half-block characters or ascii for ## pairs). Defaults to unicode"`
+}
+
+// qrECLevel describes one of the four QR error correction levels: its index
+// into the capacity tables and the two-bit value written into format info.
+type qrECLevel struct {
+	Ordinal    int
+	FormatBits int
+}
+
+var qrECLevels = map[string]qrECLevel{
+	"L": {Ordinal: 0, FormatBits: 1},
+	"M": {Ordinal: 1, FormatBits: 0},
+	"Q": {Ordinal: 2, FormatBits: 3},
+	"H": {Ordinal: 3, FormatBits: 2},
+}
+
+// qrECCCodewordsPerBlock and qrNumECCBlocks are indexed by [level][version]
+// and come from ISO/IEC 18004 Table 9. Index 0 is unused.
+var qrECCCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+var qrNumECCBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+const qrQuietZone = 4
+
+// qrRawDataModules returns the number of modules available for data and
+// error correction in a symbol of the given version, after all function
+// patterns are excluded.
+func qrRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+func qrDataCodewords(version int, ec qrECLevel) int {
+	return qrRawDataModules(version)/8 - qrECCCodewordsPerBlock[ec.Ordinal][version]*qrNumECCBlocks[ec.Ordinal][version]
+}
+
+// qrByteCapacity returns how many bytes fit in byte mode at the given version.
+func qrByteCapacity(version int, ec qrECLevel) int {
+	countBits := 8
+	if version >= 10 {
+		countBits = 16
+	}
+	return (qrDataCodewords(version, ec)*8 - 4 - countBits) / 8
+}
+
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	numAlign := version/7 + 2
+	size := version*4 + 17
+	step := (version*4 + numAlign*2 + 1) / (numAlign*2 - 2) * 2
+	if version == 32 {
+		step = 26
+	}
+
+	positions := make([]int, numAlign)
+	positions[0] = 6
+	for i, pos := numAlign-1, size-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// gfMultiply multiplies two elements of GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range divisor {
+			result[i] ^= gfMultiply(coef, factor)
+		}
+	}
+	return result
+}
+
+type qrSymbol struct {
+	version    int
+	size       int
+	mask       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+func (q *qrSymbol) setFunction(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.isFunction[y][x] = true
+}
+
+func (q *qrSymbol) drawFunctionPatterns(ec qrECLevel) {
+	for i := 0; i < q.size; i++ {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+
+	for _, c := range [][2]int{{3, 3}, {q.size - 4, 3}, {3, q.size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := c[0]+dx, c[1]+dy
+				if x < 0 || x >= q.size || y < 0 || y >= q.size {
+					continue
+				}
+				dist := max(abs(dx), abs(dy))
+				q.setFunction(x, y, dist != 2 && dist != 4)
+			}
+		}
+	}
+
+	positions := qrAlignmentPositions(q.version)
+	last := len(positions) - 1
+	for i, px := range positions {
+		for j, py := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					q.setFunction(px+dx, py+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	q.drawFormatBits(ec, 0)
+	q.drawVersion()
+}
+
+func (q *qrSymbol) drawFormatBits(ec qrECLevel, mask int) {
+	data := ec.FormatBits<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool { return (bits>>uint(i))&1 != 0 }
+
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(i))
+	}
+	q.setFunction(8, 7, bit(6))
+	q.setFunction(8, 8, bit(7))
+	q.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.setFunction(q.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, q.size-15+i, bit(i))
+	}
+	q.setFunction(8, q.size-8, true)
+}
+
+func (q *qrSymbol) drawVersion() {
+	if q.version < 7 {
+		return
+	}
+	rem := q.version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := q.version<<12 | rem
+	for i := 0; i < 18; i++ {
+		dark := (bits>>uint(i))&1 != 0
+		a, b := q.size-11+i%3, i/3
+		q.setFunction(a, b, dark)
+		q.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords places the interleaved codewords in the zigzag order defined
+// by the standard, skipping function modules.
+func (q *qrSymbol) drawCodewords(data []byte) {
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < q.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = q.size - 1 - vert
+				}
+				if !q.isFunction[y][x] && i < len(data)*8 {
+					q.modules[y][x] = (data[i>>3]>>uint(7-(i&7)))&1 != 0
+					i++
+				}
+			}
+		}
+	}
+}
+
+func qrMaskBit(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask XORs the mask pattern over all data modules. Applying the same
+// mask twice restores the original symbol.
+func (q *qrSymbol) applyMask(mask int) {
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if !q.isFunction[y][x] && qrMaskBit(mask, x, y) {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the symbol using the four rules from ISO/IEC 18004 §7.8.3;
+// the mask with the lowest score is the one that gets used.
+func (q *qrSymbol) penalty() int {
+	n := q.size
+	score := 0
+	at := func(x, y int, transpose bool) bool {
+		if transpose {
+			return q.modules[x][y]
+		}
+		return q.modules[y][x]
+	}
+
+	finderA := []bool{true, false, true, true, true, false, true, false, false, false, false}
+	finderB := []bool{false, false, false, false, true, false, true, true, true, false, true}
+
+	for _, transpose := range []bool{false, true} {
+		for y := 0; y < n; y++ {
+			run := 1
+			for x := 1; x < n; x++ {
+				if at(x, y, transpose) == at(x-1, y, transpose) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += 3 + run - 5
+				}
+				run = 1
+			}
+			if run >= 5 {
+				score += 3 + run - 5
+			}
+
+			for x := 0; x+len(finderA) <= n; x++ {
+				matchA, matchB := true, true
+				for k := range finderA {
+					v := at(x+k, y, transpose)
+					matchA = matchA && v == finderA[k]
+					matchB = matchB && v == finderB[k]
+				}
+				if matchA {
+					score += 40
+				}
+				if matchB {
+					score += 40
+				}
+			}
+		}
+	}
+
+	dark := 0
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if q.modules[y][x] {
+				dark++
+			}
+			if x+1 < n && y+1 < n {
+				c := q.modules[y][x]
+				if c == q.modules[y][x+1] && c == q.modules[y+1][x] && c == q.modules[y+1][x+1] {
+					score += 3
+				}
+			}
+		}
+	}
+
+	total := n * n
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	score += k * 10
+
+	return score
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// encodeQR encodes data in byte mode using the smallest version that fits at
+// the given error correction level.
+func encodeQR(data []byte, ec qrECLevel) (*qrSymbol, error) {
+	version := 0
+	for v := 1; v <= 40; v++ {
+		if len(data) <= qrByteCapacity(v, ec) {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("input is %d bytes; the maximum at this error correction level is %d bytes", len(data), qrByteCapacity(40, ec))
+	}
+
+	countBits := 8
+	if version >= 10 {
+		countBits = 16
+	}
+	capacityBits := qrDataCodewords(version, ec) * 8
+
+	var bits []bool
+	appendBits := func(val, n int) {
+		for i := n - 1; i >= 0; i-- {
+			bits = append(bits, (val>>uint(i))&1 != 0)
+		}
+	}
+	appendBits(0b0100, 4)
+	appendBits(len(data), countBits)
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+	appendBits(0, min(4, capacityBits-len(bits)))
+	appendBits(0, (8-len(bits)%8)%8)
+
+	codewords := make([]byte, len(bits)/8, capacityBits/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i>>3] |= 1 << uint(7-(i&7))
+		}
+	}
+	for pad := byte(0xEC); len(codewords) < capacityBits/8; pad ^= 0xEC ^ 0x11 {
+		codewords = append(codewords, pad)
+	}
+
+	numBlocks := qrNumECCBlocks[ec.Ordinal][version]
+	eccLen := qrECCCodewordsPerBlock[ec.Ordinal][version]
+	rawCodewords := qrRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	divisor := rsDivisor(eccLen)
+	blocks := make([][]byte, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		n := shortBlockLen - eccLen
+		if i >= numShortBlocks {
+			n++
+		}
+		block := append([]byte{}, codewords[k:k+n]...)
+		k += n
+		ecc := rsRemainder(block, divisor)
+		if i < numShortBlocks {
+			block = append(block, 0)
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	interleaved := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortBlockLen-eccLen || j >= numShortBlocks {
+				interleaved = append(interleaved, block[i])
+			}
+		}
+	}
+
+	size := version*4 + 17
+	q := &qrSymbol{version: version, size: size}
+	q.modules = make([][]bool, size)
+	q.isFunction = make([][]bool, size)
+	for i := range q.modules {
+		q.modules[i] = make([]bool, size)
+		q.isFunction[i] = make([]bool, size)
+	}
+
+	q.drawFunctionPatterns(ec)
+	q.drawCodewords(interleaved)
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormatBits(ec, mask)
+		if p := q.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		q.applyMask(mask)
+	}
+	q.mask = best
+	q.applyMask(best)
+	q.drawFormatBits(ec, best)
+
+	return q, nil
+}
+
+// dark reports whether the module at (x, y) is dark, treating the quiet zone
+// around the symbol as light.
+func (q *qrSymbol) dark(x, y int) bool {
+	return x >= 0 && y >= 0 && x < q.size && y < q.size && q.modules[y][x]
+}
+
+// renderUnicode draws two module rows per line using half-block characters.
+func (q *qrSymbol) renderUnicode() string {
+	var b strings.Builder
+	for y := -qrQuietZone; y < q.size+qrQuietZone; y += 2 {
+		for x := -qrQuietZone; x < q.size+qrQuietZone; x++ {
+			top, bottom := q.dark(x, y), q.dark(x, y+1)
+			switch {
+			case top && bottom:
+				b.WriteString("█")
+			case top:
+				b.WriteString("▀")
+			case bottom:
+				b.WriteString("▄")
+			default:
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (q *qrSymbol) renderASCII() string {
+	var b strings.Builder
+	for y := -qrQuietZone; y < q.size+qrQuietZone; y++ {
+		for x := -qrQuietZone; x < q.size+qrQuietZone; x++ {
+			if q.dark(x, y) {
+				b.WriteString("##")
+			} else {
+				b.WriteString("  ")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func handleQRCode(ctx context.Context, req *mcp.CallToolRequest, args QRCodeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("qr_code called with text length: %d, error correction: %q", len(args.Text), args.ErrorCorrection))
+
+	level := strings.ToUpper(args.ErrorCorrection)
+	if level == "" {
+		level = "M"
+	}
+	ec, ok := qrECLevels[level]
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported error correction level: %s", args.ErrorCorrection)), nil, nil
+	}
+
+	render := args.Render
+	if render == "" {
+		render = "unicode"
+	}
+	if render != "unicode" && render != "ascii" {
+		return errorResult(fmt.Sprintf("Unsupported render style: %s", args.Render)), nil, nil
+	}
+
+	q, err := encodeQR([]byte(args.Text), ec)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Input too long for error correction level %s: %v", level, err)), nil, nil
+	}
+
+	matrix := make([]string, q.size)
+	for y, row := range q.modules {
+		var b strings.Builder
+		for _, dark := range row {
+			if dark {
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		}
+		matrix[y] = b.String()
+	}
+
+	text := q.renderUnicode()
+	if render == "ascii" {
+		text = q.renderASCII()
+	}
+
+	return textResult(text), map[string]any{
+		"version":          q.version,
+		"size":             q.size,
+		"error_correction": level,
+		"mask":             q.mask,
+		"matrix":           matrix,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// qrMatrix returns the module rows of a qr_code result.
+func qrMatrix(t *testing.T, out any) []string {
+	t.Helper()
+	rows := out.(map[string]any)["matrix"].([]any)
+	matrix := make([]string, len(rows))
+	for i, row := range rows {
+		matrix[i] = row.(string)
+	}
+	return matrix
+}
+
+// decodeVersion1 reads the byte-mode payload of a version 1 symbol, which
+// has a single block of data codewords, written independently of the
+// encoder from the placement and masking rules of ISO/IEC 18004.
+func decodeVersion1(t *testing.T, matrix []string) (format int, payload string) {
+	t.Helper()
+	dark := func(x, y int) bool { return matrix[y][x] == '1' }
+
+	// Format information, first copy, least significant bit first.
+	var bits int
+	for i := 0; i < 15; i++ {
+		var x, y int
+		switch {
+		case i <= 5:
+			x, y = 8, i
+		case i == 6:
+			x, y = 8, 7
+		case i == 7:
+			x, y = 8, 8
+		case i == 8:
+			x, y = 7, 8
+		default:
+			x, y = 14-i, 8
+		}
+		if dark(x, y) {
+			bits |= 1 << i
+		}
+	}
+	format = (bits ^ 0x5412) >> 10
+	mask := format & 7
+
+	masks := []func(x, y int) bool{
+		func(x, y int) bool { return (x+y)%2 == 0 },
+		func(x, y int) bool { return y%2 == 0 },
+		func(x, y int) bool { return x%3 == 0 },
+		func(x, y int) bool { return (x+y)%3 == 0 },
+		func(x, y int) bool { return (x/3+y/2)%2 == 0 },
+		func(x, y int) bool { return x*y%2+x*y%3 == 0 },
+		func(x, y int) bool { return (x*y%2+x*y%3)%2 == 0 },
+		func(x, y int) bool { return ((x+y)%2+x*y%3)%2 == 0 },
+	}
+	function := func(x, y int) bool {
+		return x < 9 && y < 9 || x >= 13 && y < 9 || x < 9 && y >= 13 || x == 6 || y == 6
+	}
+
+	var stream []bool
+	for right := 20; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < 21; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = 20 - vert
+				}
+				if !function(x, y) {
+					stream = append(stream, dark(x, y) != masks[mask](x, y))
+				}
+			}
+		}
+	}
+	if len(stream) != 208 {
+		t.Fatalf("read %d data modules, want 208", len(stream))
+	}
+
+	read := func(pos, n int) int {
+		v := 0
+		for _, b := range stream[pos : pos+n] {
+			v <<= 1
+			if b {
+				v |= 1
+			}
+		}
+		return v
+	}
+	if m := read(0, 4); m != 0b0100 {
+		t.Fatalf("mode indicator = %04b, want byte mode 0100", m)
+	}
+	length := read(4, 8)
+	var b strings.Builder
+	for i := 0; i < length; i++ {
+		b.WriteByte(byte(read(12+8*i, 8)))
+	}
+	return format, b.String()
+}
+
+func TestQRCode(t *testing.T) {
+	runToolCases(t, handleQRCode, []toolCase[QRCodeArgs]{
+		{name: "short text", args: QRCodeArgs{Text: "hello"}, out: `{"version":1,"size":21,"error_correction":"M"}`},
+		{name: "longer text", args: QRCodeArgs{Text: "https://example.com/some/long/path"}, out: `{"version":3,"size":29}`},
+		{name: "high correction", args: QRCodeArgs{Text: "hello", ErrorCorrection: "h"}, out: `{"version":1,"error_correction":"H"}`},
+		{name: "too long", args: QRCodeArgs{Text: strings.Repeat("x", 1300), ErrorCorrection: "H"}, err: true, contains: []string{"Input too long for error correction level H"}},
+		{name: "unknown level", args: QRCodeArgs{Text: "x", ErrorCorrection: "Z"}, err: true, text: "Unsupported error correction level: Z"},
+		{name: "unknown render", args: QRCodeArgs{Text: "x", Render: "svg"}, err: true, text: "Unsupported render style: svg"},
+	})
+}
+
+func TestQRCodeDecodes(t *testing.T) {
+	for _, level := range []string{"L", "M", "Q", "H"} {
+		_, out := callTool(t, handleQRCode, QRCodeArgs{Text: "hello", ErrorCorrection: level})
+		format, payload := decodeVersion1(t, qrMatrix(t, out))
+		if payload != "hello" {
+			t.Errorf("level %s: decoded %q, want %q", level, payload, "hello")
+		}
+		if want := qrECLevels[level].FormatBits<<3 | int(numberField(t, out, "mask")); format != want {
+			t.Errorf("level %s: format bits %05b, want %05b", level, format, want)
+		}
+	}
+}
+
+func TestQRCodeStable(t *testing.T) {
+	result, out := callTool(t, handleQRCode, QRCodeArgs{Text: "stable input"})
+	again, outAgain := callTool(t, handleQRCode, QRCodeArgs{Text: "stable input"})
+	if resultText(result) != resultText(again) || compactJSON(out) != compactJSON(outAgain) {
+		t.Error("the same input produced different symbols")
+	}
+
+	matrix := qrMatrix(t, out)
+	size := int(numberField(t, out, "size"))
+	if len(matrix) != size {
+		t.Fatalf("matrix has %d rows, want %d", len(matrix), size)
+	}
+	finder := []string{"1111111", "1000001", "1011101", "1011101", "1011101", "1000001", "1111111"}
+	for _, corner := range [][2]int{{0, 0}, {size - 7, 0}, {0, size - 7}} {
+		for dy, want := range finder {
+			if got := matrix[corner[1]+dy][corner[0] : corner[0]+7]; got != want {
+				t.Errorf("finder at %v row %d = %s, want %s", corner, dy, got, want)
+			}
+		}
+	}
+	for i := 8; i < size-8; i++ {
+		want := byte('0')
+		if i%2 == 0 {
+			want = '1'
+		}
+		if matrix[6][i] != want || matrix[i][6] != want {
+			t.Errorf("timing pattern broken at %d", i)
+		}
+	}
+
+	ascii, _ := callTool(t, handleQRCode, QRCodeArgs{Text: "stable input", Render: "ascii"})
+	lines := strings.Split(strings.TrimSuffix(resultText(ascii), "\n"), "\n")
+	if width := 2 * (size + 2*qrQuietZone); len(lines) != size+2*qrQuietZone || len(lines[0]) != width {
+		t.Errorf("ascii render is %d lines of %d columns, want %d of %d", len(lines), len(lines[0]), size+2*qrQuietZone, width)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type RedactArgs struct {
+	Text       string   `json:"text" jsonschema:"The text to redact"`
+	Categories []string `json:"categories,omitempty" jsonschema:"Categories to redact (email, card, phone). Defaults to all"`
+	MaskChar   string   `json:"mask_char,omitempty" jsonschema:"Single character used for masking (default *)"`
+	KeepLast   int      `json:"keep_last,omitempty" jsonschema:"Number of trailing characters of each match to leave visible"`
+}
+
+// redactPatterns are applied in order. Card numbers run before phone numbers
+// so that long digit runs are not partially consumed by the phone pattern.
+var redactPatterns = []struct {
+	Category string
+	Pattern  *regexp.Regexp
+}{
+	{"email", regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{"card", regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)},
+	{"phone", regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{2,4}\)[ .-]?|\b\d{2,4}[ .-])?\d{3,4}[ .-]\d{4}\b`)},
+}
+
+// maskString replaces every character of s with mask except the last keep.
+func maskString(s string, mask string, keep int) string {
+	n := utf8.RuneCountInString(s)
+	if keep >= n {
+		return s
+	}
+
+	var b strings.Builder
+	i := 0
+	for _, r := range s {
+		if i < n-keep {
+			b.WriteString(mask)
+		} else {
+			b.WriteRune(r)
+		}
+		i++
+	}
+	return b.String()
+}
+
+func handleRedact(ctx context.Context, req *mcp.CallToolRequest, args RedactArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("redact called with text length: %d, categories: %v", len(args.Text), args.Categories))
+
+	mask := args.MaskChar
+	if mask == "" {
+		mask = "*"
+	}
+	if utf8.RuneCountInString(mask) != 1 {
+		return errorResult("Mask character must be a single character"), nil, nil
+	}
+	if args.KeepLast < 0 {
+		return errorResult("keep_last must not be negative"), nil, nil
+	}
+
+	selected := map[string]bool{}
+	for _, c := range args.Categories {
+		selected[strings.ToLower(strings.TrimSpace(c))] = true
+	}
+	for c := range selected {
+		known := false
+		for _, p := range redactPatterns {
+			if p.Category == c {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return errorResult(fmt.Sprintf("Unknown category: %s", c)), nil, nil
+		}
+	}
+
+	redacted := args.Text
+	counts := map[string]int{}
+	for _, p := range redactPatterns {
+		if len(selected) > 0 && !selected[p.Category] {
+			continue
+		}
+		counts[p.Category] = 0
+		redacted = p.Pattern.ReplaceAllStringFunc(redacted, func(match string) string {
+			counts[p.Category]++
+			return maskString(match, mask, args.KeepLast)
+		})
+	}
+
+	return textResult(redacted), map[string]any{
+		"text":   redacted,
+		"counts": counts,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	const text = "Mail jane.doe@example.com, card 4111 1111 1111 1111, call 555-123-4567."
+	runToolCases(t, handleRedact, []toolCase[RedactArgs]{
+		{
+			name: "all categories",
+			args: RedactArgs{Text: text},
+			text: "Mail " + strings.Repeat("*", 20) + ", card " + strings.Repeat("*", 19) + ", call " + strings.Repeat("*", 12) + ".",
+			out:  `{"counts":{"email":1,"card":1,"phone":1}}`,
+		},
+		{
+			name: "keep last",
+			args: RedactArgs{Text: "card 4111-1111-1111-1111", KeepLast: 4, MaskChar: "#"},
+			text: "card ###############1111",
+			out:  `{"text":"card ###############1111","counts":{"email":0,"card":1,"phone":0}}`,
+		},
+		{
+			name: "email only",
+			args: RedactArgs{Text: text, Categories: []string{" Email "}},
+			text: "Mail " + strings.Repeat("*", 20) + ", card 4111 1111 1111 1111, call 555-123-4567.",
+			out:  `{"counts":{"email":1}}`,
+		},
+		{
+			name: "ordinary text intact",
+			args: RedactArgs{Text: "Meet at 10:30 in room 42, bring 3 copies."},
+			text: "Meet at 10:30 in room 42, bring 3 copies.",
+			out:  `{"counts":{"email":0,"card":0,"phone":0}}`,
+		},
+		{name: "unknown category", args: RedactArgs{Text: text, Categories: []string{"ssn"}}, err: true, text: "Unknown category: ssn"},
+		{name: "long mask", args: RedactArgs{Text: text, MaskChar: "**"}, err: true, text: "Mask character must be a single character"},
+		{name: "negative keep", args: RedactArgs{Text: text, KeepLast: -1}, err: true, text: "keep_last must not be negative"},
+	})
+}
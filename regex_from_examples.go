@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type RegexFromExamplesArgs struct {
+	Examples []string `json:"examples" jsonschema:"Example strings the pattern should match (1-100)"`
+}
+
+// exampleToken is a run of digits or letters, or a single other character
+// kept literally.
+type exampleToken struct {
+	class  string // "digit", "letter", or "literal"
+	text   string
+	length int
+
+	hasUpper, hasLower, nonASCII bool
+}
+
+func tokenizeExample(s string) []exampleToken {
+	var tokens []exampleToken
+	for _, r := range s {
+		class := "literal"
+		switch {
+		case r >= '0' && r <= '9':
+			class = "digit"
+		case unicode.IsLetter(r):
+			class = "letter"
+		}
+		if class != "literal" && len(tokens) > 0 && tokens[len(tokens)-1].class == class {
+			t := &tokens[len(tokens)-1]
+			t.text += string(r)
+			t.length++
+		} else {
+			tokens = append(tokens, exampleToken{class: class, text: string(r), length: 1})
+		}
+		t := &tokens[len(tokens)-1]
+		t.hasUpper = t.hasUpper || unicode.IsUpper(r)
+		t.hasLower = t.hasLower || unicode.IsLower(r)
+		t.nonASCII = t.nonASCII || r >= utf8.RuneSelf
+	}
+	return tokens
+}
+
+// sameShape reports whether two token lists have the same classes in the
+// same order with identical literal characters.
+func sameShape(a, b []exampleToken) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].class != b[i].class || (a[i].class == "literal" && a[i].text != b[i].text) {
+			return false
+		}
+	}
+	return true
+}
+
+// maxRepeatCount is the largest count RE2 accepts in a {n,m} repetition.
+const maxRepeatCount = 1000
+
+// quantifier repeats a token between lo and hi times, falling back to an
+// open-ended repetition when hi is beyond what RE2 accepts.
+func quantifier(lo, hi int) string {
+	switch {
+	case hi > maxRepeatCount && lo == 0:
+		return "*"
+	case hi > maxRepeatCount:
+		return "+"
+	case lo == hi && lo == 1:
+		return ""
+	case lo == hi:
+		return fmt.Sprintf("{%d}", lo)
+	default:
+		return fmt.Sprintf("{%d,%d}", lo, hi)
+	}
+}
+
+// shapePattern builds a pattern from examples that all share one token
+// shape, widening each run's length and letter case to cover every example.
+func shapePattern(tokenized [][]exampleToken) string {
+	var b strings.Builder
+	for i, first := range tokenized[0] {
+		if first.class == "literal" {
+			b.WriteString(regexp.QuoteMeta(first.text))
+			continue
+		}
+		lo, hi := first.length, first.length
+		var upper, lower, nonASCII bool
+		for _, tokens := range tokenized {
+			t := tokens[i]
+			lo, hi = min(lo, t.length), max(hi, t.length)
+			upper, lower, nonASCII = upper || t.hasUpper, lower || t.hasLower, nonASCII || t.nonASCII
+		}
+		switch {
+		case first.class == "digit":
+			b.WriteString(`\d`)
+		case nonASCII:
+			b.WriteString(`\p{L}`)
+		case upper && !lower:
+			b.WriteString("[A-Z]")
+		case lower && !upper:
+			b.WriteString("[a-z]")
+		default:
+			b.WriteString("[A-Za-z]")
+		}
+		b.WriteString(quantifier(lo, hi))
+	}
+	return b.String()
+}
+
+// affixPattern falls back to the examples' longest common prefix and suffix
+// with a wildcard of the right length range in between, reporting whether the
+// wildcard was needed.
+func affixPattern(examples []string) (string, bool) {
+	runes := make([][]rune, len(examples))
+	shortest := -1
+	for i, e := range examples {
+		runes[i] = []rune(e)
+		if shortest < 0 || len(runes[i]) < shortest {
+			shortest = len(runes[i])
+		}
+	}
+
+	prefix := 0
+	for prefix < shortest && allEqualAt(runes, func(r []rune) rune { return r[prefix] }) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < shortest-prefix && allEqualAt(runes, func(r []rune) rune { return r[len(r)-1-suffix] }) {
+		suffix++
+	}
+
+	lo, hi := -1, 0
+	for _, r := range runes {
+		middle := len(r) - prefix - suffix
+		if lo < 0 || middle < lo {
+			lo = middle
+		}
+		hi = max(hi, middle)
+	}
+
+	middle := ""
+	if hi > 0 {
+		middle = "." + quantifier(lo, hi)
+	}
+	first := runes[0]
+	return regexp.QuoteMeta(string(first[:prefix])) + middle + regexp.QuoteMeta(string(first[len(first)-suffix:])), middle != ""
+}
+
+func allEqualAt(runes [][]rune, at func([]rune) rune) bool {
+	want := at(runes[0])
+	for _, r := range runes[1:] {
+		if at(r) != want {
+			return false
+		}
+	}
+	return true
+}
+
+func handleRegexFromExamples(ctx context.Context, req *mcp.CallToolRequest, args RegexFromExamplesArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("regex_from_examples called with %d examples", len(args.Examples)))
+
+	if len(args.Examples) == 0 || len(args.Examples) > 100 {
+		return errorResult("Provide between 1 and 100 examples"), nil, nil
+	}
+
+	tokenized := make([][]exampleToken, len(args.Examples))
+	uniform := true
+	for i, e := range args.Examples {
+		tokenized[i] = tokenizeExample(e)
+		uniform = uniform && sameShape(tokenized[0], tokenized[i])
+	}
+
+	strategy := "shape"
+	pattern := ""
+	if uniform {
+		pattern = "^" + shapePattern(tokenized) + "$"
+	} else {
+		strategy = "affix"
+		body, wildcard := affixPattern(args.Examples)
+		pattern = "^" + body + "$"
+		if wildcard {
+			// Let the wildcard cover newlines in multi-line examples too.
+			pattern = "(?s)" + pattern
+		}
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Generated an invalid pattern %q: %v", pattern, err)), nil, nil
+	}
+	unmatched := []string{}
+	for _, e := range args.Examples {
+		if !re.MatchString(e) {
+			unmatched = append(unmatched, e)
+		}
+	}
+
+	return textResult(pattern), map[string]any{
+		"pattern":     pattern,
+		"strategy":    strategy,
+		"matched_all": len(unmatched) == 0,
+		"unmatched":   unmatched,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestRegexFromExamples(t *testing.T) {
+	runToolCases(t, handleRegexFromExamples, []toolCase[RegexFromExamplesArgs]{
+		{
+			name: "phone numbers",
+			args: RegexFromExamplesArgs{Examples: []string{"555-123-4567", "555-987-6543"}},
+			text: `^\d{3}-\d{3}-\d{4}$`,
+			out:  `{"pattern":"^\\d{3}-\\d{3}-\\d{4}$","strategy":"shape","matched_all":true,"unmatched":[]}`,
+		},
+		{
+			name: "phone numbers with area code",
+			args: RegexFromExamplesArgs{Examples: []string{"(555) 123-4567", "(212) 555-0100"}},
+			text: `^\(\d{3}\) \d{3}-\d{4}$`,
+		},
+		{
+			name: "ISO dates",
+			args: RegexFromExamplesArgs{Examples: []string{"2024-01-15", "2023-12-31", "1999-07-04"}},
+			text: `^\d{4}-\d{2}-\d{2}$`,
+			out:  `{"matched_all":true}`,
+		},
+		{
+			name: "dates with varying lengths",
+			args: RegexFromExamplesArgs{Examples: []string{"1/2/2024", "12/31/2023"}},
+			text: `^\d{1,2}/\d{1,2}/\d{4}$`,
+		},
+		{
+			name: "uppercase codes",
+			args: RegexFromExamplesArgs{Examples: []string{"ABC-12", "XY-345"}},
+			text: `^[A-Z]{2,3}-\d{2,3}$`,
+		},
+		{
+			name: "mixed case",
+			args: RegexFromExamplesArgs{Examples: []string{"Hello", "world"}},
+			text: `^[A-Za-z]{5}$`,
+		},
+		{
+			name: "non-ASCII letters",
+			args: RegexFromExamplesArgs{Examples: []string{"café", "naïve"}},
+			text: `^\p{L}{4,5}$`,
+		},
+		{
+			name: "literal punctuation quoted",
+			args: RegexFromExamplesArgs{Examples: []string{"img_001.png", "img_2.jpg"}},
+			text: `^[a-z]{3}_\d{1,3}\.[a-z]{3}$`,
+		},
+		{
+			name: "common prefix and suffix",
+			args: RegexFromExamplesArgs{Examples: []string{"order #1234 shipped", "order 55 shipped"}},
+			text: `(?s)^order .{2,5} shipped$`,
+			out:  `{"strategy":"affix","matched_all":true}`,
+		},
+		{
+			name: "optional suffix",
+			args: RegexFromExamplesArgs{Examples: []string{"same", "same!"}},
+			text: `(?s)^same.{0,1}$`,
+		},
+		{
+			name: "runs longer than RE2 repeats",
+			args: RegexFromExamplesArgs{Examples: []string{strings.Repeat("7", 1500), strings.Repeat("3", 1200)}},
+			text: `^\d+$`,
+			out:  `{"matched_all":true}`,
+		},
+		{
+			name: "no examples",
+			args: RegexFromExamplesArgs{Examples: []string{}},
+			err:  true,
+			text: "Provide between 1 and 100 examples",
+		},
+		{
+			name: "too many examples",
+			args: RegexFromExamplesArgs{Examples: make([]string, 101)},
+			err:  true,
+			text: "Provide between 1 and 100 examples",
+		},
+	})
+}
+
+func TestQuantifier(t *testing.T) {
+	tests := []struct {
+		lo, hi int
+		want   string
+	}{
+		{1, 1, ""},
+		{3, 3, "{3}"},
+		{1, 2, "{1,2}"},
+		{0, 1000, "{0,1000}"},
+		{2, 1001, "+"},
+		{1500, 1500, "+"},
+		{0, 1001, "*"},
+	}
+	for _, tt := range tests {
+		if got := quantifier(tt.lo, tt.hi); got != tt.want {
+			t.Errorf("quantifier(%d, %d) = %q, want %q", tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
+
+func TestRegexFromExamplesMatches(t *testing.T) {
+	tests := []struct {
+		examples []string
+		reject   []string
+	}{
+		{[]string{"555-123-4567", "555-987-6543"}, []string{"5551234567", "555-123-456", "abc-def-ghij"}},
+		{[]string{"2024-01-15", "2023-12-31"}, []string{"2024-1-15", "2024/01/15", "24-01-15"}},
+		{[]string{"order #1234 shipped", "order 55 shipped"}, []string{"order shipped", "orders 55 shipped"}},
+		{[]string{"a\nb!", "a?"}, []string{"b", "a"}},
+		{[]string{strings.Repeat("7", 1500), strings.Repeat("3", 1200)}, []string{"", "12a"}},
+		{[]string{"id-" + strings.Repeat("x", 1001), "id-ab"}, []string{"id-", "id-12"}},
+		{[]string{"<" + strings.Repeat("a b", 600) + ">", "<>"}, []string{"<", "x<>"}},
+	}
+	for _, tt := range tests {
+		_, out := callTool(t, handleRegexFromExamples, RegexFromExamplesArgs{Examples: tt.examples})
+		pattern, _ := out.(map[string]any)["pattern"].(string)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			t.Fatalf("%q: pattern %q does not compile: %v", tt.examples, pattern, err)
+		}
+		for _, e := range tt.examples {
+			if !re.MatchString(e) {
+				t.Errorf("%q does not match example %q", pattern, e)
+			}
+		}
+		for _, s := range tt.reject {
+			if re.MatchString(s) {
+				t.Errorf("%q matches %q", pattern, s)
+			}
+		}
+	}
+}
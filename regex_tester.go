@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type RegexTestArgs struct {
+	Pattern    string   `json:"pattern" jsonschema:"Regular expression in Go (RE2) syntax"`
+	Samples    []string `json:"samples" jsonschema:"Strings to test the pattern against (1-100)"`
+	IgnoreCase bool     `json:"ignore_case,omitempty" jsonschema:"Match case-insensitively"`
+	TimeoutMS  *int     `json:"timeout_ms,omitempty" jsonschema:"Give up if matching all samples takes longer than this many milliseconds (1-10000, default 1000)"`
+}
+
+type regexGroup struct {
+	Index   int    `json:"index"`
+	Name    string `json:"name,omitempty"`
+	Matched bool   `json:"matched"`
+	Value   string `json:"value"`
+}
+
+type regexSampleResult struct {
+	Input   string       `json:"input"`
+	Matched bool         `json:"matched"`
+	Match   string       `json:"match,omitempty"`
+	Start   int          `json:"start"`
+	End     int          `json:"end"`
+	Groups  []regexGroup `json:"groups,omitempty"`
+}
+
+// testSample reports the first match of re in sample, with byte offsets and
+// every capture group. Start and End are -1 when nothing matches.
+func testSample(re *regexp.Regexp, sample string) regexSampleResult {
+	result := regexSampleResult{Input: sample, Start: -1, End: -1}
+	loc := re.FindStringSubmatchIndex(sample)
+	if loc == nil {
+		return result
+	}
+	result.Matched = true
+	result.Match = sample[loc[0]:loc[1]]
+	result.Start, result.End = loc[0], loc[1]
+	for i, name := range re.SubexpNames()[1:] {
+		group := regexGroup{Index: i + 1, Name: name}
+		if start, end := loc[2*(i+1)], loc[2*(i+1)+1]; start >= 0 {
+			group.Matched = true
+			group.Value = sample[start:end]
+		}
+		result.Groups = append(result.Groups, group)
+	}
+	return result
+}
+
+func handleRegexTest(ctx context.Context, req *mcp.CallToolRequest, args RegexTestArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("regex_test called: pattern=%q samples=%d ignore_case=%t", args.Pattern, len(args.Samples), args.IgnoreCase))
+
+	if len(args.Samples) == 0 || len(args.Samples) > 100 {
+		return errorResult("Provide between 1 and 100 samples"), nil, nil
+	}
+
+	timeout := time.Second
+	if args.TimeoutMS != nil {
+		if *args.TimeoutMS < 1 || *args.TimeoutMS > 10000 {
+			return errorResult("Timeout must be between 1 and 10000 milliseconds"), nil, nil
+		}
+		timeout = time.Duration(*args.TimeoutMS) * time.Millisecond
+	}
+
+	expr := args.Pattern
+	if args.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid regular expression: %v", err)), nil, nil
+	}
+
+	// RE2 matching is linear in the input, but a large pattern against long
+	// samples can still take a while; stop waiting once the deadline passes.
+	// The worker notices the expired context between samples and exits.
+	matchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	done := make(chan []regexSampleResult, 1)
+	go func() {
+		results := make([]regexSampleResult, 0, len(args.Samples))
+		for _, sample := range args.Samples {
+			if matchCtx.Err() != nil {
+				return
+			}
+			results = append(results, testSample(re, sample))
+		}
+		done <- results
+	}()
+
+	var results []regexSampleResult
+	select {
+	case results = <-done:
+	case <-matchCtx.Done():
+		if ctx.Err() != nil {
+			return cancelledResult(ctx.Err()), nil, nil
+		}
+		return errorResult(fmt.Sprintf("Matching timed out after %v", timeout)), nil, nil
+	}
+
+	matched := 0
+	for _, r := range results {
+		if r.Matched {
+			matched++
+		}
+	}
+
+	return textResult(fmt.Sprintf("%d of %d samples matched", matched, len(results))), map[string]any{
+		"pattern": re.String(),
+		"groups":  re.NumSubexp(),
+		"matched": matched,
+		"results": results,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import "testing"
+
+func TestRegexTest(t *testing.T) {
+	runToolCases(t, handleRegexTest, []toolCase[RegexTestArgs]{
+		{
+			name: "groups",
+			args: RegexTestArgs{Pattern: `(\d{4})-(?P<month>\d{2})`, Samples: []string{"on 2024-03-15", "no date"}},
+			text: "1 of 2 samples matched",
+			out: `{"pattern":"(\\d{4})-(?P<month>\\d{2})","groups":2,"matched":1,"results":[
+				{"input":"on 2024-03-15","matched":true,"match":"2024-03","start":3,"end":10,"groups":[
+					{"index":1,"matched":true,"value":"2024"},
+					{"index":2,"name":"month","matched":true,"value":"03"}]},
+				{"input":"no date","matched":false,"start":-1,"end":-1}]}`,
+		},
+		{
+			name: "unmatched optional group",
+			args: RegexTestArgs{Pattern: `a(b)?(c)`, Samples: []string{"ac"}},
+			out: `{"results":[{"input":"ac","matched":true,"match":"ac","start":0,"end":2,"groups":[
+				{"index":1,"matched":false,"value":""},
+				{"index":2,"matched":true,"value":"c"}]}]}`,
+		},
+		{
+			name: "empty match",
+			args: RegexTestArgs{Pattern: `x*`, Samples: []string{"abc"}},
+			text: "1 of 1 samples matched",
+			out:  `{"results":[{"input":"abc","matched":true,"start":0,"end":0}]}`,
+		},
+		{
+			name: "ignore case",
+			args: RegexTestArgs{Pattern: `hello`, Samples: []string{"HeLLo there", "bye"}, IgnoreCase: true},
+			text: "1 of 2 samples matched",
+			out:  `{"pattern":"(?i)hello","groups":0,"matched":1}`,
+		},
+		{
+			name: "case sensitive by default",
+			args: RegexTestArgs{Pattern: `hello`, Samples: []string{"HELLO"}},
+			text: "0 of 1 samples matched",
+		},
+		{
+			name: "invalid pattern",
+			args: RegexTestArgs{Pattern: `(a`, Samples: []string{"a"}},
+			err:  true,
+			text: "Invalid regular expression: error parsing regexp: missing closing ): `(a`",
+		},
+		{
+			name:     "unsupported backreference",
+			args:     RegexTestArgs{Pattern: `(a)\1`, Samples: []string{"aa"}},
+			err:      true,
+			contains: []string{"Invalid regular expression: ", "invalid escape sequence"},
+		},
+		{
+			name: "no samples",
+			args: RegexTestArgs{Pattern: `a`},
+			err:  true,
+			text: "Provide between 1 and 100 samples",
+		},
+		{
+			name: "timeout out of range",
+			args: RegexTestArgs{Pattern: `a`, Samples: []string{"a"}, TimeoutMS: ptr(0)},
+			err:  true,
+			text: "Timeout must be between 1 and 10000 milliseconds",
+		},
+	})
+}
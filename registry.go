@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type toolInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+}
+
+// toolRegistry holds metadata for every registered tool, keyed by tool name.
+// It is populated by registerTools before the server starts and is read-only
+// afterwards.
+var toolRegistry = map[string]toolInfo{}
+
+// addTool registers a tool with the server and records its metadata in the
+// registry under the given category. Every handler is wrapped so that dry-run
+// requests are answered by validation alone and structured-only requests get
+// no text content.
+//
+// A typed Out still declares its output schema, but error results are sent
+// without structured content: the SDK would otherwise serialize the zero
+// value alongside the error text.
+func addTool[In, Out any](server *mcp.Server, category string, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, Out]) {
+	toolRegistry[tool.Name] = toolInfo{
+		Name:        tool.Name,
+		Description: tool.Description,
+		Category:    category,
+	}
+	if tool.OutputSchema == nil && reflect.TypeFor[Out]() != reflect.TypeFor[any]() {
+		schema, err := jsonschema.For[Out](&jsonschema.ForOptions{})
+		if err != nil {
+			panic(fmt.Sprintf("tool %s: output schema: %v", tool.Name, err))
+		}
+		tool.OutputSchema = schema
+	}
+	mcp.AddTool(server, tool, withStructuredOnly(withDryRun(withoutErrorOutput(handler))))
+}
+
+// withoutErrorOutput adapts a typed handler to one returning any, dropping
+// the output of error results so that they carry no structured payload.
+func withoutErrorOutput[In, Out any](handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, any] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, any, error) {
+		result, out, err := handler(ctx, req, args)
+		if err != nil || result == nil || result.IsError {
+			return result, nil, err
+		}
+		return result, out, nil
+	}
+}
+
+type ListToolsArgs struct {
+	Category string `json:"category,omitempty" jsonschema:"Only list tools in this category (e.g. text, conversion, formatting)"`
+}
+
+func handleListTools(ctx context.Context, req *mcp.CallToolRequest, args ListToolsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("list_tools called with category: %q", args.Category))
+
+	category := strings.ToLower(strings.TrimSpace(args.Category))
+
+	tools := []toolInfo{}
+	for _, info := range toolRegistry {
+		if category == "" || info.Category == category {
+			tools = append(tools, info)
+		}
+	}
+	sort.Slice(tools, func(i, j int) bool { return tools[i].Name < tools[j].Name })
+
+	lines := make([]string, len(tools))
+	for i, info := range tools {
+		lines[i] = fmt.Sprintf("%s [%s]: %s", info.Name, info.Category, info.Description)
+	}
+
+	text := strings.Join(lines, "\n")
+	if len(tools) == 0 {
+		text = fmt.Sprintf("No tools found in category: %s", args.Category)
+	}
+
+	return textResult(text), map[string]any{"tools": tools, "count": len(tools)}, nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestListTools(t *testing.T) {
+	registerTools(mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil))
+
+	tests := []struct {
+		name     string
+		category string
+		want     []string
+		notWant  []string
+	}{
+		{name: "text", category: "text", want: []string{"word_count", "slugify"}, notWant: []string{"temperature_convert", "list_tools"}},
+		{name: "case and spaces", category: " Conversion ", want: []string{"temperature_convert"}, notWant: []string{"word_count"}},
+		{name: "all", want: []string{"word_count", "slugify", "temperature_convert", "list_tools"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, out := callTool(t, handleListTools, ListToolsArgs{Category: tt.category})
+			if result.IsError {
+				t.Fatalf("unexpected error: %s", resultText(result))
+			}
+			fields := out.(map[string]any)
+			tools := fields["tools"].([]any)
+			if got := compactJSON(fields["count"]); got != strconv.Itoa(len(tools)) {
+				t.Errorf("count = %s, want %d", got, len(tools))
+			}
+			listed := map[string]string{}
+			for _, tool := range tools {
+				info := tool.(map[string]any)
+				listed[info["name"].(string)] = info["category"].(string)
+			}
+			for _, name := range tt.want {
+				if _, ok := listed[name]; !ok {
+					t.Errorf("%s is not listed", name)
+				}
+			}
+			for _, name := range tt.notWant {
+				if _, ok := listed[name]; ok {
+					t.Errorf("%s is listed in category %s", name, listed[name])
+				}
+			}
+		})
+	}
+
+	runToolCases(t, handleListTools, []toolCase[ListToolsArgs]{
+		{name: "unknown category", args: ListToolsArgs{Category: "cooking"}, text: "No tools found in category: cooking", out: `{"count":0,"tools":[]}`},
+		{name: "line format", args: ListToolsArgs{Category: "meta"}, text: "list_tools [meta]: " + toolRegistry["list_tools"].Description},
+	})
+}
+
+func TestOutputSchemas(t *testing.T) {
+	setConfig(t, serverConfig{})
+	session := connectServer(t, nil)
+
+	listed, err := session.ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("listing tools: %v", err)
+	}
+	schemas := map[string]any{}
+	for _, tool := range listed.Tools {
+		schemas[tool.Name] = tool.OutputSchema
+	}
+	if schemas["slugify"] != nil {
+		t.Errorf("slugify declares an output schema: %s", compactJSON(schemas["slugify"]))
+	}
+
+	tests := []struct {
+		name   string
+		params *mcp.CallToolParams
+		want   string
+		// bad is a payload the declared schema must reject.
+		bad map[string]any
+	}{
+		{
+			name:   "word_count",
+			params: &mcp.CallToolParams{Name: "word_count", Arguments: map[string]any{"text": "one two\nthree"}},
+			want:   `{"words":3,"characters":13,"characters_no_whitespace":11,"lines":2}`,
+			bad:    map[string]any{"words": 3},
+		},
+		{
+			name:   "word_count documents",
+			params: &mcp.CallToolParams{Name: "word_count", Arguments: map[string]any{"texts": []string{"a b", "c"}}},
+			want:   `{"words":3,"documents":[{"words":2,"characters":3,"characters_no_whitespace":2,"lines":1},{"words":1,"characters":1,"characters_no_whitespace":1,"lines":1}],"totals":{"words":3,"characters":4,"characters_no_whitespace":3,"lines":2}}`,
+		},
+		{
+			name:   "temperature_convert",
+			params: &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 0, "from_unit": "celsius", "to_unit": "kelvin"}},
+			want:   `{"result":273.15}`,
+			bad:    map[string]any{"result": "273.15"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			declared, ok := schemas[tt.params.Name]
+			if !ok || declared == nil {
+				t.Fatalf("%s declares no output schema", tt.params.Name)
+			}
+			var schema jsonschema.Schema
+			if err := json.Unmarshal([]byte(compactJSON(declared)), &schema); err != nil {
+				t.Fatalf("decoding output schema: %v", err)
+			}
+			resolved, err := schema.Resolve(nil)
+			if err != nil {
+				t.Fatalf("resolving output schema: %v", err)
+			}
+
+			result, out := callRemote(t, session, tt.params)
+			if result.IsError {
+				t.Fatalf("unexpected error: %s", resultText(result))
+			}
+			if err := resolved.Validate(result.StructuredContent); err != nil {
+				t.Errorf("structured content %s does not match the declared schema: %v", compactJSON(out), err)
+			}
+			checkOutput(t, out, tt.want)
+			if tt.bad != nil && resolved.Validate(tt.bad) == nil {
+				t.Errorf("declared schema accepts %s", compactJSON(tt.bad))
+			}
+		})
+	}
+
+	t.Run("error result", func(t *testing.T) {
+		result, out := callRemote(t, session, &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 1, "from_unit": "rankine", "to_unit": "celsius"}})
+		if !result.IsError {
+			t.Fatalf("expected an error, got %q", resultText(result))
+		}
+		if out != nil {
+			t.Errorf("error result has structured content %s", compactJSON(out))
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/text/unicode/norm"
+)
+
+type RemoveDiacriticsArgs struct {
+	Text       string `json:"text" jsonschema:"The text to strip diacritics from"`
+	AllScripts bool   `json:"all_scripts,omitempty" jsonschema:"Also strip combining marks from non-Latin letters (Greek tonos, Cyrillic breve, Japanese dakuten, and so on). By default only Latin letters are changed"`
+}
+
+// strokedLetters maps Latin letters whose diacritic is part of the base
+// character, so NFD cannot separate it, to their plain forms.
+var strokedLetters = map[rune]rune{
+	'ø': 'o', 'Ø': 'O',
+	'ł': 'l', 'Ł': 'L',
+	'đ': 'd', 'Đ': 'D',
+	'ħ': 'h', 'Ħ': 'H',
+	'ŧ': 't', 'Ŧ': 'T',
+	'ƀ': 'b', 'Ɨ': 'I',
+	'ɨ': 'i', 'ƶ': 'z',
+	'Ƶ': 'Z',
+}
+
+// removeDiacritics decomposes text (NFD), drops nonspacing combining marks
+// that follow a Latin letter (or any letter when allScripts is set), and
+// recomposes what remains (NFC). It returns the result and the number of
+// marks removed.
+func removeDiacritics(text string, allScripts bool) (string, int) {
+	var b strings.Builder
+	removed := 0
+	stripping := false
+	for _, r := range norm.NFD.String(text) {
+		if unicode.Is(unicode.Mn, r) {
+			if stripping {
+				removed++
+				continue
+			}
+			b.WriteRune(r)
+			continue
+		}
+		stripping = allScripts || unicode.Is(unicode.Latin, r)
+		if plain, ok := strokedLetters[r]; ok {
+			r = plain
+			removed++
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String()), removed
+}
+
+func handleRemoveDiacritics(ctx context.Context, req *mcp.CallToolRequest, args RemoveDiacriticsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("remove_diacritics called: all_scripts=%t text length=%d", args.AllScripts, len(args.Text)))
+
+	result, removed := removeDiacritics(args.Text, args.AllScripts)
+
+	return textResult(result), map[string]any{
+		"text":    result,
+		"removed": removed,
+		"changed": result != args.Text,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import "testing"
+
+func TestRemoveDiacritics(t *testing.T) {
+	runToolCases(t, handleRemoveDiacritics, []toolCase[RemoveDiacriticsArgs]{
+		{
+			name: "accented Latin",
+			args: RemoveDiacriticsArgs{Text: "naïve café"},
+			text: "naive cafe",
+			out:  `{"text":"naive cafe","removed":2,"changed":true}`,
+		},
+		{
+			name: "several accents",
+			args: RemoveDiacriticsArgs{Text: "Crème Brûlée à la façon de Zoë"},
+			text: "Creme Brulee a la facon de Zoe",
+			out:  `{"removed":6}`,
+		},
+		{
+			name: "stacked marks",
+			args: RemoveDiacriticsArgs{Text: "Đặng"},
+			text: "Dang",
+			out:  `{"removed":3}`,
+		},
+		{
+			name: "stroked letters",
+			args: RemoveDiacriticsArgs{Text: "Łódź Ørsted"},
+			text: "Lodz Orsted",
+			out:  `{"removed":4}`,
+		},
+		{
+			name: "already decomposed",
+			args: RemoveDiacriticsArgs{Text: "cafe\u0301"},
+			text: "cafe",
+			out:  `{"removed":1}`,
+		},
+		{
+			name: "mark on a digit kept",
+			args: RemoveDiacriticsArgs{Text: "1\u0301"},
+			text: "1\u0301",
+			out:  `{"removed":0,"changed":false}`,
+		},
+		{
+			name: "CJK unchanged",
+			args: RemoveDiacriticsArgs{Text: "東京タワー と ガギグ"},
+			text: "東京タワー と ガギグ",
+			out:  `{"removed":0,"changed":false}`,
+		},
+		{
+			name: "Greek and Cyrillic unchanged",
+			args: RemoveDiacriticsArgs{Text: "Ελληνικά й"},
+			text: "Ελληνικά й",
+			out:  `{"changed":false}`,
+		},
+		{
+			name: "all scripts",
+			args: RemoveDiacriticsArgs{Text: "Ελληνικά й ガギグ café", AllScripts: true},
+			text: "Ελληνικα и カキク cafe",
+			out:  `{"removed":6,"changed":true}`,
+		},
+		{
+			name: "plain text",
+			args: RemoveDiacriticsArgs{Text: "plain"},
+			text: "plain",
+			out:  `{"removed":0,"changed":false}`,
+		},
+		{
+			name: "empty",
+			args: RemoveDiacriticsArgs{Text: ""},
+			out:  `{"text":"","removed":0,"changed":false}`,
+		},
+	})
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type RenderTableArgs struct {
+	Text      string   `json:"text" jsonschema:"Delimited rows, one per line; fields may be quoted as in CSV"`
+	Delimiter string   `json:"delimiter,omitempty" jsonschema:"Single-character field delimiter (default comma; use \\t for tabs)"`
+	Header    bool     `json:"header,omitempty" jsonschema:"Treat the first row as a header and draw a separator below it"`
+	Style     string   `json:"style,omitempty" jsonschema:"Border style: ascii (+, -, |) or unicode (box-drawing characters). Defaults to ascii"`
+	Align     []string `json:"align,omitempty" jsonschema:"Alignment per column in order: left, right, or center. Columns without an entry are left-aligned"`
+	MaxWidth  *int     `json:"max_width,omitempty" jsonschema:"Maximum display width of any column (2-1000); wider cells are wrapped or truncated"`
+	Overflow  string   `json:"overflow,omitempty" jsonschema:"How to fit cells wider than max_width: wrap (default, breaking at spaces where possible) or truncate (ending with …)"`
+}
+
+// tableBorders holds the characters used to draw a table: the horizontal
+// line, the vertical line, and the left, middle, and right junctions of the
+// top, separator, and bottom rules.
+type tableBorders struct {
+	horizontal, vertical string
+	top, middle, bottom  [3]string
+}
+
+var tableBorderStyles = map[string]tableBorders{
+	"ascii": {
+		horizontal: "-", vertical: "|",
+		top: [3]string{"+", "+", "+"}, middle: [3]string{"+", "+", "+"}, bottom: [3]string{"+", "+", "+"},
+	},
+	"unicode": {
+		horizontal: "─", vertical: "│",
+		top: [3]string{"┌", "┬", "┐"}, middle: [3]string{"├", "┼", "┤"}, bottom: [3]string{"└", "┴", "┘"},
+	},
+}
+
+// wrapToWidth breaks s into lines no wider than width columns, splitting at
+// spaces where possible and inside words only when a word alone is too wide.
+func wrapToWidth(s string, width int) []string {
+	var lines []string
+	line, lineWidth := "", 0
+	for _, word := range strings.Fields(s) {
+		for displayWidth(word) > width {
+			if lineWidth > 0 {
+				lines = append(lines, line)
+				line, lineWidth = "", 0
+			}
+			head, _ := truncateToWidth(word, width)
+			if head == "" {
+				// A single character wider than the column still has to go
+				// somewhere; give it a line of its own.
+				head = graphemeClusters(word)[0]
+			}
+			lines = append(lines, head)
+			word = word[len(head):]
+		}
+		if word == "" {
+			continue
+		}
+		w := displayWidth(word)
+		switch {
+		case lineWidth == 0:
+			line, lineWidth = word, w
+		case lineWidth+1+w <= width:
+			line += " " + word
+			lineWidth += 1 + w
+		default:
+			lines = append(lines, line)
+			line, lineWidth = word, w
+		}
+	}
+	if lineWidth > 0 || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// alignToWidth pads s with spaces to width columns.
+func alignToWidth(s string, width int, align string) string {
+	padding := width - displayWidth(s)
+	if padding <= 0 {
+		return s
+	}
+	switch align {
+	case "right":
+		return strings.Repeat(" ", padding) + s
+	case "center":
+		left := padding / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", padding-left)
+	default:
+		return s + strings.Repeat(" ", padding)
+	}
+}
+
+func handleRenderTable(ctx context.Context, req *mcp.CallToolRequest, args RenderTableArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("render_table called with %d bytes, style=%q", len(args.Text), args.Style))
+
+	delimiter, err := parseDelimiter(args.Delimiter)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid delimiter: %v", err)), nil, nil
+	}
+
+	style := args.Style
+	if style == "" {
+		style = "ascii"
+	}
+	borders, ok := tableBorderStyles[style]
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported style: %s (use ascii or unicode)", args.Style)), nil, nil
+	}
+
+	overflow := args.Overflow
+	if overflow == "" {
+		overflow = "wrap"
+	}
+	if overflow != "wrap" && overflow != "truncate" {
+		return errorResult(fmt.Sprintf("Unsupported overflow option: %s (use wrap or truncate)", args.Overflow)), nil, nil
+	}
+	if args.Overflow != "" && args.MaxWidth == nil {
+		return errorResult("'overflow' requires 'max_width'"), nil, nil
+	}
+	if args.MaxWidth != nil && (*args.MaxWidth < 2 || *args.MaxWidth > 1000) {
+		return errorResult("Max width must be between 2 and 1000"), nil, nil
+	}
+
+	rows, err := parseDelimited(args.Text, delimiter)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid input: %v", err)), nil, nil
+	}
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	if len(args.Align) > columns {
+		return errorResult(fmt.Sprintf("'align' has %d entries but the table has %d columns", len(args.Align), columns)), nil, nil
+	}
+	aligns := make([]string, columns)
+	for i := range aligns {
+		aligns[i] = "left"
+		if i < len(args.Align) && args.Align[i] != "" {
+			aligns[i] = args.Align[i]
+		}
+		if aligns[i] != "left" && aligns[i] != "right" && aligns[i] != "center" {
+			return errorResult(fmt.Sprintf("Unsupported alignment for column %d: %s (use left, right, or center)", i+1, aligns[i])), nil, nil
+		}
+	}
+
+	// Each cell becomes one or more lines: embedded newlines always start a
+	// new line, and max_width may wrap or truncate further.
+	cells := make([][][]string, len(rows))
+	widths := make([]int, columns)
+	truncated := 0
+	for r, row := range rows {
+		cells[r] = make([][]string, columns)
+		for c := range columns {
+			value := ""
+			if c < len(row) {
+				value = row[c]
+			}
+			var lines []string
+			for _, line := range strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n") {
+				switch {
+				case args.MaxWidth == nil || displayWidth(line) <= *args.MaxWidth:
+					lines = append(lines, line)
+				case overflow == "wrap":
+					lines = append(lines, wrapToWidth(line, *args.MaxWidth)...)
+				default:
+					head, _ := truncateToWidth(line, *args.MaxWidth-1)
+					lines = append(lines, head+"…")
+					truncated++
+				}
+			}
+			for _, line := range lines {
+				widths[c] = max(widths[c], displayWidth(line))
+			}
+			cells[r][c] = lines
+		}
+	}
+
+	rule := func(junctions [3]string) string {
+		parts := make([]string, columns)
+		for c, w := range widths {
+			parts[c] = strings.Repeat(borders.horizontal, w+2)
+		}
+		return junctions[0] + strings.Join(parts, junctions[1]) + junctions[2]
+	}
+
+	var b strings.Builder
+	b.WriteString(rule(borders.top))
+	for r, row := range cells {
+		height := 1
+		for _, lines := range row {
+			height = max(height, len(lines))
+		}
+		for i := range height {
+			b.WriteString("\n" + borders.vertical)
+			for c, lines := range row {
+				line := ""
+				if i < len(lines) {
+					line = lines[i]
+				}
+				b.WriteString(" " + alignToWidth(line, widths[c], aligns[c]) + " " + borders.vertical)
+			}
+		}
+		if r == 0 && args.Header && len(cells) > 1 {
+			b.WriteString("\n" + rule(borders.middle))
+		}
+	}
+	b.WriteString("\n" + rule(borders.bottom))
+	table := b.String()
+
+	return textResult(table), map[string]any{
+		"table":           table,
+		"rows":            len(rows),
+		"columns":         columns,
+		"column_widths":   widths,
+		"truncated_cells": truncated,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRenderTable(t *testing.T) {
+	runToolCases(t, handleRenderTable, []toolCase[RenderTableArgs]{
+		{
+			name: "simple table",
+			args: RenderTableArgs{Text: "name,qty\napple,3\nbanana,12", Header: true},
+			text: `+--------+-----+
+| name   | qty |
++--------+-----+
+| apple  | 3   |
+| banana | 12  |
++--------+-----+`,
+			out: `{"rows":3,"columns":2,"column_widths":[6,3],"truncated_cells":0}`,
+		},
+		{
+			name: "unicode borders and right alignment",
+			args: RenderTableArgs{Text: "name,qty\napple,3\nbanana,12", Header: true, Style: "unicode", Align: []string{"left", "right"}},
+			text: `┌────────┬─────┐
+│ name   │ qty │
+├────────┼─────┤
+│ apple  │   3 │
+│ banana │  12 │
+└────────┴─────┘`,
+		},
+		{
+			name: "wide characters",
+			args: RenderTableArgs{Text: "城市,pop\n東京,14\nParis,2", Header: true, Align: []string{"center", "right"}},
+			text: `+-------+-----+
+| 城市  | pop |
++-------+-----+
+| 東京  |  14 |
+| Paris |   2 |
++-------+-----+`,
+			out: `{"column_widths":[5,3]}`,
+		},
+		{
+			name: "center alignment",
+			args: RenderTableArgs{Text: "heading\nab\nabc", Align: []string{"center"}},
+			text: `+---------+
+| heading |
+|   ab    |
+|   abc   |
++---------+`,
+		},
+		{
+			name: "wrap at max width",
+			args: RenderTableArgs{Text: "id,desc\n1,the quick brown fox", Header: true, MaxWidth: ptr(9)},
+			text: `+----+-----------+
+| id | desc      |
++----+-----------+
+| 1  | the quick |
+|    | brown fox |
++----+-----------+`,
+			out: `{"column_widths":[2,9],"truncated_cells":0}`,
+		},
+		{
+			name: "truncate at max width",
+			args: RenderTableArgs{Text: "id,desc\n1,the quick brown fox", MaxWidth: ptr(9), Overflow: "truncate"},
+			text: `+----+-----------+
+| id | desc      |
+| 1  | the quic… |
++----+-----------+`,
+			out: `{"truncated_cells":1}`,
+		},
+		{
+			name: "tab delimiter and short rows",
+			args: RenderTableArgs{Text: "a\tb\n1", Delimiter: `\t`},
+			text: `+---+---+
+| a | b |
+| 1 |   |
++---+---+`,
+			out: `{"rows":2,"columns":2}`,
+		},
+		{
+			name: "embedded newline",
+			args: RenderTableArgs{Text: "\"x\ny\",z"},
+			text: `+---+---+
+| x | z |
+| y |   |
++---+---+`,
+			out: `{"rows":1}`,
+		},
+		{
+			name: "too many alignments",
+			args: RenderTableArgs{Text: "a,b", Align: []string{"left", "right", "left"}},
+			err:  true,
+			text: "'align' has 3 entries but the table has 2 columns",
+		},
+		{
+			name: "unsupported alignment",
+			args: RenderTableArgs{Text: "a,b", Align: []string{"up"}},
+			err:  true,
+			text: "Unsupported alignment for column 1: up (use left, right, or center)",
+		},
+		{
+			name: "unsupported style",
+			args: RenderTableArgs{Text: "a", Style: "fancy"},
+			err:  true,
+			text: "Unsupported style: fancy (use ascii or unicode)",
+		},
+		{
+			name: "overflow without max width",
+			args: RenderTableArgs{Text: "a", Overflow: "wrap"},
+			err:  true,
+			text: "'overflow' requires 'max_width'",
+		},
+		{
+			name: "max width too small",
+			args: RenderTableArgs{Text: "a", MaxWidth: ptr(1)},
+			err:  true,
+			text: "Max width must be between 2 and 1000",
+		},
+		{
+			name:     "malformed quoting",
+			args:     RenderTableArgs{Text: `a,"b`},
+			err:      true,
+			contains: []string{"Invalid input: "},
+		},
+	})
+}
+
+func TestWrapToWidth(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  []string
+	}{
+		{"the quick brown fox", 9, []string{"the quick", "brown fox"}},
+		{"supercalifragilistic", 8, []string{"supercal", "ifragili", "stic"}},
+		{"a verylongword b", 5, []string{"a", "veryl", "ongwo", "rd b"}},
+		{"東京都", 3, []string{"東", "京", "都"}},
+		{"", 4, []string{""}},
+	}
+	for _, tt := range tests {
+		if got := wrapToWidth(tt.in, tt.width); !slices.Equal(got, tt.want) {
+			t.Errorf("wrapToWidth(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+	}
+}
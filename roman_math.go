@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type RomanMathArgs struct {
+	Left     string `json:"left" jsonschema:"The first Roman numeral"`
+	Operator string `json:"operator" jsonschema:"Arithmetic operator (+, -, *, or /)"`
+	Right    string `json:"right" jsonschema:"The second Roman numeral"`
+}
+
+// parseCanonicalRoman converts a Roman numeral to an integer, rejecting
+// non-standard forms such as "IIII" or "IC" that romanToInt would accept.
+func parseCanonicalRoman(s string) (int, error) {
+	n, err := romanToInt(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < 1 || intToRoman(n) != strings.ToUpper(s) {
+		return 0, fmt.Errorf("invalid Roman numeral: %s", s)
+	}
+	return n, nil
+}
+
+func handleRomanMath(ctx context.Context, req *mcp.CallToolRequest, args RomanMathArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("roman_math called: %s %s %s", args.Left, args.Operator, args.Right))
+
+	left, err := parseCanonicalRoman(args.Left)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+	right, err := parseCanonicalRoman(args.Right)
+	if err != nil {
+		return errorResult(err.Error()), nil, nil
+	}
+
+	var result int
+	switch args.Operator {
+	case "+":
+		result = left + right
+	case "-":
+		result = left - right
+	case "*":
+		result = left * right
+	case "/":
+		if left%right != 0 {
+			return errorResult(fmt.Sprintf("%s / %s is not a whole number", args.Left, args.Right)), nil, nil
+		}
+		result = left / right
+	default:
+		return errorResult(fmt.Sprintf("Unsupported operator: %s", args.Operator)), nil, nil
+	}
+
+	if result < 1 || result > 3999 {
+		return errorResult(fmt.Sprintf("Result %d is outside the Roman numeral range 1-3999", result)), nil, nil
+	}
+
+	roman := intToRoman(result)
+
+	return textResult(roman), map[string]any{
+		"roman":   roman,
+		"decimal": result,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestRomanMath(t *testing.T) {
+	runToolCases(t, handleRomanMath, []toolCase[RomanMathArgs]{
+		{
+			name: "add",
+			args: RomanMathArgs{Left: "X", Operator: "+", Right: "V"},
+			text: "XV",
+			out:  `{"roman":"XV","decimal":15}`,
+		},
+		{
+			name: "subtract lowercase",
+			args: RomanMathArgs{Left: "xl", Operator: "-", Right: "ii"},
+			text: "XXXVIII",
+			out:  `{"roman":"XXXVIII","decimal":38}`,
+		},
+		{
+			name: "multiply",
+			args: RomanMathArgs{Left: "XII", Operator: "*", Right: "XII"},
+			text: "CXLIV",
+			out:  `{"roman":"CXLIV","decimal":144}`,
+		},
+		{
+			name: "exact division",
+			args: RomanMathArgs{Left: "C", Operator: "/", Right: "IV"},
+			text: "XXV",
+			out:  `{"roman":"XXV","decimal":25}`,
+		},
+		{name: "overflow", args: RomanMathArgs{Left: "MMM", Operator: "*", Right: "II"}, err: true, text: "Result 6000 is outside the Roman numeral range 1-3999"},
+		{name: "zero", args: RomanMathArgs{Left: "V", Operator: "-", Right: "V"}, err: true, text: "Result 0 is outside the Roman numeral range 1-3999"},
+		{name: "inexact division", args: RomanMathArgs{Left: "X", Operator: "/", Right: "III"}, err: true, text: "X / III is not a whole number"},
+		{name: "non-canonical", args: RomanMathArgs{Left: "IIII", Operator: "+", Right: "I"}, err: true, text: "invalid Roman numeral: IIII"},
+		{name: "bad character", args: RomanMathArgs{Left: "X", Operator: "+", Right: "Z"}, err: true, text: "invalid Roman numeral character: Z"},
+		{name: "unknown operator", args: RomanMathArgs{Left: "X", Operator: "%", Right: "V"}, err: true, text: "Unsupported operator: %"},
+	})
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type RoundCashArgs struct {
+	Amount    float64  `json:"amount" jsonschema:"The amount to round"`
+	Currency  string   `json:"currency" jsonschema:"Currency code or symbol (USD, EUR, GBP, JPY)"`
+	Increment *float64 `json:"increment,omitempty" jsonschema:"Cash denomination to round to (e.g. 0.05). Defaults to the currency's smallest coin"`
+}
+
+// roundToIncrement rounds value to the nearest multiple of increment, with ties
+// rounded away from zero. Both numbers are treated as their shortest decimal
+// representations so that 2.03 at 0.05 reliably becomes 2.05. It returns the
+// rounded value and the adjustment applied.
+func roundToIncrement(value, increment float64) (rounded, adjustment float64) {
+	v, _ := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	inc, _ := new(big.Rat).SetString(strconv.FormatFloat(increment, 'f', -1, 64))
+
+	ratio := new(big.Rat).Quo(v, inc)
+	q, rem := new(big.Int).QuoRem(ratio.Num(), ratio.Denom(), new(big.Int))
+	if new(big.Int).Lsh(new(big.Int).Abs(rem), 1).Cmp(ratio.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(ratio.Sign())))
+	}
+
+	r := new(big.Rat).Mul(new(big.Rat).SetInt(q), inc)
+	rounded, _ = r.Float64()
+	adjustment, _ = new(big.Rat).Sub(r, v).Float64()
+	return rounded, adjustment
+}
+
+func handleRoundCash(ctx context.Context, req *mcp.CallToolRequest, args RoundCashArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("round_cash called: %.4f %s", args.Amount, args.Currency))
+
+	code, _, ok := normalizeCurrency(args.Currency)
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported currency: %s", args.Currency)), nil, nil
+	}
+	info := currencies[code]
+
+	if math.IsNaN(args.Amount) || math.IsInf(args.Amount, 0) {
+		return errorResult("Amount must be a finite number"), nil, nil
+	}
+
+	increment := info.CashIncrement
+	if args.Increment != nil {
+		increment = *args.Increment
+		if increment <= 0 || math.IsInf(increment, 0) || math.IsNaN(increment) {
+			return errorResult("Increment must be a positive number"), nil, nil
+		}
+	}
+
+	rounded, adjustment := roundToIncrement(args.Amount, increment)
+	formatted := formatMoney(rounded, info)
+
+	return textResult(fmt.Sprintf("%s (adjustment %+g)", formatted, adjustment)),
+		map[string]any{
+			"rounded":    rounded,
+			"adjustment": adjustment,
+			"increment":  increment,
+			"currency":   code,
+			"formatted":  formatted,
+		}, nil
+}
@@ -0,0 +1,17 @@
+package main
+
+import "testing"
+
+func TestRoundCash(t *testing.T) {
+	runToolCases(t, handleRoundCash, []toolCase[RoundCashArgs]{
+		{name: "unsupported currency", args: RoundCashArgs{Amount: 2.03, Currency: "CAD"}, err: true, text: "Unsupported currency: CAD"},
+		{name: "2.03 at 0.05", args: RoundCashArgs{Amount: 2.03, Currency: "USD", Increment: ptr(0.05)}, text: "$2.05 (adjustment +0.02)", out: `{"rounded":2.05,"adjustment":0.02,"increment":0.05,"currency":"USD","formatted":"$2.05"}`},
+		{name: "2.02 at 0.05", args: RoundCashArgs{Amount: 2.02, Currency: "usd", Increment: ptr(0.05)}, text: "$2.00 (adjustment -0.02)", out: `{"rounded":2,"adjustment":-0.02}`},
+		{name: "tie away from zero", args: RoundCashArgs{Amount: 2.025, Currency: "EUR", Increment: ptr(0.05)}, text: "€2.05 (adjustment +0.025)"},
+		{name: "negative tie", args: RoundCashArgs{Amount: -2.025, Currency: "EUR", Increment: ptr(0.05)}, out: `{"rounded":-2.05,"adjustment":-0.025}`},
+		{name: "yen whole units", args: RoundCashArgs{Amount: 1234.5, Currency: "JPY"}, text: "¥1235 (adjustment +0.5)", out: `{"rounded":1235,"increment":1,"currency":"JPY"}`},
+		{name: "yen down", args: RoundCashArgs{Amount: 99.4, Currency: "¥"}, text: "¥99 (adjustment -0.4)", out: `{"rounded":99}`},
+		{name: "default cent", args: RoundCashArgs{Amount: 0.125, Currency: "GBP"}, text: "£0.13 (adjustment +0.005)"},
+		{name: "zero increment", args: RoundCashArgs{Amount: 1, Currency: "USD", Increment: ptr(0.0)}, err: true, text: "Increment must be a positive number"},
+	})
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SafeFilenameArgs struct {
+	Name         string  `json:"name" jsonschema:"The desired file name, without any directory"`
+	PreserveCase bool    `json:"preserve_case,omitempty" jsonschema:"Keep the original letter case instead of lowercasing"`
+	Replacement  *string `json:"replacement,omitempty" jsonschema:"Text substituted for illegal characters (default _; may be empty to drop them)"`
+	MaxLength    int     `json:"max_length,omitempty" jsonschema:"Maximum length in bytes, keeping the extension (default 255)"`
+}
+
+// illegalFilenameChars are rejected by Windows; / is also the separator on
+// macOS and Linux.
+const illegalFilenameChars = `<>:"/\|?*`
+
+// windowsReservedNames cannot be used as a file name stem on Windows, with
+// or without an extension and in any case.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// truncateBytes shortens s to at most n bytes without splitting a rune.
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// safeFilename makes name valid on Windows, macOS, and Linux, returning the
+// result and a description of each change made.
+func safeFilename(name string, preserveCase bool, replacement string, maxLength int) (string, []string) {
+	changes := []string{}
+
+	var b strings.Builder
+	replaced := false
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(illegalFilenameChars, r) {
+			b.WriteString(replacement)
+			replaced = true
+			continue
+		}
+		b.WriteRune(r)
+	}
+	safe := b.String()
+	if replaced {
+		changes = append(changes, "replaced illegal characters")
+	}
+
+	if collapsed := normalizeWhitespace(safe); collapsed != safe {
+		safe = collapsed
+		changes = append(changes, "collapsed or trimmed whitespace")
+	}
+	if !preserveCase && strings.ToLower(safe) != safe {
+		safe = strings.ToLower(safe)
+		changes = append(changes, "lowercased")
+	}
+
+	trimTrailing := func() {
+		if trimmed := strings.TrimRight(safe, ". "); trimmed != safe {
+			safe = trimmed
+			changes = append(changes, "removed trailing dots or spaces")
+		}
+	}
+	trimTrailing()
+
+	// Windows treats "con.txt" and "con.tar.gz" as the CON device too, so
+	// the reserved check applies to everything before the first dot.
+	if first, rest, hasExt := strings.Cut(safe, "."); windowsReservedNames[strings.ToUpper(first)] {
+		safe = first + "_"
+		if hasExt {
+			safe += "." + rest
+		}
+		changes = append(changes, "avoided a reserved Windows name")
+	}
+
+	if len(safe) > maxLength {
+		ext := path.Ext(safe)
+		if len(ext) >= maxLength {
+			ext = ""
+		}
+		safe = truncateBytes(strings.TrimSuffix(safe, ext), maxLength-len(ext)) + ext
+		changes = append(changes, fmt.Sprintf("truncated to %d bytes", maxLength))
+		trimTrailing()
+	}
+
+	if safe == "" || safe == "." || safe == ".." {
+		safe = "unnamed"
+		changes = append(changes, "replaced an empty name")
+	}
+	return safe, changes
+}
+
+func handleSafeFilename(ctx context.Context, req *mcp.CallToolRequest, args SafeFilenameArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("safe_filename called with name: %s", args.Name))
+
+	replacement := "_"
+	if args.Replacement != nil {
+		replacement = *args.Replacement
+		if strings.ContainsAny(replacement, illegalFilenameChars) || strings.ContainsFunc(replacement, func(r rune) bool { return r < 0x20 || r == 0x7f }) {
+			return errorResult("Replacement must not contain characters that are illegal in file names"), nil, nil
+		}
+	}
+
+	maxLength := args.MaxLength
+	if maxLength == 0 {
+		maxLength = 255
+	}
+	if maxLength < 1 || maxLength > 4096 {
+		return errorResult("Max length must be between 1 and 4096"), nil, nil
+	}
+
+	safe, changes := safeFilename(args.Name, args.PreserveCase, replacement, maxLength)
+
+	return textResult(safe), map[string]any{
+		"filename": safe,
+		"changed":  safe != args.Name,
+		"changes":  changes,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSafeFilename(t *testing.T) {
+	runToolCases(t, handleSafeFilename, []toolCase[SafeFilenameArgs]{
+		{
+			name: "illegal characters",
+			args: SafeFilenameArgs{Name: `Report: Q1/Q2?.txt`},
+			text: "report_ q1_q2_.txt",
+			out:  `{"filename":"report_ q1_q2_.txt","changed":true,"changes":["replaced illegal characters","lowercased"]}`,
+		},
+		{name: "every illegal character", args: SafeFilenameArgs{Name: `a<b>c:d"e/f\g|h?i*j`, PreserveCase: true}, text: "a_b_c_d_e_f_g_h_i_j"},
+		{name: "control characters", args: SafeFilenameArgs{Name: "bad\x00name\x7f"}, text: "bad_name_"},
+		{name: "custom replacement", args: SafeFilenameArgs{Name: "a<b>c", Replacement: ptr("-")}, text: "a-b-c"},
+		{name: "dropped characters", args: SafeFilenameArgs{Name: "a<b>c", Replacement: ptr("")}, text: "abc"},
+		{
+			name: "already safe",
+			args: SafeFilenameArgs{Name: "My File.TXT", PreserveCase: true},
+			text: "My File.TXT",
+			out:  `{"filename":"My File.TXT","changed":false,"changes":[]}`,
+		},
+		{
+			name: "reserved name",
+			args: SafeFilenameArgs{Name: "CON"},
+			text: "con_",
+			out:  `{"changed":true,"changes":["lowercased","avoided a reserved Windows name"]}`,
+		},
+		{name: "reserved name with extensions", args: SafeFilenameArgs{Name: "con.tar.gz"}, text: "con_.tar.gz"},
+		{name: "reserved name keeps case", args: SafeFilenameArgs{Name: "COM1.txt", PreserveCase: true}, text: "COM1_.txt"},
+		{name: "reserved prefix is fine", args: SafeFilenameArgs{Name: "console.txt"}, out: `{"filename":"console.txt","changed":false}`},
+		{
+			name: "trailing dots and spaces",
+			args: SafeFilenameArgs{Name: "notes. . "},
+			text: "notes",
+			out:  `{"changes":["collapsed or trimmed whitespace","removed trailing dots or spaces"]}`,
+		},
+		{
+			name: "truncated keeping extension",
+			args: SafeFilenameArgs{Name: "abcdefghijkl.txt", MaxLength: 10},
+			text: "abcdef.txt",
+			out:  `{"changes":["truncated to 10 bytes"]}`,
+		},
+		{name: "truncation keeps runes whole", args: SafeFilenameArgs{Name: "ééééé", MaxLength: 5}, text: "éé"},
+		{name: "extension longer than the limit", args: SafeFilenameArgs{Name: "a." + strings.Repeat("x", 10), MaxLength: 5}, text: "a.xxx"},
+		{
+			name: "nothing left",
+			args: SafeFilenameArgs{Name: "..."},
+			text: "unnamed",
+			out:  `{"changes":["removed trailing dots or spaces","replaced an empty name"]}`,
+		},
+		{name: "illegal replacement", args: SafeFilenameArgs{Name: "a", Replacement: ptr("/")}, err: true, text: "Replacement must not contain characters that are illegal in file names"},
+		{name: "control replacement", args: SafeFilenameArgs{Name: "a", Replacement: ptr("\t")}, err: true, text: "Replacement must not contain characters that are illegal in file names"},
+		{name: "max length too large", args: SafeFilenameArgs{Name: "a", MaxLength: 5000}, err: true, text: "Max length must be between 1 and 4096"},
+		{name: "negative max length", args: SafeFilenameArgs{Name: "a", MaxLength: -1}, err: true, text: "Max length must be between 1 and 4096"},
+	})
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SemverArgs struct {
+	Mode       string `json:"mode" jsonschema:"parse (split a version), compare (order two versions), or satisfies (match a version against a constraint)"`
+	Version    string `json:"version" jsonschema:"Semantic version such as 1.2.3-beta.1+build.5; a leading v is accepted"`
+	Other      string `json:"other,omitempty" jsonschema:"Second version (compare mode)"`
+	Constraint string `json:"constraint,omitempty" jsonschema:"Constraint such as >=1.2.0 <2.0.0, ^1.4, or ~2.1.0 || 3.x (satisfies mode)"`
+}
+
+// semVersion is a parsed Semantic Versioning 2.0.0 version.
+type semVersion struct {
+	Major      uint64   `json:"major"`
+	Minor      uint64   `json:"minor"`
+	Patch      uint64   `json:"patch"`
+	Prerelease []string `json:"prerelease"`
+	Build      []string `json:"build"`
+}
+
+func (v semVersion) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if len(v.Build) > 0 {
+		s += "+" + strings.Join(v.Build, ".")
+	}
+	return s
+}
+
+// parseSemverNumber parses a numeric identifier, which must not have leading
+// zeros.
+func parseSemverNumber(s string) (uint64, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, fmt.Errorf("invalid numeric identifier %q", s)
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid numeric identifier %q", s)
+	}
+	return n, nil
+}
+
+func isNumericIdentifier(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// parseSemverIdentifiers splits dot-separated prerelease or build
+// identifiers. Numeric prerelease identifiers must not have leading zeros.
+func parseSemverIdentifiers(s string, prerelease bool) ([]string, error) {
+	ids := strings.Split(s, ".")
+	for _, id := range ids {
+		if id == "" {
+			return nil, fmt.Errorf("empty identifier in %q", s)
+		}
+		for _, r := range id {
+			if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+				return nil, fmt.Errorf("invalid character %q in identifier %q", r, id)
+			}
+		}
+		if prerelease && isNumericIdentifier(id) && len(id) > 1 && id[0] == '0' {
+			return nil, fmt.Errorf("numeric identifier %q has a leading zero", id)
+		}
+	}
+	return ids, nil
+}
+
+// parseSemver parses a full MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD] version.
+// A leading "v" or "=" is tolerated and dropped.
+func parseSemver(s string) (semVersion, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "="), "v")
+	var v semVersion
+
+	rest, build, hasBuild := strings.Cut(s, "+")
+	core, pre, hasPre := strings.Cut(rest, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return v, fmt.Errorf("%q is not MAJOR.MINOR.PATCH", s)
+	}
+	var err error
+	for i, field := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		if *field, err = parseSemverNumber(parts[i]); err != nil {
+			return v, err
+		}
+	}
+
+	if hasPre {
+		if v.Prerelease, err = parseSemverIdentifiers(pre, true); err != nil {
+			return v, err
+		}
+	}
+	if hasBuild {
+		if v.Build, err = parseSemverIdentifiers(build, false); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareSemver orders versions by SemVer precedence: numeric core fields,
+// then a version with a prerelease before the same version without one, then
+// prerelease identifiers left to right (numeric below alphanumeric). Build
+// metadata is ignored.
+func compareSemver(a, b semVersion) int {
+	if c := compareUint(a.Major, b.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(a.Minor, b.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(a.Patch, b.Patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(a.Prerelease) == 0 && len(b.Prerelease) == 0:
+		return 0
+	case len(a.Prerelease) == 0:
+		return 1
+	case len(b.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a.Prerelease) && i < len(b.Prerelease); i++ {
+		x, y := a.Prerelease[i], b.Prerelease[i]
+		xNum, yNum := isNumericIdentifier(x), isNumericIdentifier(y)
+		switch {
+		case xNum && yNum:
+			nx, _ := strconv.ParseUint(x, 10, 64)
+			ny, _ := strconv.ParseUint(y, 10, 64)
+			if c := compareUint(nx, ny); c != 0 {
+				return c
+			}
+		case xNum:
+			return -1
+		case yNum:
+			return 1
+		default:
+			if c := strings.Compare(x, y); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareUint(uint64(len(a.Prerelease)), uint64(len(b.Prerelease)))
+}
+
+// semverBound is a single comparison a version must pass, such as ">=1.2.0".
+type semverBound struct {
+	op      string
+	version semVersion
+}
+
+func (b semverBound) matches(v semVersion) bool {
+	c := compareSemver(v, b.version)
+	switch b.op {
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	}
+	return c == 0
+}
+
+// parsePartialVersion parses a possibly partial version in a constraint
+// ("1", "1.2", "1.2.x", "1.2.3-rc.1"), returning it with missing fields set
+// to zero and the number of fields given.
+func parsePartialVersion(s string) (semVersion, int, error) {
+	s = strings.TrimPrefix(s, "v")
+	core, _, _ := strings.Cut(strings.SplitN(s, "+", 2)[0], "-")
+	parts := strings.Split(core, ".")
+	for len(parts) > 0 {
+		last := parts[len(parts)-1]
+		if last != "x" && last != "X" && last != "*" {
+			break
+		}
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) == 0 {
+		return semVersion{}, 0, nil
+	}
+	if len(parts) > 3 {
+		return semVersion{}, 0, fmt.Errorf("%q has too many fields", s)
+	}
+	if len(parts) == 3 {
+		v, err := parseSemver(s)
+		return v, 3, err
+	}
+	if core != s {
+		return semVersion{}, 0, fmt.Errorf("%q: a prerelease requires MAJOR.MINOR.PATCH", s)
+	}
+
+	var v semVersion
+	fields := []*uint64{&v.Major, &v.Minor}
+	for i, p := range parts {
+		n, err := parseSemverNumber(p)
+		if err != nil {
+			return v, 0, err
+		}
+		*fields[i] = n
+	}
+	return v, len(parts), nil
+}
+
+// expandComparator turns one comparator into the bounds it implies. Caret
+// ranges allow changes that do not modify the leftmost non-zero field, tilde
+// ranges allow patch-level changes (or minor-level when only a major is
+// given), and a bare partial version such as "1.2" or "1.x" matches
+// everything with that prefix.
+func expandComparator(c string) ([]semverBound, error) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(c, candidate) {
+			op = candidate
+			break
+		}
+	}
+	v, fields, err := parsePartialVersion(strings.TrimSpace(c[len(op):]))
+	if err != nil {
+		return nil, err
+	}
+	if fields == 0 {
+		// "*", "x", or ">=x": any version.
+		return nil, nil
+	}
+
+	next := func(field int) semVersion {
+		switch field {
+		case 0:
+			return semVersion{Major: v.Major + 1}
+		case 1:
+			return semVersion{Major: v.Major, Minor: v.Minor + 1}
+		}
+		return semVersion{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	}
+	lower := semverBound{">=", v}
+
+	switch op {
+	case "^":
+		field := fields - 1
+		switch {
+		case v.Major > 0 || fields == 1:
+			field = 0
+		case v.Minor > 0 || fields == 2:
+			field = 1
+		}
+		return []semverBound{lower, {"<", next(field)}}, nil
+	case "~":
+		field := 1
+		if fields == 1 {
+			field = 0
+		}
+		return []semverBound{lower, {"<", next(field)}}, nil
+	case ">", "<=":
+		if fields < 3 {
+			// ">1.2" means ">=1.3.0"; "<=1.2" means "<1.3.0".
+			flipped := map[string]string{">": ">=", "<=": "<"}[op]
+			return []semverBound{{flipped, next(fields - 1)}}, nil
+		}
+		return []semverBound{{op, v}}, nil
+	case ">=", "<":
+		return []semverBound{{op, v}}, nil
+	}
+
+	if fields < 3 {
+		return []semverBound{lower, {"<", next(fields - 1)}}, nil
+	}
+	return []semverBound{{"=", v}}, nil
+}
+
+// satisfiesConstraint reports whether v matches a constraint made of
+// space-separated comparators that must all hold, with alternatives separated
+// by "||".
+func satisfiesConstraint(v semVersion, constraint string) (bool, error) {
+	if strings.TrimSpace(constraint) == "" {
+		return false, fmt.Errorf("constraint must not be empty")
+	}
+
+	matched := false
+	for _, alternative := range strings.Split(constraint, "||") {
+		// Allow a space between an operator and its version (">= 1.2.0").
+		fields := strings.Fields(alternative)
+		var comparators []string
+		for i := 0; i < len(fields); i++ {
+			c := fields[i]
+			if strings.Trim(c, "<>=^~") == "" && i+1 < len(fields) {
+				c += fields[i+1]
+				i++
+			}
+			comparators = append(comparators, c)
+		}
+		if len(comparators) == 0 {
+			return false, fmt.Errorf("empty alternative in %q", constraint)
+		}
+
+		all := true
+		for _, c := range comparators {
+			bounds, err := expandComparator(c)
+			if err != nil {
+				return false, fmt.Errorf("invalid comparator %q: %v", c, err)
+			}
+			for _, b := range bounds {
+				if !b.matches(v) {
+					all = false
+				}
+			}
+		}
+		matched = matched || all
+	}
+	return matched, nil
+}
+
+func handleSemver(ctx context.Context, req *mcp.CallToolRequest, args SemverArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("semver called: mode=%q version=%q", args.Mode, args.Version))
+
+	v, err := parseSemver(args.Version)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid version: %v", err)), nil, nil
+	}
+
+	switch args.Mode {
+	case "parse":
+		return textResult(v.String()), map[string]any{
+			"normalized": v.String(),
+			"version":    v,
+		}, nil
+
+	case "compare":
+		other, err := parseSemver(args.Other)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid other version: %v", err)), nil, nil
+		}
+		c := compareSemver(v, other)
+		relation := map[int]string{-1: "<", 0: "=", 1: ">"}[c]
+		return textResult(fmt.Sprintf("%s %s %s", v, relation, other)), map[string]any{"comparison": c}, nil
+
+	case "satisfies":
+		ok, err := satisfiesConstraint(v, args.Constraint)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid constraint: %v", err)), nil, nil
+		}
+		text := fmt.Sprintf("%s satisfies %s", v, args.Constraint)
+		if !ok {
+			text = fmt.Sprintf("%s does not satisfy %s", v, args.Constraint)
+		}
+		return textResult(text), map[string]any{"satisfies": ok}, nil
+	}
+
+	return errorResult(fmt.Sprintf("Unsupported mode: %s (use parse, compare, or satisfies)", args.Mode)), nil, nil
+}
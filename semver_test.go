@@ -0,0 +1,62 @@
+package main
+
+import "testing"
+
+func TestSemver(t *testing.T) {
+	runToolCases(t, handleSemver, []toolCase[SemverArgs]{
+		{
+			name: "parse",
+			args: SemverArgs{Mode: "parse", Version: "v1.2.3-beta.1+build.5"},
+			text: "1.2.3-beta.1+build.5",
+			out:  `{"normalized":"1.2.3-beta.1+build.5","version":{"major":1,"minor":2,"patch":3,"prerelease":["beta","1"],"build":["build","5"]}}`,
+		},
+		{name: "parse plain", args: SemverArgs{Mode: "parse", Version: "=10.20.30"}, out: `{"version":{"major":10,"minor":20,"patch":30,"prerelease":null,"build":null}}`},
+		{name: "prerelease first", args: SemverArgs{Mode: "compare", Version: "1.0.0-alpha", Other: "1.0.0"}, text: "1.0.0-alpha < 1.0.0", out: `{"comparison":-1}`},
+		{name: "release after", args: SemverArgs{Mode: "compare", Version: "1.0.0", Other: "1.0.0-rc.1"}, text: "1.0.0 > 1.0.0-rc.1", out: `{"comparison":1}`},
+		{name: "build ignored", args: SemverArgs{Mode: "compare", Version: "1.0.0+a", Other: "v1.0.0+b"}, text: "1.0.0+a = 1.0.0+b", out: `{"comparison":0}`},
+		{name: "numeric fields", args: SemverArgs{Mode: "compare", Version: "1.10.0", Other: "1.9.0"}, out: `{"comparison":1}`},
+		{name: "range match", args: SemverArgs{Mode: "satisfies", Version: "1.5.0", Constraint: ">=1.2.0 <2.0.0"}, text: "1.5.0 satisfies >=1.2.0 <2.0.0", out: `{"satisfies":true}`},
+		{name: "range upper bound", args: SemverArgs{Mode: "satisfies", Version: "2.0.0", Constraint: ">=1.2.0 <2.0.0"}, text: "2.0.0 does not satisfy >=1.2.0 <2.0.0", out: `{"satisfies":false}`},
+		{name: "spaced operator", args: SemverArgs{Mode: "satisfies", Version: "1.2.0", Constraint: ">= 1.2.0"}, out: `{"satisfies":true}`},
+		{name: "caret", args: SemverArgs{Mode: "satisfies", Version: "1.9.9", Constraint: "^1.4"}, out: `{"satisfies":true}`},
+		{name: "caret zero major", args: SemverArgs{Mode: "satisfies", Version: "0.3.0", Constraint: "^0.2.3"}, out: `{"satisfies":false}`},
+		{name: "caret zero minor", args: SemverArgs{Mode: "satisfies", Version: "0.0.4", Constraint: "^0.0.3"}, out: `{"satisfies":false}`},
+		{name: "tilde", args: SemverArgs{Mode: "satisfies", Version: "2.1.9", Constraint: "~2.1.0"}, out: `{"satisfies":true}`},
+		{name: "tilde upper bound", args: SemverArgs{Mode: "satisfies", Version: "2.2.0", Constraint: "~2.1.0"}, out: `{"satisfies":false}`},
+		{name: "alternatives", args: SemverArgs{Mode: "satisfies", Version: "3.4.1", Constraint: "~2.1.0 || 3.x"}, out: `{"satisfies":true}`},
+		{name: "partial greater", args: SemverArgs{Mode: "satisfies", Version: "1.2.9", Constraint: ">1.2"}, out: `{"satisfies":false}`},
+		{name: "partial at most", args: SemverArgs{Mode: "satisfies", Version: "1.2.9", Constraint: "<=1.2"}, out: `{"satisfies":true}`},
+		{name: "wildcard", args: SemverArgs{Mode: "satisfies", Version: "0.0.1", Constraint: "*"}, out: `{"satisfies":true}`},
+		{name: "missing patch", args: SemverArgs{Mode: "parse", Version: "1.2"}, err: true, text: `Invalid version: "1.2" is not MAJOR.MINOR.PATCH`},
+		{name: "leading zero", args: SemverArgs{Mode: "parse", Version: "01.2.3"}, err: true, text: `Invalid version: invalid numeric identifier "01"`},
+		{name: "leading zero prerelease", args: SemverArgs{Mode: "parse", Version: "1.0.0-01"}, err: true, text: `Invalid version: numeric identifier "01" has a leading zero`},
+		{name: "empty prerelease", args: SemverArgs{Mode: "parse", Version: "1.0.0-"}, err: true, text: `Invalid version: empty identifier in ""`},
+		{name: "bad other", args: SemverArgs{Mode: "compare", Version: "1.0.0", Other: "latest"}, err: true, text: `Invalid other version: "latest" is not MAJOR.MINOR.PATCH`},
+		{name: "empty constraint", args: SemverArgs{Mode: "satisfies", Version: "1.0.0"}, err: true, text: "Invalid constraint: constraint must not be empty"},
+		{name: "bad comparator", args: SemverArgs{Mode: "satisfies", Version: "1.0.0", Constraint: ">=abc"}, err: true, text: `Invalid constraint: invalid comparator ">=abc": invalid numeric identifier "abc"`},
+		{name: "unknown mode", args: SemverArgs{Mode: "bump", Version: "1.0.0"}, err: true, text: "Unsupported mode: bump (use parse, compare, or satisfies)"},
+	})
+}
+
+func TestSemverPrecedence(t *testing.T) {
+	// The precedence example from the SemVer 2.0.0 specification.
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "2.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			_, out := callTool(t, handleSemver, SemverArgs{Mode: "compare", Version: ordered[i], Other: ordered[j]})
+			want := 0.0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			if got := numberField(t, out, "comparison"); got != want {
+				t.Errorf("compare(%s, %s) = %g, want %g", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SequenceArgs struct {
+	Type         string `json:"type" jsonschema:"Sequence to compute (fibonacci, triangular, factorial, or catalan)"`
+	N            int    `json:"n" jsonschema:"Zero-based index of the term to return"`
+	IncludeTerms bool   `json:"include_terms,omitempty" jsonschema:"Also return every term from index 0 through n"`
+}
+
+const (
+	maxSequenceIndex = 10000
+	maxSequenceTerms = 1000
+)
+
+// sequenceSteps gives, for each sequence, its term at index 0 and a function
+// advancing the term at index i to the term at index i+1.
+var sequenceSteps = map[string]struct {
+	first func() *big.Int
+	next  func(term *big.Int, i int, state *big.Int) *big.Int
+}{
+	// Fibonacci keeps the previous term in state: F(i+1) = F(i) + F(i-1).
+	"fibonacci": {
+		first: func() *big.Int { return big.NewInt(0) },
+		next: func(term *big.Int, i int, state *big.Int) *big.Int {
+			if i == 0 {
+				state.SetInt64(0)
+				return big.NewInt(1)
+			}
+			following := new(big.Int).Add(term, state)
+			state.Set(term)
+			return following
+		},
+	},
+	"triangular": {
+		first: func() *big.Int { return big.NewInt(0) },
+		next: func(term *big.Int, i int, _ *big.Int) *big.Int {
+			return new(big.Int).Add(term, big.NewInt(int64(i+1)))
+		},
+	},
+	"factorial": {
+		first: func() *big.Int { return big.NewInt(1) },
+		next: func(term *big.Int, i int, _ *big.Int) *big.Int {
+			return new(big.Int).Mul(term, big.NewInt(int64(i+1)))
+		},
+	},
+	// C(i+1) = C(i) * 2(2i+1) / (i+2), which always divides exactly.
+	"catalan": {
+		first: func() *big.Int { return big.NewInt(1) },
+		next: func(term *big.Int, i int, _ *big.Int) *big.Int {
+			t := new(big.Int).Mul(term, big.NewInt(int64(2*(2*i+1))))
+			return t.Quo(t, big.NewInt(int64(i+2)))
+		},
+	},
+}
+
+func handleSequence(ctx context.Context, req *mcp.CallToolRequest, args SequenceArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("sequence called: type=%s n=%d", args.Type, args.N))
+
+	kind := strings.ToLower(strings.TrimSpace(args.Type))
+	step, ok := sequenceSteps[kind]
+	if !ok {
+		return errorResult(fmt.Sprintf("Unsupported sequence type: %s", args.Type)), nil, nil
+	}
+	if args.N < 0 || args.N > maxSequenceIndex {
+		return errorResult(fmt.Sprintf("n must be between 0 and %d", maxSequenceIndex)), nil, nil
+	}
+	if args.IncludeTerms && args.N >= maxSequenceTerms {
+		return errorResult(fmt.Sprintf("n must be below %d when include_terms is set", maxSequenceTerms)), nil, nil
+	}
+
+	term := step.first()
+	state := new(big.Int)
+	var terms []string
+	if args.IncludeTerms {
+		terms = append(terms, term.String())
+	}
+	for i := 0; i < args.N; i++ {
+		term = step.next(term, i, state)
+		if args.IncludeTerms {
+			terms = append(terms, term.String())
+		}
+	}
+
+	value := term.String()
+	out := map[string]any{
+		"type":   kind,
+		"n":      args.N,
+		"term":   value,
+		"digits": len(value),
+	}
+	text := fmt.Sprintf("%s(%d) = %s", kind, args.N, value)
+	if args.IncludeTerms {
+		out["terms"] = terms
+		text += "\n" + strings.Join(terms, ", ")
+	}
+	return textResult(text), out, nil
+}
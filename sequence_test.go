@@ -0,0 +1,102 @@
+package main
+
+import "testing"
+
+func TestSequence(t *testing.T) {
+	runToolCases(t, handleSequence, []toolCase[SequenceArgs]{
+		{
+			name: "fibonacci 10",
+			args: SequenceArgs{Type: "fibonacci", N: 10},
+			text: "fibonacci(10) = 55",
+			out:  `{"type":"fibonacci","n":10,"term":"55","digits":2}`,
+		},
+		{
+			name: "fibonacci 0 and 1",
+			args: SequenceArgs{Type: "fibonacci", N: 1, IncludeTerms: true},
+			text: "fibonacci(1) = 1\n0, 1",
+			out:  `{"term":"1","terms":["0","1"]}`,
+		},
+		{
+			name: "fibonacci terms",
+			args: SequenceArgs{Type: "fibonacci", N: 10, IncludeTerms: true},
+			text: "fibonacci(10) = 55\n0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55",
+			out:  `{"terms":["0","1","1","2","3","5","8","13","21","34","55"]}`,
+		},
+		{
+			name: "fibonacci beyond uint64",
+			args: SequenceArgs{Type: "fibonacci", N: 100},
+			out:  `{"term":"354224848179261915075","digits":21}`,
+		},
+		{
+			name: "fibonacci 1000 digits",
+			args: SequenceArgs{Type: "fibonacci", N: 1000},
+			out:  `{"digits":209}`,
+		},
+		{
+			name: "factorial 20",
+			args: SequenceArgs{Type: "factorial", N: 20},
+			text: "factorial(20) = 2432902008176640000",
+			out:  `{"term":"2432902008176640000","digits":19}`,
+		},
+		{
+			name: "factorial beyond int64",
+			args: SequenceArgs{Type: "factorial", N: 25},
+			out:  `{"term":"15511210043330985984000000"}`,
+		},
+		{
+			name: "factorial 0",
+			args: SequenceArgs{Type: "factorial", N: 0},
+			text: "factorial(0) = 1",
+		},
+		{
+			name: "triangular",
+			args: SequenceArgs{Type: "triangular", N: 100},
+			text: "triangular(100) = 5050",
+		},
+		{
+			name: "triangular terms",
+			args: SequenceArgs{Type: "triangular", N: 5, IncludeTerms: true},
+			out:  `{"terms":["0","1","3","6","10","15"]}`,
+		},
+		{
+			name: "catalan terms",
+			args: SequenceArgs{Type: "catalan", N: 6, IncludeTerms: true},
+			out:  `{"term":"132","terms":["1","1","2","5","14","42","132"]}`,
+		},
+		{
+			name: "catalan 10",
+			args: SequenceArgs{Type: "catalan", N: 10},
+			text: "catalan(10) = 16796",
+		},
+		{
+			name: "type normalized",
+			args: SequenceArgs{Type: " Fibonacci ", N: 7},
+			text: "fibonacci(7) = 13",
+			out:  `{"type":"fibonacci"}`,
+		},
+		{
+			name: "unknown type",
+			args: SequenceArgs{Type: "primes", N: 3},
+			err:  true,
+			text: "Unsupported sequence type: primes",
+		},
+		{
+			name: "negative n",
+			args: SequenceArgs{Type: "fibonacci", N: -1},
+			err:  true,
+			text: "n must be between 0 and 10000",
+		},
+		{
+			name: "n too large",
+			args: SequenceArgs{Type: "factorial", N: 10001},
+			err:  true,
+			text: "n must be between 0 and 10000",
+		},
+		{
+			name: "too many terms",
+			args: SequenceArgs{Type: "fibonacci", N: 1000, IncludeTerms: true},
+			err:  true,
+			text: "n must be below 1000 when include_terms is set",
+		},
+	})
+}
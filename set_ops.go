@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SetOpsArgs struct {
+	A               []string `json:"a" jsonschema:"First list of strings"`
+	B               []string `json:"b" jsonschema:"Second list of strings"`
+	Operation       string   `json:"operation" jsonschema:"union, intersection, difference (a minus b), or symmetric_difference"`
+	CaseInsensitive bool     `json:"case_insensitive,omitempty" jsonschema:"Treat strings differing only in case as equal; the first spelling seen is returned"`
+}
+
+// stringSet is an insertion-ordered set keyed by a possibly case-folded form
+// of each string, remembering the first spelling seen for each key.
+type stringSet struct {
+	fold   bool
+	values map[string]string
+}
+
+func newStringSet(items []string, fold bool) *stringSet {
+	s := &stringSet{fold: fold, values: make(map[string]string, len(items))}
+	for _, item := range items {
+		s.add(item)
+	}
+	return s
+}
+
+func (s *stringSet) key(item string) string {
+	if s.fold {
+		return strings.ToLower(item)
+	}
+	return item
+}
+
+func (s *stringSet) add(item string) {
+	if _, ok := s.values[s.key(item)]; !ok {
+		s.values[s.key(item)] = item
+	}
+}
+
+func (s *stringSet) has(item string) bool {
+	_, ok := s.values[s.key(item)]
+	return ok
+}
+
+// sorted returns the set's values ordered by key.
+func (s *stringSet) sorted() []string {
+	keys := make([]string, 0, len(s.values))
+	for k := range s.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = s.values[k]
+	}
+	return out
+}
+
+func handleSetOps(ctx context.Context, req *mcp.CallToolRequest, args SetOpsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("set_ops called: %s of %d and %d items", args.Operation, len(args.A), len(args.B)))
+
+	a := newStringSet(args.A, args.CaseInsensitive)
+	b := newStringSet(args.B, args.CaseInsensitive)
+	result := newStringSet(nil, args.CaseInsensitive)
+
+	switch args.Operation {
+	case "union":
+		for _, item := range append(append([]string{}, args.A...), args.B...) {
+			result.add(item)
+		}
+	case "intersection":
+		for _, item := range args.A {
+			if b.has(item) {
+				result.add(item)
+			}
+		}
+	case "difference":
+		for _, item := range args.A {
+			if !b.has(item) {
+				result.add(item)
+			}
+		}
+	case "symmetric_difference":
+		for _, item := range args.A {
+			if !b.has(item) {
+				result.add(item)
+			}
+		}
+		for _, item := range args.B {
+			if !a.has(item) {
+				result.add(item)
+			}
+		}
+	default:
+		return errorResult(fmt.Sprintf("Unsupported operation: %s (use union, intersection, difference, or symmetric_difference)", args.Operation)), nil, nil
+	}
+
+	items := result.sorted()
+	return textResult(strings.Join(items, "\n")), map[string]any{
+		"result":       items,
+		"count_a":      len(a.values),
+		"count_b":      len(b.values),
+		"count_result": len(items),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestSetOps(t *testing.T) {
+	a := []string{"pear", "apple", "fig", "apple", "Kiwi"}
+	b := []string{"fig", "banana", "kiwi", "pear", "date"}
+
+	runToolCases(t, handleSetOps, []toolCase[SetOpsArgs]{
+		{
+			name: "union",
+			args: SetOpsArgs{A: a, B: b, Operation: "union"},
+			text: "Kiwi\napple\nbanana\ndate\nfig\nkiwi\npear",
+			out:  `{"result":["Kiwi","apple","banana","date","fig","kiwi","pear"],"count_a":4,"count_b":5,"count_result":7}`,
+		},
+		{
+			name: "intersection",
+			args: SetOpsArgs{A: a, B: b, Operation: "intersection"},
+			text: "fig\npear",
+			out:  `{"result":["fig","pear"],"count_result":2}`,
+		},
+		{
+			name: "difference",
+			args: SetOpsArgs{A: a, B: b, Operation: "difference"},
+			out:  `{"result":["Kiwi","apple"],"count_result":2}`,
+		},
+		{
+			name: "symmetric difference",
+			args: SetOpsArgs{A: a, B: b, Operation: "symmetric_difference"},
+			out:  `{"result":["Kiwi","apple","banana","date","kiwi"],"count_result":5}`,
+		},
+		{
+			name: "case-insensitive union keeps first spelling",
+			args: SetOpsArgs{A: a, B: b, Operation: "union", CaseInsensitive: true},
+			out:  `{"result":["apple","banana","date","fig","Kiwi","pear"],"count_a":4,"count_b":5,"count_result":6}`,
+		},
+		{
+			name: "case-insensitive intersection",
+			args: SetOpsArgs{A: a, B: b, Operation: "intersection", CaseInsensitive: true},
+			out:  `{"result":["fig","Kiwi","pear"]}`,
+		},
+		{
+			name: "case-insensitive symmetric difference",
+			args: SetOpsArgs{A: a, B: b, Operation: "symmetric_difference", CaseInsensitive: true},
+			out:  `{"result":["apple","banana","date"]}`,
+		},
+		{
+			name: "empty result",
+			args: SetOpsArgs{A: []string{"x"}, B: []string{"x"}, Operation: "difference"},
+			text: "",
+			out:  `{"result":[],"count_result":0}`,
+		},
+		{name: "unknown operation", args: SetOpsArgs{A: a, B: b, Operation: "product"}, err: true, text: "Unsupported operation: product (use union, intersection, difference, or symmetric_difference)"},
+	})
+}
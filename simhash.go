@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SimHashArgs struct {
+	Mode         string `json:"mode,omitempty" jsonschema:"fingerprint (hash text) or compare (measure two fingerprints). Defaults to fingerprint"`
+	Text         string `json:"text,omitempty" jsonschema:"Text to fingerprint (fingerprint mode)"`
+	ShingleSize  int    `json:"shingle_size,omitempty" jsonschema:"Number of consecutive words per feature (1-5, default 2); use the same value for fingerprints you compare"`
+	Fingerprint1 string `json:"fingerprint1,omitempty" jsonschema:"First 64-bit fingerprint as 16 hex digits (compare mode)"`
+	Fingerprint2 string `json:"fingerprint2,omitempty" jsonschema:"Second 64-bit fingerprint as 16 hex digits (compare mode)"`
+}
+
+// simhashTokens lower-cases text and splits it into runs of letters and
+// digits, so punctuation and spacing differences do not change the hash.
+func simhashTokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// simhash computes Charikar's 64-bit SimHash of text. Each feature, a shingle
+// of shingleSize consecutive words, is hashed with FNV-1a; every bit of the
+// fingerprint is set when more feature hashes have that bit set than clear.
+// Texts sharing most features therefore differ in few bits, and the Hamming
+// distance between fingerprints approximates how different the texts are.
+func simhash(text string, shingleSize int) (uint64, int) {
+	tokens := simhashTokens(text)
+	var features []string
+	if len(tokens) <= shingleSize {
+		if len(tokens) > 0 {
+			features = []string{strings.Join(tokens, " ")}
+		}
+	} else {
+		for i := 0; i+shingleSize <= len(tokens); i++ {
+			features = append(features, strings.Join(tokens[i:i+shingleSize], " "))
+		}
+	}
+
+	var weights [64]int
+	for _, f := range features {
+		h := fnv.New64a()
+		h.Write([]byte(f))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for bit, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+	return fingerprint, len(features)
+}
+
+func parseFingerprint(name, s string) (uint64, error) {
+	s = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "0x")
+	if s == "" {
+		return 0, fmt.Errorf("'%s' is required in compare mode", name)
+	}
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' must be a 64-bit hex fingerprint: %s", name, s)
+	}
+	return v, nil
+}
+
+func handleSimHash(ctx context.Context, req *mcp.CallToolRequest, args SimHashArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("simhash called: mode=%q", args.Mode))
+
+	switch args.Mode {
+	case "", "fingerprint":
+		shingleSize := args.ShingleSize
+		if shingleSize == 0 {
+			shingleSize = 2
+		}
+		if shingleSize < 1 || shingleSize > 5 {
+			return errorResult("Shingle size must be between 1 and 5"), nil, nil
+		}
+
+		fingerprint, features := simhash(args.Text, shingleSize)
+		if features == 0 {
+			return errorResult("Text contains no words to fingerprint"), nil, nil
+		}
+		hex := fmt.Sprintf("%016x", fingerprint)
+		return textResult(hex), map[string]any{
+			"fingerprint":  hex,
+			"features":     features,
+			"shingle_size": shingleSize,
+		}, nil
+
+	case "compare":
+		a, err := parseFingerprint("fingerprint1", args.Fingerprint1)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+		b, err := parseFingerprint("fingerprint2", args.Fingerprint2)
+		if err != nil {
+			return errorResult(err.Error()), nil, nil
+		}
+
+		distance := bits.OnesCount64(a ^ b)
+		similarity := 1 - float64(distance)/64
+		return textResult(fmt.Sprintf("Hamming distance: %d of 64 bits (similarity %.3f)", distance, similarity)),
+			map[string]any{
+				"hamming_distance": distance,
+				"similarity":       similarity,
+			}, nil
+	}
+
+	return errorResult(fmt.Sprintf("Unsupported mode: %s (use fingerprint or compare)", args.Mode)), nil, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"testing"
+)
+
+func TestSimHash(t *testing.T) {
+	h := fnv.New64a()
+	h.Write([]byte("hello world"))
+	helloWorld := fmt.Sprintf("%016x", h.Sum64())
+
+	runToolCases(t, handleSimHash, []toolCase[SimHashArgs]{
+		{
+			name: "single feature is its hash",
+			args: SimHashArgs{Text: "Hello, WORLD!"},
+			text: helloWorld,
+			out:  `{"fingerprint":"` + helloWorld + `","features":1,"shingle_size":2}`,
+		},
+		{
+			name: "punctuation and case ignored",
+			args: SimHashArgs{Text: "hello   world", Mode: "fingerprint"},
+			text: helloWorld,
+		},
+		{
+			name: "shingles",
+			args: SimHashArgs{Text: "one two three four", ShingleSize: 3},
+			out:  `{"features":2,"shingle_size":3}`,
+		},
+		{
+			name: "compare",
+			args: SimHashArgs{Mode: "compare", Fingerprint1: "0x00000000000000ff", Fingerprint2: "000000000000000F"},
+			text: "Hamming distance: 4 of 64 bits (similarity 0.938)",
+			out:  `{"hamming_distance":4,"similarity":0.9375}`,
+		},
+		{
+			name: "compare opposite",
+			args: SimHashArgs{Mode: "compare", Fingerprint1: "ffffffffffffffff", Fingerprint2: "0"},
+			text: "Hamming distance: 64 of 64 bits (similarity 0.000)",
+			out:  `{"hamming_distance":64,"similarity":0}`,
+		},
+		{name: "no words", args: SimHashArgs{Text: " ... "}, err: true, text: "Text contains no words to fingerprint"},
+		{name: "shingle too large", args: SimHashArgs{Text: "a b", ShingleSize: 6}, err: true, text: "Shingle size must be between 1 and 5"},
+		{name: "missing fingerprint", args: SimHashArgs{Mode: "compare", Fingerprint1: "ff"}, err: true, text: "'fingerprint2' is required in compare mode"},
+		{name: "bad fingerprint", args: SimHashArgs{Mode: "compare", Fingerprint1: "xyz", Fingerprint2: "ff"}, err: true, text: "'fingerprint1' must be a 64-bit hex fingerprint: xyz"},
+		{name: "too long fingerprint", args: SimHashArgs{Mode: "compare", Fingerprint1: "1ffffffffffffffff", Fingerprint2: "ff"}, err: true, text: "'fingerprint1' must be a 64-bit hex fingerprint: 1ffffffffffffffff"},
+		{name: "unknown mode", args: SimHashArgs{Mode: "minhash", Text: "a"}, err: true, text: "Unsupported mode: minhash (use fingerprint or compare)"},
+	})
+}
+
+func TestSimHashSimilarity(t *testing.T) {
+	const (
+		original  = "The quick brown fox jumps over the lazy dog near the quiet river bank while the farmer watches from his old wooden porch on a warm summer evening"
+		nearDup   = "The quick brown fox jumps over the lazy dog near the quiet river bank while the farmer watches from his old wooden porch on a warm autumn evening"
+		unrelated = "Quarterly revenue grew eight percent as the company expanded cloud services into new markets across Europe and Asia despite currency headwinds"
+	)
+	fingerprint := func(text string) string {
+		_, out := callTool(t, handleSimHash, SimHashArgs{Text: text})
+		return out.(map[string]any)["fingerprint"].(string)
+	}
+	distance := func(a, b string) float64 {
+		_, out := callTool(t, handleSimHash, SimHashArgs{Mode: "compare", Fingerprint1: a, Fingerprint2: b})
+		return numberField(t, out, "hamming_distance")
+	}
+
+	orig, near, other := fingerprint(original), fingerprint(nearDup), fingerprint(unrelated)
+	if d := distance(orig, orig); d != 0 {
+		t.Errorf("identical texts are %g bits apart", d)
+	}
+	nearDistance, otherDistance := distance(orig, near), distance(orig, other)
+	if nearDistance > 8 {
+		t.Errorf("near-identical texts are %g bits apart, want at most 8", nearDistance)
+	}
+	if otherDistance < 16 {
+		t.Errorf("unrelated texts are %g bits apart, want at least 16", otherDistance)
+	}
+	if nearDistance >= otherDistance {
+		t.Errorf("near-duplicate distance %g not below unrelated distance %g", nearDistance, otherDistance)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	generatedSlugsURI      = "slugs://generated"
+	generatedSlugsCapacity = 1000
+)
+
+// slugStore remembers the most recently generated slugs so clients can avoid
+// duplicates across a session. It holds at most capacity entries, evicting
+// the oldest first, and is safe for concurrent use.
+type slugStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    []string
+	seen     map[string]bool
+}
+
+func newSlugStore(capacity int) *slugStore {
+	return &slugStore{capacity: capacity, seen: map[string]bool{}}
+}
+
+// Add records slug and reports whether it had already been recorded.
+func (s *slugStore) Add(slug string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.seen[slug] {
+		return true
+	}
+
+	if len(s.order) >= s.capacity {
+		delete(s.seen, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.order = append(s.order, slug)
+	s.seen[slug] = true
+	return false
+}
+
+// List returns the stored slugs in the order they were first generated.
+func (s *slugStore) List() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.order...)
+}
+
+var generatedSlugs = newSlugStore(generatedSlugsCapacity)
+
+func handleGeneratedSlugsResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	logMsg("[RESOURCE]", "slugs://generated read")
+
+	data, err := json.Marshal(map[string]any{"slugs": generatedSlugs.List()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      generatedSlugsURI,
+				MIMEType: "application/json",
+				Text:     string(data),
+			},
+		},
+	}, nil
+}
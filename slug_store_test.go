@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// resetGeneratedSlugs gives a test an empty slug store of the given capacity.
+func resetGeneratedSlugs(t *testing.T, capacity int) {
+	t.Helper()
+	saved := generatedSlugs
+	generatedSlugs = newSlugStore(capacity)
+	t.Cleanup(func() { generatedSlugs = saved })
+}
+
+func TestSlugifySeenBefore(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+
+	runToolCases(t, handleSlugify, []toolCase[SlugifyArgs]{
+		{name: "first", args: SlugifyArgs{Text: "Hello World", CheckDuplicate: true}, out: `{"slug":"hello-world","seen_before":false}`},
+		{name: "second", args: SlugifyArgs{Text: "Hello World", CheckDuplicate: true}, out: `{"slug":"hello-world","seen_before":true}`},
+		{name: "same slug from other text", args: SlugifyArgs{Text: "hello, world!", CheckDuplicate: true}, out: `{"slug":"hello-world","seen_before":true}`},
+		{name: "new slug", args: SlugifyArgs{Text: "Goodbye", CheckDuplicate: true}, out: `{"slug":"goodbye","seen_before":false}`},
+		{name: "empty slug", args: SlugifyArgs{Text: "!!!", CheckDuplicate: true}, out: `{"slug":"","seen_before":false}`},
+		{name: "empty slug again", args: SlugifyArgs{Text: "???", CheckDuplicate: true}, out: `{"slug":"","seen_before":false}`},
+	})
+	if got, want := generatedSlugs.List(), []string{"hello-world", "goodbye"}; !slices.Equal(got, want) {
+		t.Errorf("generated slugs = %q, want %q", got, want)
+	}
+
+	_, out := callTool(t, handleSlugify, SlugifyArgs{Text: "Hello World"})
+	if _, ok := out.(map[string]any)["seen_before"]; ok {
+		t.Errorf("seen_before reported without check_duplicate: %s", compactJSON(out))
+	}
+}
+
+func TestSlugStoreBounded(t *testing.T) {
+	s := newSlugStore(2)
+	for _, slug := range []string{"a", "b", "a", "c"} {
+		s.Add(slug)
+	}
+	if got, want := s.List(), []string{"b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("List() = %q, want %q", got, want)
+	}
+	if s.Add("a") {
+		t.Error("evicted slug reported as seen")
+	}
+	if !s.Add("c") {
+		t.Error("stored slug not reported as seen")
+	}
+}
+
+func TestSlugStoreConcurrent(t *testing.T) {
+	s := newSlugStore(100)
+	var wg sync.WaitGroup
+	seen := make([]bool, 10)
+	for i := range seen {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seen[i] = s.Add("same")
+		}()
+	}
+	wg.Wait()
+
+	first := 0
+	for _, ok := range seen {
+		if !ok {
+			first++
+		}
+	}
+	if first != 1 {
+		t.Errorf("%d concurrent adds reported the slug as new, want 1", first)
+	}
+	if got := s.List(); len(got) != 1 {
+		t.Errorf("List() = %q, want one slug", got)
+	}
+}
+
+func TestGeneratedSlugsResource(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+	session := connectServer(t, nil)
+
+	for _, text := range []string{"First Post", "Second Post", "first post"} {
+		callRemote(t, session, &mcp.CallToolParams{Name: "slugify", Arguments: map[string]any{"text": text}})
+	}
+
+	res, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: generatedSlugsURI})
+	if err != nil {
+		t.Fatalf("reading %s: %v", generatedSlugsURI, err)
+	}
+	if len(res.Contents) != 1 || res.Contents[0].MIMEType != "application/json" {
+		t.Fatalf("contents = %+v, want one JSON document", res.Contents)
+	}
+	var doc struct {
+		Slugs []string `json:"slugs"`
+	}
+	if err := json.Unmarshal([]byte(res.Contents[0].Text), &doc); err != nil {
+		t.Fatalf("decoding %q: %v", res.Contents[0].Text, err)
+	}
+	if want := []string{"first-post", "second-post"}; !slices.Equal(doc.Slugs, want) {
+		t.Errorf("slugs = %q, want %q", doc.Slugs, want)
+	}
+}
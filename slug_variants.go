@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+)
+
+type slugVariant struct {
+	Label string `json:"label"`
+	Slug  string `json:"slug"`
+}
+
+// maxShortSlugWords is how many keywords the short slug variant keeps.
+const maxShortSlugWords = 3
+
+// slugStopwords are dropped from the keyword-based slug variants: the title
+// case small words plus common filler words that rarely help a URL.
+var slugStopwords = func() map[string]bool {
+	words := map[string]bool{}
+	for _, w := range defaultSmallWords {
+		words[w] = true
+	}
+	for _, w := range []string{
+		"is", "are", "was", "be", "it", "its", "this", "that", "these", "with",
+		"from", "how", "what", "why", "when", "your", "you", "my", "our", "i", "we",
+	} {
+		words[w] = true
+	}
+	return words
+}()
+
+// slugVariants derives alternative slugs from text for A/B tests: the base
+// slug, a keyword-first variant that leads with the longest keyword, a short
+// variant of the first few keywords, and the base without stopwords. Each
+// candidate goes through slugifyWithOptions so it honors the same options as
+// the base, and candidates that repeat an earlier one are left out.
+func slugVariants(text string, args SlugifyArgs) ([]slugVariant, error) {
+	if args.NormalizeWhitespace {
+		text = normalizeWhitespace(text)
+	}
+	words := strings.FieldsFunc(slugify(text), func(r rune) bool { return r == '-' })
+
+	keywords := []string{}
+	keyword := -1
+	for i, w := range words {
+		if slugStopwords[w] {
+			continue
+		}
+		keywords = append(keywords, w)
+		if keyword < 0 || len(w) > len(words[keyword]) {
+			keyword = i
+		}
+	}
+
+	candidates := []slugVariant{{Label: "base", Slug: strings.Join(words, " ")}}
+	if keyword >= 0 {
+		rest := append(append([]string{}, words[:keyword]...), words[keyword+1:]...)
+		candidates = append(candidates,
+			slugVariant{Label: "keyword_first", Slug: strings.Join(append([]string{words[keyword]}, rest...), " ")},
+			slugVariant{Label: "short", Slug: strings.Join(keywords[:min(len(keywords), maxShortSlugWords)], " ")},
+			slugVariant{Label: "no_stopwords", Slug: strings.Join(keywords, " ")},
+		)
+	}
+
+	variants := []slugVariant{}
+	seen := map[string]bool{}
+	for _, c := range candidates {
+		slug, _, err := slugifyWithOptions(c.Slug, args)
+		if err != nil {
+			return nil, err
+		}
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		variants = append(variants, slugVariant{Label: c.Label, Slug: slug})
+	}
+	return variants, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestSlugifyVariants(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+
+	runToolCases(t, handleSlugify, []toolCase[SlugifyArgs]{
+		{
+			name: "multi-word title",
+			args: SlugifyArgs{Text: "The Quick Guide to Building Fast Web Servers", Variants: true},
+			text: "the-quick-guide-to-building-fast-web-servers\n" +
+				"base: the-quick-guide-to-building-fast-web-servers\n" +
+				"keyword_first: building-the-quick-guide-to-fast-web-servers\n" +
+				"short: quick-guide-building\n" +
+				"no_stopwords: quick-guide-building-fast-web-servers",
+			out: `{"slug":"the-quick-guide-to-building-fast-web-servers","variants":[
+				{"label":"base","slug":"the-quick-guide-to-building-fast-web-servers"},
+				{"label":"keyword_first","slug":"building-the-quick-guide-to-fast-web-servers"},
+				{"label":"short","slug":"quick-guide-building"},
+				{"label":"no_stopwords","slug":"quick-guide-building-fast-web-servers"}]}`,
+		},
+		{
+			name: "repeated candidate dropped",
+			args: SlugifyArgs{Text: "How to Learn Go", Variants: true},
+			text: "how-to-learn-go\nbase: how-to-learn-go\nkeyword_first: learn-how-to-go\nshort: learn-go",
+			out: `{"variants":[
+				{"label":"base","slug":"how-to-learn-go"},
+				{"label":"keyword_first","slug":"learn-how-to-go"},
+				{"label":"short","slug":"learn-go"}]}`,
+		},
+		{
+			name: "single word",
+			args: SlugifyArgs{Text: "Go", Variants: true},
+			text: "go\nbase: go",
+			out:  `{"variants":[{"label":"base","slug":"go"}]}`,
+		},
+		{
+			name: "only stopwords",
+			args: SlugifyArgs{Text: "the of and", Variants: true},
+			out:  `{"variants":[{"label":"base","slug":"the-of-and"}]}`,
+		},
+		{
+			name: "options apply to variants",
+			args: SlugifyArgs{Text: "10 Tips for Writing Go", Variants: true, NoLeadingDigit: "prefix"},
+			out: `{"slug":"n-10-tips-for-writing-go","variants":[
+				{"label":"base","slug":"n-10-tips-for-writing-go"},
+				{"label":"keyword_first","slug":"writing-10-tips-for-go"},
+				{"label":"short","slug":"n-10-tips-writing"},
+				{"label":"no_stopwords","slug":"n-10-tips-writing-go"}]}`,
+		},
+	})
+
+	_, out := callTool(t, handleSlugify, SlugifyArgs{Text: "Hello World"})
+	if _, ok := out.(map[string]any)["variants"]; ok {
+		t.Errorf("variants reported without the flag: %s", compactJSON(out))
+	}
+}
+
+func TestSlugifyVariantsDistinctAndValid(t *testing.T) {
+	validSlug := regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	titles := []string{
+		"The Quick Guide to Building Fast Web Servers",
+		"Why Your Tests Are Slow and How to Fix Them",
+		"Getting Started With Kubernetes Operators in Go",
+	}
+	for _, title := range titles {
+		variants, err := slugVariants(title, SlugifyArgs{Text: title})
+		if err != nil {
+			t.Fatalf("slugVariants(%q): %v", title, err)
+		}
+		if len(variants) < 2 {
+			t.Errorf("slugVariants(%q) = %v, want several candidates", title, variants)
+		}
+		seen := map[string]bool{}
+		for _, v := range variants {
+			if !validSlug.MatchString(v.Slug) {
+				t.Errorf("%q: %s variant %q is not a valid slug", title, v.Label, v.Slug)
+			}
+			if seen[v.Slug] {
+				t.Errorf("%q: %s variant %q repeats an earlier one", title, v.Label, v.Slug)
+			}
+			seen[v.Slug] = true
+		}
+	}
+}
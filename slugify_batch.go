@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SlugifyBatchArgs struct {
+	Texts []string `json:"texts" jsonschema:"The strings to slugify, in order (at most 1000)"`
+}
+
+type batchSlug struct {
+	Input string `json:"input"`
+	Slug  string `json:"slug"`
+}
+
+// uniqueSlugs slugifies each text and disambiguates collisions within the set
+// by appending -2, -3, and so on in input order. A suffixed candidate that is
+// itself produced by another input is skipped, so every returned slug is
+// distinct.
+func uniqueSlugs(texts []string) []batchSlug {
+	bases := make([]string, len(texts))
+	taken := make(map[string]bool, len(texts))
+	for i, text := range texts {
+		bases[i] = slugify(text)
+	}
+
+	results := make([]batchSlug, len(texts))
+	counts := make(map[string]int, len(texts))
+	for i, base := range bases {
+		slug := base
+		if taken[slug] {
+			for n := max(counts[base], 1) + 1; ; n++ {
+				candidate := strings.TrimPrefix(base+"-"+strconv.Itoa(n), "-")
+				if !taken[candidate] {
+					slug = candidate
+					counts[base] = n
+					break
+				}
+			}
+		}
+		taken[slug] = true
+		results[i] = batchSlug{Input: texts[i], Slug: slug}
+	}
+	return results
+}
+
+func handleSlugifyBatch(ctx context.Context, req *mcp.CallToolRequest, args SlugifyBatchArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("slugify_batch called with %d texts", len(args.Texts)))
+
+	if len(args.Texts) == 0 {
+		return errorResult("Please provide at least one text"), nil, nil
+	}
+	if len(args.Texts) > 1000 {
+		return errorResult("At most 1000 texts can be slugified at once"), nil, nil
+	}
+
+	for i, text := range args.Texts {
+		if slugify(text) == "" {
+			return errorResult(fmt.Sprintf("Text %d produces an empty slug: %q", i+1, text)), nil, nil
+		}
+	}
+
+	results := uniqueSlugs(args.Texts)
+	lines := make([]string, len(results))
+	for i, r := range results {
+		generatedSlugs.Add(r.Slug)
+		lines[i] = r.Slug
+	}
+
+	return textResult(strings.Join(lines, "\n")), map[string]any{"slugs": results}, nil
+}
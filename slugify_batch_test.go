@@ -0,0 +1,63 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSlugifyBatch(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+
+	runToolCases(t, handleSlugifyBatch, []toolCase[SlugifyBatchArgs]{
+		{
+			name: "collapse to one base",
+			args: SlugifyBatchArgs{Texts: []string{"Hello World", "hello world", "Hello, World!", "Other"}},
+			text: "hello-world\nhello-world-2\nhello-world-3\nother",
+			out: `{"slugs":[
+				{"input":"Hello World","slug":"hello-world"},
+				{"input":"hello world","slug":"hello-world-2"},
+				{"input":"Hello, World!","slug":"hello-world-3"},
+				{"input":"Other","slug":"other"}]}`,
+		},
+		{
+			name: "suffix already taken",
+			args: SlugifyBatchArgs{Texts: []string{"Post 2", "Post", "post", "POST"}},
+			text: "post-2\npost\npost-3\npost-4",
+		},
+		{
+			name: "later input matches a suffix",
+			args: SlugifyBatchArgs{Texts: []string{"a", "a", "a-2"}},
+			text: "a\na-2\na-2-2",
+		},
+		{name: "single", args: SlugifyBatchArgs{Texts: []string{"Just One"}}, text: "just-one", out: `{"slugs":[{"input":"Just One","slug":"just-one"}]}`},
+		{name: "empty slug", args: SlugifyBatchArgs{Texts: []string{"fine", "!!!"}}, err: true, text: `Text 2 produces an empty slug: "!!!"`},
+		{name: "no texts", args: SlugifyBatchArgs{}, err: true, text: "Please provide at least one text"},
+		{name: "too many", args: SlugifyBatchArgs{Texts: make([]string, 1001)}, err: true, text: "At most 1000 texts can be slugified at once"},
+	})
+}
+
+func TestSlugifyBatchDistinct(t *testing.T) {
+	resetGeneratedSlugs(t, generatedSlugsCapacity)
+
+	texts := strings.Fields("x x-2 x x x-3 x-2 x")
+	result, _ := callTool(t, handleSlugifyBatch, SlugifyBatchArgs{Texts: texts})
+	slugs := strings.Split(resultText(result), "\n")
+	if len(slugs) != len(texts) {
+		t.Fatalf("got %d slugs for %d texts", len(slugs), len(texts))
+	}
+	seen := map[string]bool{}
+	for _, s := range slugs {
+		if seen[s] {
+			t.Errorf("slug %q returned twice in %q", s, slugs)
+		}
+		seen[s] = true
+	}
+
+	generated := generatedSlugs.List()
+	slices.Sort(generated)
+	slices.Sort(slugs)
+	if !slices.Equal(generated, slugs) {
+		t.Errorf("generated slugs = %q, want %q", generated, slugs)
+	}
+}
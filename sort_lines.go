@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SortLinesArgs struct {
+	Text                string `json:"text" jsonschema:"The text whose lines to sort"`
+	Reverse             bool   `json:"reverse,omitempty" jsonschema:"Sort in descending order"`
+	CaseInsensitive     bool   `json:"case_insensitive,omitempty" jsonschema:"Ignore case when comparing lines"`
+	Numeric             bool   `json:"numeric,omitempty" jsonschema:"Compare lines as numbers; lines that are not numbers sort after all numeric lines"`
+	Unique              bool   `json:"unique,omitempty" jsonschema:"Keep only the first of lines that compare equal"`
+	TrimTrailingNewline bool   `json:"trim_trailing_newline,omitempty" jsonschema:"Drop the input's trailing newline from the output instead of preserving it"`
+}
+
+// lineComparer returns the comparison used by sort_lines. Numeric comparison
+// parses each trimmed line as a float64; numeric lines order before other
+// lines, which fall back to text comparison.
+func lineComparer(numeric, fold bool) func(a, b string) int {
+	text := func(a, b string) int {
+		if fold {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		}
+		return strings.Compare(a, b)
+	}
+	if !numeric {
+		return text
+	}
+	return func(a, b string) int {
+		x, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+		y, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+		switch {
+		case errA == nil && errB == nil:
+			if x < y {
+				return -1
+			}
+			if x > y {
+				return 1
+			}
+			return 0
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		}
+		return text(a, b)
+	}
+}
+
+func handleSortLines(ctx context.Context, req *mcp.CallToolRequest, args SortLinesArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("sort_lines called with %d bytes", len(args.Text)))
+
+	newline := "\n"
+	if strings.Contains(args.Text, "\r\n") {
+		newline = "\r\n"
+	}
+	body, trailing := strings.CutSuffix(args.Text, newline)
+	if body == "" && !trailing {
+		return textResult(""), map[string]any{"text": "", "lines": 0}, nil
+	}
+	lines := strings.Split(body, newline)
+
+	compare := lineComparer(args.Numeric, args.CaseInsensitive)
+	slices.SortStableFunc(lines, compare)
+	if args.Unique {
+		lines = slices.CompactFunc(lines, func(a, b string) bool { return compare(a, b) == 0 })
+	}
+	if args.Reverse {
+		slices.Reverse(lines)
+	}
+
+	output := strings.Join(lines, newline)
+	if trailing && !args.TrimTrailingNewline {
+		output += newline
+	}
+
+	return textResult(output), map[string]any{"text": output, "lines": len(lines)}, nil
+}
@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestSortLines(t *testing.T) {
+	runToolCases(t, handleSortLines, []toolCase[SortLinesArgs]{
+		{name: "lexical", args: SortLinesArgs{Text: "10\n9\n100\n2\n"}, text: "10\n100\n2\n9\n", out: `{"text":"10\n100\n2\n9\n","lines":4}`},
+		{name: "numeric", args: SortLinesArgs{Text: "10\n9\n100\n2\n", Numeric: true}, text: "2\n9\n10\n100\n", out: `{"lines":4}`},
+		{name: "numeric with text", args: SortLinesArgs{Text: "b\n 3\n-1.5\na\n1e2", Numeric: true}, text: "-1.5\n 3\n1e2\na\nb"},
+		{name: "numeric reverse", args: SortLinesArgs{Text: "10\n9\n100\n2", Numeric: true, Reverse: true}, text: "100\n10\n9\n2"},
+		{name: "unique", args: SortLinesArgs{Text: "b\na\nb\na\nc", Unique: true}, text: "a\nb\nc", out: `{"text":"a\nb\nc","lines":3}`},
+		{name: "unique keeps case variants", args: SortLinesArgs{Text: "apple\nApple\napple", Unique: true}, text: "Apple\napple"},
+		{name: "unique case-insensitive", args: SortLinesArgs{Text: "Apple\nbanana\napple\nAPPLE", Unique: true, CaseInsensitive: true}, text: "Apple\nbanana", out: `{"lines":2}`},
+		{name: "unique numeric", args: SortLinesArgs{Text: "1\n1.0\n01\n2", Unique: true, Numeric: true}, text: "1\n2"},
+		{name: "case-insensitive", args: SortLinesArgs{Text: "b\nA\nc\nB", CaseInsensitive: true}, text: "A\nb\nB\nc"},
+		{name: "trim trailing newline", args: SortLinesArgs{Text: "b\na\n", TrimTrailingNewline: true}, text: "a\nb"},
+		{name: "CRLF", args: SortLinesArgs{Text: "b\r\na\r\n"}, text: "a\r\nb\r\n"},
+		{name: "empty", args: SortLinesArgs{Text: ""}, text: "", out: `{"text":"","lines":0}`},
+		{name: "blank line", args: SortLinesArgs{Text: "\n"}, text: "\n", out: `{"lines":1}`},
+	})
+}
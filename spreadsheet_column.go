@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type SpreadsheetColumnArgs struct {
+	Column *string `json:"column,omitempty" jsonschema:"Column letters to convert to a 1-based index (e.g. A, AA, XFD)"`
+	Index  *int64  `json:"index,omitempty" jsonschema:"1-based column index to convert to letters"`
+}
+
+// maxColumnLetters keeps conversions within int64; 13 letters already exceed
+// any real spreadsheet by many orders of magnitude.
+const maxColumnLetters = 13
+
+// columnToIndex converts spreadsheet column letters to a 1-based index using
+// bijective base 26: A=1 ... Z=26, AA=27.
+func columnToIndex(column string) (int64, error) {
+	column = strings.ToUpper(strings.TrimSpace(column))
+	if column == "" {
+		return 0, fmt.Errorf("column must not be empty")
+	}
+	if len(column) > maxColumnLetters {
+		return 0, fmt.Errorf("column must have at most %d letters", maxColumnLetters)
+	}
+
+	var index int64
+	for _, r := range column {
+		if r < 'A' || r > 'Z' {
+			return 0, fmt.Errorf("column must contain only letters A-Z: %s", column)
+		}
+		index = index*26 + int64(r-'A'+1)
+	}
+	return index, nil
+}
+
+// indexToColumn converts a positive 1-based index to spreadsheet column
+// letters.
+func indexToColumn(index int64) string {
+	var letters []byte
+	for index > 0 {
+		index--
+		letters = append(letters, byte('A'+index%26))
+		index /= 26
+	}
+	for i, j := 0, len(letters)-1; i < j; i, j = i+1, j-1 {
+		letters[i], letters[j] = letters[j], letters[i]
+	}
+	return string(letters)
+}
+
+func handleSpreadsheetColumn(ctx context.Context, req *mcp.CallToolRequest, args SpreadsheetColumnArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", "spreadsheet_column called")
+
+	if args.Column != nil && args.Index != nil {
+		return errorResult("Please provide either 'column' or 'index', not both"), nil, nil
+	}
+	if args.Column == nil && args.Index == nil {
+		return errorResult("Please provide either 'column' or 'index'"), nil, nil
+	}
+
+	if args.Index != nil {
+		if *args.Index < 1 {
+			return errorResult("Index must be a positive integer"), nil, nil
+		}
+		column := indexToColumn(*args.Index)
+		return textResult(column), map[string]any{"column": column, "index": *args.Index}, nil
+	}
+
+	index, err := columnToIndex(*args.Column)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid column: %v", err)), nil, nil
+	}
+	return textResult(fmt.Sprintf("%d", index)),
+		map[string]any{"column": strings.ToUpper(strings.TrimSpace(*args.Column)), "index": index}, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSpreadsheetColumn(t *testing.T) {
+	runToolCases(t, handleSpreadsheetColumn, []toolCase[SpreadsheetColumnArgs]{
+		{name: "A", args: SpreadsheetColumnArgs{Column: ptr("A")}, text: "1", out: `{"column":"A","index":1}`},
+		{name: "Z", args: SpreadsheetColumnArgs{Column: ptr("Z")}, text: "26", out: `{"column":"Z","index":26}`},
+		{name: "AA", args: SpreadsheetColumnArgs{Column: ptr("AA")}, text: "27", out: `{"column":"AA","index":27}`},
+		{name: "lowercase padded", args: SpreadsheetColumnArgs{Column: ptr(" xfd ")}, text: "16384", out: `{"column":"XFD","index":16384}`},
+		{name: "longest", args: SpreadsheetColumnArgs{Column: ptr(strings.Repeat("Z", 13))}, text: "2580398988131886038"},
+		{name: "index 1", args: SpreadsheetColumnArgs{Index: ptr[int64](1)}, text: "A", out: `{"column":"A","index":1}`},
+		{name: "index 702", args: SpreadsheetColumnArgs{Index: ptr[int64](702)}, text: "ZZ"},
+		{name: "index 703", args: SpreadsheetColumnArgs{Index: ptr[int64](703)}, text: "AAA"},
+		{name: "large index", args: SpreadsheetColumnArgs{Index: ptr[int64](1_000_000_000_000_000)}, text: "GBDPXGRZXJL", out: `{"column":"GBDPXGRZXJL","index":1000000000000000}`},
+		{name: "digits", args: SpreadsheetColumnArgs{Column: ptr("A1")}, err: true, text: "Invalid column: column must contain only letters A-Z: A1"},
+		{name: "empty", args: SpreadsheetColumnArgs{Column: ptr(" ")}, err: true, text: "Invalid column: column must not be empty"},
+		{name: "too long", args: SpreadsheetColumnArgs{Column: ptr(strings.Repeat("A", 14))}, err: true, text: "Invalid column: column must have at most 13 letters"},
+		{name: "zero index", args: SpreadsheetColumnArgs{Index: ptr[int64](0)}, err: true, text: "Index must be a positive integer"},
+		{name: "both", args: SpreadsheetColumnArgs{Column: ptr("A"), Index: ptr[int64](1)}, err: true, text: "Please provide either 'column' or 'index', not both"},
+		{name: "neither", args: SpreadsheetColumnArgs{}, err: true, text: "Please provide either 'column' or 'index'"},
+	})
+}
+
+func TestSpreadsheetColumnRoundTrip(t *testing.T) {
+	for _, index := range []int64{1, 26, 27, 52, 53, 702, 703, 16384, 18278, 18279, 123456789, 2580398988131886038} {
+		column := indexToColumn(index)
+		got, err := columnToIndex(column)
+		if err != nil {
+			t.Errorf("columnToIndex(%q): %v", column, err)
+			continue
+		}
+		if got != index {
+			t.Errorf("%d -> %q -> %d", index, column, got)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type StringToColorArgs struct {
+	Text       string   `json:"text" jsonschema:"The string to derive a color from, such as a tag or label name"`
+	Saturation *float64 `json:"saturation,omitempty" jsonschema:"HSL saturation in percent (0-100, default 65)"`
+	Lightness  *float64 `json:"lightness,omitempty" jsonschema:"HSL lightness in percent (0-100, default 45)"`
+}
+
+// hslToRGB converts a hue in degrees and saturation and lightness in [0, 1]
+// to 8-bit RGB components.
+func hslToRGB(h, s, l float64) (int, int, int) {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	channel := func(v float64) int { return int(math.Round((v + m) * 255)) }
+	return channel(r), channel(g), channel(b)
+}
+
+func handleStringToColor(ctx context.Context, req *mcp.CallToolRequest, args StringToColorArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("string_to_color called with text: %s", args.Text))
+
+	saturation, lightness := 65.0, 45.0
+	if args.Saturation != nil {
+		saturation = *args.Saturation
+	}
+	if args.Lightness != nil {
+		lightness = *args.Lightness
+	}
+	if !(saturation >= 0 && saturation <= 100) || !(lightness >= 0 && lightness <= 100) {
+		return errorResult("Saturation and lightness must be between 0 and 100"), nil, nil
+	}
+
+	// Only the hue comes from the hash; fixed saturation and lightness keep
+	// every color equally readable. The hash is a stable SHA-256, so the
+	// mapping never changes between runs or versions.
+	sum := sha256.Sum256([]byte(args.Text))
+	hue := int(binary.BigEndian.Uint32(sum[:4]) % 360)
+
+	r, g, b := hslToRGB(float64(hue), saturation/100, lightness/100)
+	hex := fmt.Sprintf("#%02x%02x%02x", r, g, b)
+
+	return textResult(fmt.Sprintf("%s hsl(%d, %g%%, %g%%)", hex, hue, saturation, lightness)),
+		map[string]any{
+			"hex": hex,
+			"hsl": map[string]any{"h": hue, "s": saturation, "l": lightness},
+			"rgb": map[string]int{"r": r, "g": g, "b": b},
+		}, nil
+}
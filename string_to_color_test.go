@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStringToColor(t *testing.T) {
+	runToolCases(t, handleStringToColor, []toolCase[StringToColorArgs]{
+		{
+			name: "default saturation and lightness",
+			args: StringToColorArgs{Text: "bug"},
+			text: "#bdae28 hsl(54, 65%, 45%)",
+			out:  `{"hex":"#bdae28","hsl":{"h":54,"s":65,"l":45},"rgb":{"r":189,"g":174,"b":40}}`,
+		},
+		{
+			name: "custom saturation and lightness",
+			args: StringToColorArgs{Text: "feature", Saturation: ptr(100.0), Lightness: ptr(50.0)},
+			text: "#fff200 hsl(57, 100%, 50%)",
+			out:  `{"hex":"#fff200","hsl":{"h":57,"s":100,"l":50}}`,
+		},
+		{name: "grey at zero saturation", args: StringToColorArgs{Text: "bug", Saturation: ptr(0.0), Lightness: ptr(50.0)}, out: `{"hex":"#808080"}`},
+		{name: "empty text", args: StringToColorArgs{}, out: `{"hsl":{"h":50,"s":65,"l":45}}`},
+		{name: "saturation out of range", args: StringToColorArgs{Text: "bug", Saturation: ptr(101.0)}, err: true, text: "Saturation and lightness must be between 0 and 100"},
+		{name: "negative lightness", args: StringToColorArgs{Text: "bug", Lightness: ptr(-1.0)}, err: true, text: "Saturation and lightness must be between 0 and 100"},
+	})
+}
+
+func TestStringToColorStable(t *testing.T) {
+	hueOf := func(text string) string {
+		t.Helper()
+		result, out := callTool(t, handleStringToColor, StringToColorArgs{Text: text})
+		if result.IsError {
+			t.Fatalf("%q: %s", text, resultText(result))
+		}
+		return compactJSON(out.(map[string]any)["hsl"].(map[string]any)["h"])
+	}
+
+	hues := map[string]bool{}
+	const n = 50
+	for i := 0; i < n; i++ {
+		text := fmt.Sprintf("label-%d", i)
+		hue := hueOf(text)
+		if again := hueOf(text); again != hue {
+			t.Errorf("%q: hue %s, then %s", text, hue, again)
+		}
+		hues[hue] = true
+	}
+	// 50 labels hashed into 360 hues collide a few times at most.
+	if len(hues) < n-5 {
+		t.Errorf("%d labels gave only %d distinct hues", n, len(hues))
+	}
+}
+
+func TestHSLToRGB(t *testing.T) {
+	tests := []struct {
+		h, s, l float64
+		r, g, b int
+	}{
+		{0, 1, 0.5, 255, 0, 0},
+		{120, 1, 0.5, 0, 255, 0},
+		{240, 1, 0.5, 0, 0, 255},
+		{300, 1, 0.25, 128, 0, 128},
+		{0, 0, 1, 255, 255, 255},
+		{200, 0.5, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		if r, g, b := hslToRGB(tt.h, tt.s, tt.l); r != tt.r || g != tt.g || b != tt.b {
+			t.Errorf("hslToRGB(%g, %g, %g) = %d, %d, %d; want %d, %d, %d", tt.h, tt.s, tt.l, r, g, b, tt.r, tt.g, tt.b)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// isStructuredOnly reports whether a call's result should carry only
+// structured content: either the server runs with --structured-only, or the
+// request sets "structured_only": true in its _meta.
+func isStructuredOnly(req *mcp.CallToolRequest) bool {
+	return config.StructuredOnly || metaFlag(req, "structured_only")
+}
+
+// withStructuredOnly wraps a handler so that structured-only calls return an
+// empty content list alongside the structured result. Error results and
+// results without structured output keep their text, since it is then the
+// only payload. The Content slice is left empty rather than nil so that the
+// SDK does not fill it with the serialized structured result.
+func withStructuredOnly[In, Out any](handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, Out, error) {
+		result, out, err := handler(ctx, req, args)
+		if err != nil || result == nil || result.IsError || any(out) == nil || !isStructuredOnly(req) {
+			return result, out, err
+		}
+
+		result.Content = []mcp.Content{}
+		return result, out, nil
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestStructuredOnly(t *testing.T) {
+	valid, invalid := true, false
+	tests := []struct {
+		name        string
+		cfg         serverConfig
+		params      *mcp.CallToolParams
+		wantErr     bool
+		wantText    string
+		wantOut     string
+		wantNoOut   bool
+		wantValid   *bool
+		wantContent int
+	}{
+		{
+			name:        "flag drops text",
+			cfg:         serverConfig{StructuredOnly: true},
+			params:      &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "celsius", "to_unit": "fahrenheit"}},
+			wantOut:     `{"result":212}`,
+			wantContent: 0,
+		},
+		{
+			name:        "meta drops text",
+			params:      &mcp.CallToolParams{Name: "count_occurrences", Arguments: map[string]any{"text": "a b a", "pattern": "a"}, Meta: mcp.Meta{"structured_only": true}},
+			wantOut:     `{"count":2}`,
+			wantContent: 0,
+		},
+		{
+			name:        "text kept by default",
+			params:      &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "celsius", "to_unit": "fahrenheit"}},
+			wantText:    "212.00",
+			wantOut:     `{"result":212}`,
+			wantContent: 1,
+		},
+		{
+			name:        "meta false keeps text",
+			params:      &mcp.CallToolParams{Name: "count_occurrences", Arguments: map[string]any{"text": "a b a", "pattern": "a"}, Meta: mcp.Meta{"structured_only": false}},
+			wantOut:     `{"count":2}`,
+			wantContent: 1,
+		},
+		{
+			name:        "no structured output keeps text",
+			cfg:         serverConfig{StructuredOnly: true},
+			params:      &mcp.CallToolParams{Name: "xml_format", Arguments: map[string]any{"xml": "<a><b/></a>", "mode": "minify"}},
+			wantText:    "<a><b/></a>",
+			wantNoOut:   true,
+			wantContent: 1,
+		},
+		{
+			name:        "error keeps text",
+			cfg:         serverConfig{StructuredOnly: true},
+			params:      &mcp.CallToolParams{Name: "word_count", Arguments: map[string]any{"text": "a", "texts": []string{"b"}}},
+			wantErr:     true,
+			wantText:    "Please provide either 'text' or 'texts', not both",
+			wantNoOut:   true,
+			wantContent: 1,
+		},
+		{
+			name:        "dry run keeps text",
+			cfg:         serverConfig{StructuredOnly: true, ValidateOnly: true},
+			params:      &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "celsius", "to_unit": "fahrenheit"}},
+			wantText:    "Validation passed; the operation was not performed",
+			wantNoOut:   true,
+			wantValid:   &valid,
+			wantContent: 1,
+		},
+		{
+			name:        "failed dry run keeps text",
+			cfg:         serverConfig{StructuredOnly: true, ValidateOnly: true},
+			params:      &mcp.CallToolParams{Name: "temperature_convert", Arguments: map[string]any{"value": 100, "from_unit": "rankine", "to_unit": "fahrenheit"}},
+			wantErr:     true,
+			wantText:    "Validation failed:\n- unknown unit: rankine",
+			wantNoOut:   true,
+			wantValid:   &invalid,
+			wantContent: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setConfig(t, tt.cfg)
+			session := connectServer(t, nil)
+
+			result, out := callRemote(t, session, tt.params)
+			if result.IsError != tt.wantErr {
+				t.Fatalf("IsError = %t, want %t (%q)", result.IsError, tt.wantErr, resultText(result))
+			}
+			if len(result.Content) != tt.wantContent {
+				t.Errorf("got %d content blocks, want %d", len(result.Content), tt.wantContent)
+			}
+			if tt.wantText != "" && resultText(result) != tt.wantText {
+				t.Errorf("text = %q, want %q", resultText(result), tt.wantText)
+			}
+			if tt.wantNoOut && out != nil {
+				t.Errorf("structured content = %s, want none", compactJSON(out))
+			}
+			if tt.wantOut != "" {
+				checkOutput(t, out, tt.wantOut)
+			}
+			if tt.wantValid != nil {
+				validation, _ := result.Meta["validation"].(map[string]any)
+				if got, ok := validation["valid"].(bool); !ok || got != *tt.wantValid {
+					t.Errorf("_meta.validation = %v, want valid %t", result.Meta["validation"], *tt.wantValid)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSyllables(t *testing.T) {
+	runToolCases(t, handleSyllables, []toolCase[SyllablesArgs]{
+		{
+			name: "known words",
+			args: SyllablesArgs{Text: "Apple, rhythm and queue."},
+			text: "Syllables: 6 across 4 words (estimated)",
+			out: `{"total":6,"approximate":true,"words":[
+				{"word":"Apple","syllables":2},
+				{"word":"rhythm","syllables":2},
+				{"word":"and","syllables":1},
+				{"word":"queue","syllables":1}]}`,
+		},
+		{
+			name: "apostrophes",
+			args: SyllablesArgs{Text: "don't 'quote'"},
+			out:  `{"total":2,"words":[{"word":"don't","syllables":1},{"word":"'quote'","syllables":1}]}`,
+		},
+		{name: "no words", args: SyllablesArgs{Text: "123 ... !"}, text: "Syllables: 0 across 0 words (estimated)", out: `{"total":0,"words":[]}`},
+	})
+}
+
+func TestCountSyllables(t *testing.T) {
+	known := map[string]int{
+		"apple": 2, "rhythm": 2, "queue": 1, "table": 2, "make": 1, "makes": 1,
+		"jumped": 1, "wanted": 2, "boxes": 2, "beautiful": 3, "yellow": 2, "free": 1,
+		"the": 1, "syllable": 3, "computer": 3, "people": 2, "idea": 3, "okay": 2, "a": 1,
+	}
+	for word, want := range known {
+		for _, w := range []string{word, strings.ToUpper(word)} {
+			if got := countSyllables(w); got != want {
+				t.Errorf("countSyllables(%q) = %d, want %d", w, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type TitleCaseArgs struct {
+	Text       string   `json:"text" jsonschema:"The text to convert to title case"`
+	SmallWords []string `json:"small_words,omitempty" jsonschema:"Words to keep lowercase unless first or last. Replaces the default list of articles, conjunctions, and short prepositions"`
+}
+
+// defaultSmallWords follows AP style: articles, coordinating conjunctions,
+// and prepositions of three letters or fewer stay lowercase mid-title.
+var defaultSmallWords = []string{
+	"a", "an", "the",
+	"and", "but", "for", "nor", "or", "so", "yet",
+	"as", "at", "by", "in", "of", "off", "on", "per", "to", "up", "via", "vs",
+}
+
+var titleWordPattern = regexp.MustCompile(`\S+`)
+
+// capitalizeWord uppercases the first letter of word and lowercases the rest,
+// unless the word already contains capitals after its first letter (such as
+// "iPhone" or "NASA"), in which case it is left alone.
+func capitalizeWord(word string) string {
+	runes := []rune(word)
+	first := -1
+	for i, r := range runes {
+		if unicode.IsLetter(r) {
+			if first < 0 {
+				first = i
+			} else if unicode.IsUpper(r) {
+				return word
+			}
+		}
+	}
+	if first < 0 {
+		return word
+	}
+
+	for i := first + 1; i < len(runes); i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	runes[first] = unicode.ToUpper(runes[first])
+	return string(runes)
+}
+
+func titleCase(text string, smallWords []string) string {
+	small := make(map[string]bool, len(smallWords))
+	for _, w := range smallWords {
+		small[strings.ToLower(w)] = true
+	}
+
+	locs := titleWordPattern.FindAllStringIndex(text, -1)
+	var b strings.Builder
+	prev := 0
+	for i, loc := range locs {
+		word := text[loc[0]:loc[1]]
+		b.WriteString(text[prev:loc[0]])
+		prev = loc[1]
+
+		bare := strings.ToLower(strings.TrimFunc(word, func(r rune) bool { return !unicode.IsLetter(r) }))
+		afterColon := i > 0 && strings.HasSuffix(text[locs[i-1][0]:locs[i-1][1]], ":")
+		if i > 0 && i < len(locs)-1 && !afterColon && small[bare] {
+			b.WriteString(strings.ToLower(word))
+		} else {
+			b.WriteString(capitalizeWord(word))
+		}
+	}
+	b.WriteString(text[prev:])
+
+	return b.String()
+}
+
+func handleTitleCase(ctx context.Context, req *mcp.CallToolRequest, args TitleCaseArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("title_case called with text: %s", args.Text))
+
+	smallWords := defaultSmallWords
+	if args.SmallWords != nil {
+		smallWords = args.SmallWords
+	}
+
+	titled := titleCase(args.Text, smallWords)
+
+	return textResult(titled), map[string]any{"text": titled}, nil
+}
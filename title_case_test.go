@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestTitleCase(t *testing.T) {
+	runToolCases(t, handleTitleCase, []toolCase[TitleCaseArgs]{
+		{
+			name: "lord of the rings",
+			args: TitleCaseArgs{Text: "the lord of the rings"},
+			text: "The Lord of the Rings",
+			out:  `{"text":"The Lord of the Rings"}`,
+		},
+		{
+			name: "tale of two cities",
+			args: TitleCaseArgs{Text: "a tale of two cities"},
+			text: "A Tale of Two Cities",
+			out:  `{"text":"A Tale of Two Cities"}`,
+		},
+		{
+			name: "last word capitalized",
+			args: TitleCaseArgs{Text: "what are you looking at"},
+			text: "What Are You Looking At",
+		},
+		{
+			name: "after colon",
+			args: TitleCaseArgs{Text: "star wars: a new hope"},
+			text: "Star Wars: A New Hope",
+		},
+		{
+			name: "mixed case kept",
+			args: TitleCaseArgs{Text: "using the iPhone at NASA"},
+			text: "Using the iPhone at NASA",
+		},
+		{
+			name: "custom small words",
+			args: TitleCaseArgs{Text: "the lord of the rings", SmallWords: []string{"lord"}},
+			text: "The lord Of The Rings",
+		},
+		{
+			name: "empty small words",
+			args: TitleCaseArgs{Text: "the lord of the rings", SmallWords: []string{}},
+			text: "The Lord Of The Rings",
+		},
+	})
+}
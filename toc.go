@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type TOCArgs struct {
+	Markdown string `json:"markdown" jsonschema:"The markdown document to scan for headings"`
+	MaxDepth int    `json:"max_depth,omitempty" jsonschema:"Deepest heading level to include (1-6, default 6)"`
+}
+
+type tocEntry struct {
+	Level    int         `json:"level"`
+	Text     string      `json:"text"`
+	Anchor   string      `json:"anchor"`
+	Children []*tocEntry `json:"children"`
+}
+
+var (
+	atxHeadingPattern  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	codeFencePattern   = regexp.MustCompile("^ {0,3}(```|~~~)")
+	closingHashPattern = regexp.MustCompile(`^#+$`)
+)
+
+// markdownHeadings returns the ATX headings in a document, skipping fenced
+// code blocks. Anchors are slugify output made unique across the whole
+// document the same way as slugify_batch.
+func markdownHeadings(markdown string) []*tocEntry {
+	var headings []*tocEntry
+	fence := ""
+	for _, line := range strings.Split(markdown, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if m := codeFencePattern.FindStringSubmatch(line); m != nil {
+			switch fence {
+			case "":
+				fence = m[1]
+			case m[1]:
+				fence = ""
+			}
+			continue
+		}
+		if fence != "" {
+			continue
+		}
+
+		m := atxHeadingPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		text := strings.TrimSpace(m[2])
+		if closingHashPattern.MatchString(text) {
+			text = ""
+		}
+		headings = append(headings, &tocEntry{Level: len(m[1]), Text: text, Children: []*tocEntry{}})
+	}
+
+	texts := make([]string, len(headings))
+	for i, h := range headings {
+		texts[i] = h.Text
+	}
+	for i, s := range uniqueSlugs(texts) {
+		headings[i].Anchor = s.Slug
+	}
+	return headings
+}
+
+// nestHeadings arranges headings into a tree, each heading becoming a child
+// of the nearest preceding heading with a lower level.
+func nestHeadings(headings []*tocEntry) []*tocEntry {
+	roots := []*tocEntry{}
+	var stack []*tocEntry
+	for _, h := range headings {
+		for len(stack) > 0 && stack[len(stack)-1].Level >= h.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, h)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, h)
+		}
+		stack = append(stack, h)
+	}
+	return roots
+}
+
+func renderTOC(b *strings.Builder, entries []*tocEntry, depth int) {
+	for _, e := range entries {
+		fmt.Fprintf(b, "%s- [%s](#%s)\n", strings.Repeat("  ", depth), e.Text, e.Anchor)
+		renderTOC(b, e.Children, depth+1)
+	}
+}
+
+func handleTOC(ctx context.Context, req *mcp.CallToolRequest, args TOCArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("toc called with %d bytes", len(args.Markdown)))
+
+	maxDepth := args.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = 6
+	}
+	if maxDepth < 1 || maxDepth > 6 {
+		return errorResult("Max depth must be between 1 and 6"), nil, nil
+	}
+
+	var included []*tocEntry
+	for _, h := range markdownHeadings(args.Markdown) {
+		if h.Level <= maxDepth {
+			included = append(included, h)
+		}
+	}
+	if len(included) == 0 {
+		return errorResult("No headings found"), nil, nil
+	}
+
+	tree := nestHeadings(included)
+	var b strings.Builder
+	renderTOC(&b, tree, 0)
+	rendered := strings.TrimSuffix(b.String(), "\n")
+
+	return textResult(rendered), map[string]any{
+		"markdown": rendered,
+		"headings": len(included),
+		"tree":     tree,
+	}, nil
+}
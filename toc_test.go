@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestTOC(t *testing.T) {
+	const doc = "# Guide\n## Setup\n### Install\n## Usage\n### Install\n```\n# not a heading\n```\n# Guide #"
+
+	runToolCases(t, handleTOC, []toolCase[TOCArgs]{
+		{
+			name: "nested with duplicates",
+			args: TOCArgs{Markdown: doc},
+			text: "- [Guide](#guide)\n  - [Setup](#setup)\n    - [Install](#install)\n  - [Usage](#usage)\n    - [Install](#install-2)\n- [Guide](#guide-2)",
+			out: `{"headings":6,"tree":[
+				{"level":1,"text":"Guide","anchor":"guide","children":[
+					{"level":2,"text":"Setup","anchor":"setup","children":[{"level":3,"text":"Install","anchor":"install","children":[]}]},
+					{"level":2,"text":"Usage","anchor":"usage","children":[{"level":3,"text":"Install","anchor":"install-2","children":[]}]}
+				]},
+				{"level":1,"text":"Guide","anchor":"guide-2","children":[]}
+			]}`,
+		},
+		{
+			name: "max depth",
+			args: TOCArgs{Markdown: doc, MaxDepth: 2},
+			text: "- [Guide](#guide)\n  - [Setup](#setup)\n  - [Usage](#usage)\n- [Guide](#guide-2)",
+			out:  `{"headings":4,"markdown":"- [Guide](#guide)\n  - [Setup](#setup)\n  - [Usage](#usage)\n- [Guide](#guide-2)"}`,
+		},
+		{
+			name: "skipped level",
+			args: TOCArgs{Markdown: "## Intro\n#### Detail\n## Next"},
+			text: "- [Intro](#intro)\n  - [Detail](#detail)\n- [Next](#next)",
+			out:  `{"tree":[{"level":2,"text":"Intro","anchor":"intro","children":[{"level":4,"text":"Detail","anchor":"detail","children":[]}]},{"level":2,"text":"Next","anchor":"next","children":[]}]}`,
+		},
+		{
+			name: "not headings",
+			args: TOCArgs{Markdown: "#hashtag\n    # indented code\n# Real"},
+			text: "- [Real](#real)",
+		},
+		{name: "no headings", args: TOCArgs{Markdown: "plain text\n```\n# fenced\n```"}, err: true, text: "No headings found"},
+		{name: "none within depth", args: TOCArgs{Markdown: "## Deep", MaxDepth: 1}, err: true, text: "No headings found"},
+		{name: "depth too large", args: TOCArgs{Markdown: doc, MaxDepth: 7}, err: true, text: "Max depth must be between 1 and 6"},
+		{name: "negative depth", args: TOCArgs{Markdown: doc, MaxDepth: -1}, err: true, text: "Max depth must be between 1 and 6"},
+	})
+}
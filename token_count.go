@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type TokenCountArgs struct {
+	Text          string   `json:"text" jsonschema:"The text to estimate"`
+	ModelFamily   string   `json:"model_family,omitempty" jsonschema:"Model family whose typical ratio to use (gpt, claude, llama, or code). Defaults to a generic 4 characters per token"`
+	CharsPerToken *float64 `json:"chars_per_token,omitempty" jsonschema:"Custom characters-per-token ratio, overriding model_family"`
+}
+
+// tokenRatios are rough characters-per-token averages for English prose. They
+// are a heuristic for budgeting only; real counts depend on each model's BPE
+// vocabulary.
+var tokenRatios = map[string]float64{
+	"gpt":    4.0,
+	"claude": 3.5,
+	"llama":  3.8,
+	"code":   3.0,
+}
+
+// estimateTokens returns ceil(characters / charsPerToken), so the estimate
+// never decreases as text grows.
+func estimateTokens(text string, charsPerToken float64) int {
+	return int(math.Ceil(float64(utf8.RuneCountInString(text)) / charsPerToken))
+}
+
+func handleTokenCount(ctx context.Context, req *mcp.CallToolRequest, args TokenCountArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("token_count called with text length: %d, model_family: %q", len(args.Text), args.ModelFamily))
+
+	ratio := float64(approxCharsPerToken)
+	if args.ModelFamily != "" {
+		r, ok := tokenRatios[strings.ToLower(args.ModelFamily)]
+		if !ok {
+			return errorResult(fmt.Sprintf("Unsupported model family: %s", args.ModelFamily)), nil, nil
+		}
+		ratio = r
+	}
+	if args.CharsPerToken != nil {
+		ratio = *args.CharsPerToken
+		if ratio <= 0 || math.IsInf(ratio, 0) || math.IsNaN(ratio) {
+			return errorResult("chars_per_token must be a positive number"), nil, nil
+		}
+	}
+
+	tokens := estimateTokens(args.Text, ratio)
+	characters := utf8.RuneCountInString(args.Text)
+	words := countWords(args.Text).Words
+
+	return textResult(fmt.Sprintf("Estimated tokens: %d\nCharacters: %d\nWords: %d\n(estimate at %.2f characters per token, not a real tokenizer count)",
+			tokens, characters, words, ratio)),
+		map[string]any{
+			"estimated_tokens": tokens,
+			"characters":       characters,
+			"words":            words,
+			"chars_per_token":  ratio,
+		}, nil
+}
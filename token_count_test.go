@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestTokenCount(t *testing.T) {
+	runToolCases(t, handleTokenCount, []toolCase[TokenCountArgs]{
+		{
+			name: "default ratio",
+			args: TokenCountArgs{Text: "Hello there, world"},
+			text: "Estimated tokens: 5\nCharacters: 18\nWords: 3\n(estimate at 4.00 characters per token, not a real tokenizer count)",
+			out:  `{"estimated_tokens":5,"characters":18,"words":3,"chars_per_token":4}`,
+		},
+		{
+			name: "model family",
+			args: TokenCountArgs{Text: "Hello there, world", ModelFamily: "Claude"},
+			out:  `{"estimated_tokens":6,"chars_per_token":3.5}`,
+		},
+		{
+			name:     "custom ratio wins",
+			args:     TokenCountArgs{Text: "Hello there, world", ModelFamily: "code", CharsPerToken: ptr(2.0)},
+			contains: []string{"Estimated tokens: 9", "at 2.00 characters per token"},
+			out:      `{"estimated_tokens":9,"chars_per_token":2}`,
+		},
+		{
+			name: "runes",
+			args: TokenCountArgs{Text: "日本語のテキスト"},
+			out:  `{"estimated_tokens":2,"characters":8}`,
+		},
+		{name: "empty", args: TokenCountArgs{Text: ""}, out: `{"estimated_tokens":0,"characters":0,"words":0}`},
+		{name: "unknown family", args: TokenCountArgs{Text: "x", ModelFamily: "bert"}, err: true, text: "Unsupported model family: bert"},
+		{name: "zero ratio", args: TokenCountArgs{Text: "x", CharsPerToken: ptr(0.0)}, err: true, text: "chars_per_token must be a positive number"},
+		{name: "infinite ratio", args: TokenCountArgs{Text: "x", CharsPerToken: ptr(math.Inf(1))}, err: true, text: "chars_per_token must be a positive number"},
+	})
+}
+
+func TestTokenCountMonotonic(t *testing.T) {
+	for _, ratio := range []float64{1, 3.5, 4, 7.25} {
+		prev := -1.0
+		for n := 0; n <= 40; n++ {
+			_, out := callTool(t, handleTokenCount, TokenCountArgs{Text: strings.Repeat("a", n), CharsPerToken: ptr(ratio)})
+			tokens := numberField(t, out, "estimated_tokens")
+			if tokens < prev {
+				t.Fatalf("ratio %g: %d characters give %g tokens, fewer than %g for %d", ratio, n, tokens, prev, n-1)
+			}
+			if want := math.Ceil(float64(n) / ratio); tokens != want {
+				t.Errorf("ratio %g: %d characters give %g tokens, want %g", ratio, n, tokens, want)
+			}
+			prev = tokens
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type TransposeArgs struct {
+	Text      string `json:"text" jsonschema:"Delimited rows, one per line; fields may be quoted as in CSV"`
+	Delimiter string `json:"delimiter,omitempty" jsonschema:"Single-character field delimiter (default comma; use \\t for tabs)"`
+	Ragged    string `json:"ragged,omitempty" jsonschema:"How to handle rows of different lengths: error (default), pad (fill short rows with empty fields), or truncate (cut rows to the shortest)"`
+}
+
+// parseDelimiter validates a single-character delimiter, accepting the
+// escape \t for tabs. An empty value means comma.
+func parseDelimiter(s string) (rune, error) {
+	switch s {
+	case "":
+		return ',', nil
+	case `\t`:
+		return '\t', nil
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	if size != len(s) || r == '"' || r == '\n' || r == '\r' || r == utf8.RuneError {
+		return 0, fmt.Errorf("delimiter must be a single character other than a quote or newline: %q", s)
+	}
+	return r, nil
+}
+
+// parseDelimited reads delimited rows with CSV quoting rules, allowing rows
+// of different lengths.
+func parseDelimited(text string, delimiter rune) ([][]string, error) {
+	r := csv.NewReader(strings.NewReader(text))
+	r.Comma = delimiter
+	r.FieldsPerRecord = -1
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no rows found")
+	}
+	return rows, nil
+}
+
+func writeDelimited(rows [][]string, delimiter rune) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Comma = delimiter
+	w.WriteAll(rows)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func handleTranspose(ctx context.Context, req *mcp.CallToolRequest, args TransposeArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("transpose called with %d bytes", len(args.Text)))
+
+	delimiter, err := parseDelimiter(args.Delimiter)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid delimiter: %v", err)), nil, nil
+	}
+
+	ragged := args.Ragged
+	if ragged == "" {
+		ragged = "error"
+	}
+	if ragged != "error" && ragged != "pad" && ragged != "truncate" {
+		return errorResult(fmt.Sprintf("Unsupported ragged option: %s (use error, pad, or truncate)", args.Ragged)), nil, nil
+	}
+
+	rows, err := parseDelimited(args.Text, delimiter)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid input: %v", err)), nil, nil
+	}
+
+	shortest, longest := len(rows[0]), len(rows[0])
+	for i, row := range rows {
+		if len(row) != len(rows[0]) && ragged == "error" {
+			return errorResult(fmt.Sprintf("Row %d has %d fields but row 1 has %d (set 'ragged' to pad or truncate)", i+1, len(row), len(rows[0]))), nil, nil
+		}
+		shortest = min(shortest, len(row))
+		longest = max(longest, len(row))
+	}
+	columns := longest
+	if ragged == "truncate" {
+		columns = shortest
+	}
+
+	transposed := make([][]string, columns)
+	for c := range transposed {
+		transposed[c] = make([]string, len(rows))
+		for r, row := range rows {
+			if c < len(row) {
+				transposed[c][r] = row[c]
+			}
+		}
+	}
+
+	return textResult(writeDelimited(transposed, delimiter)), map[string]any{
+		"input_rows":     len(rows),
+		"input_columns":  longest,
+		"output_rows":    columns,
+		"output_columns": len(rows),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestTranspose(t *testing.T) {
+	runToolCases(t, handleTranspose, []toolCase[TransposeArgs]{
+		{
+			name: "square",
+			args: TransposeArgs{Text: "1,2,3\n4,5,6\n7,8,9"},
+			text: "1,4,7\n2,5,8\n3,6,9",
+			out:  `{"input_rows":3,"input_columns":3,"output_rows":3,"output_columns":3}`,
+		},
+		{
+			name: "rectangular",
+			args: TransposeArgs{Text: "name,age,city\nAda,36,London\n"},
+			text: "name,Ada\nage,36\ncity,London",
+			out:  `{"input_rows":2,"input_columns":3,"output_rows":3,"output_columns":2}`,
+		},
+		{
+			name: "tabs",
+			args: TransposeArgs{Text: "a\tb\nc\td", Delimiter: `\t`},
+			text: "a\tc\nb\td",
+		},
+		{
+			name: "quoted fields",
+			args: TransposeArgs{Text: "\"x, y\",z\n\"say \"\"hi\"\"\",w", Delimiter: ","},
+			text: "\"x, y\",\"say \"\"hi\"\"\"\nz,w",
+		},
+		{
+			name: "pad",
+			args: TransposeArgs{Text: "a,b,c\nd", Ragged: "pad"},
+			text: "a,d\nb,\nc,",
+			out:  `{"input_columns":3,"output_rows":3}`,
+		},
+		{
+			name: "truncate",
+			args: TransposeArgs{Text: "a,b,c\nd,e", Ragged: "truncate"},
+			text: "a,d\nb,e",
+			out:  `{"input_columns":3,"output_rows":2,"output_columns":2}`,
+		},
+		{name: "ragged rejected", args: TransposeArgs{Text: "a,b,c\nd,e"}, err: true, text: "Row 2 has 2 fields but row 1 has 3 (set 'ragged' to pad or truncate)"},
+		{name: "empty", args: TransposeArgs{Text: ""}, err: true, text: "Invalid input: no rows found"},
+		{name: "bad quoting", args: TransposeArgs{Text: "a,\"b\nc"}, err: true, contains: []string{"Invalid input: "}},
+		{name: "quote delimiter", args: TransposeArgs{Text: "a", Delimiter: `"`}, err: true, text: `Invalid delimiter: delimiter must be a single character other than a quote or newline: "\""`},
+		{name: "long delimiter", args: TransposeArgs{Text: "a", Delimiter: "::"}, err: true, text: `Invalid delimiter: delimiter must be a single character other than a quote or newline: "::"`},
+		{name: "unknown ragged", args: TransposeArgs{Text: "a", Ragged: "drop"}, err: true, text: "Unsupported ragged option: drop (use error, pad, or truncate)"},
+	})
+}
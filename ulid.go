@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ULIDArgs struct {
+	Mode  string `json:"mode,omitempty" jsonschema:"generate (default) or parse"`
+	Count int    `json:"count,omitempty" jsonschema:"Number of ULIDs to generate (1-1000, default 1)"`
+	ULID  string `json:"ulid,omitempty" jsonschema:"The ULID to parse"`
+}
+
+const (
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	ulidLength        = 26
+	maxULIDCount      = 1000
+)
+
+// ulidGenerator produces monotonic ULIDs: within one millisecond the 80-bit
+// random part of the previous ULID is incremented instead of redrawn, so
+// ULIDs generated later always sort after earlier ones. It is safe for
+// concurrent use.
+type ulidGenerator struct {
+	mu       sync.Mutex
+	lastMS   uint64
+	lastRand [10]byte
+}
+
+var ulids = &ulidGenerator{}
+
+func (g *ulidGenerator) next(now time.Time) ([16]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := uint64(now.UnixMilli())
+	if ms <= g.lastMS {
+		// Same millisecond, or the clock went backwards: keep the previous
+		// timestamp and increment the randomness.
+		ms = g.lastMS
+		i := len(g.lastRand) - 1
+		for ; i >= 0; i-- {
+			g.lastRand[i]++
+			if g.lastRand[i] != 0 {
+				break
+			}
+		}
+		if i < 0 {
+			return [16]byte{}, fmt.Errorf("ULID randomness exhausted within one millisecond")
+		}
+	} else if _, err := rand.Read(g.lastRand[:]); err != nil {
+		return [16]byte{}, err
+	}
+	g.lastMS = ms
+
+	var id [16]byte
+	for i := 0; i < 6; i++ {
+		id[i] = byte(ms >> (40 - 8*i))
+	}
+	copy(id[6:], g.lastRand[:])
+	return id, nil
+}
+
+// encodeULID writes 128 bits as 26 Crockford base32 characters; the first
+// character carries only the top 3 bits.
+func encodeULID(id [16]byte) string {
+	out := make([]byte, ulidLength)
+	var acc uint32
+	bits := 2 // 26*5 = 130, so the value is left-padded with two zero bits
+	pos := 0
+	for _, b := range id {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out[pos] = crockfordAlphabet[acc>>bits&31]
+			pos++
+		}
+	}
+	return string(out)
+}
+
+func decodeULID(s string) ([16]byte, error) {
+	var id [16]byte
+	if len(s) != ulidLength {
+		return id, fmt.Errorf("ULID must be %d characters, got %d", ulidLength, len(s))
+	}
+	s = strings.ToUpper(s)
+	if s[0] > '7' {
+		return id, fmt.Errorf("ULID %s overflows 128 bits (first character must be 0-7)", s)
+	}
+
+	var acc uint32
+	bits := -2 // drop the two padding bits
+	pos := 0
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(crockfordAlphabet, s[i])
+		if v < 0 {
+			return id, fmt.Errorf("invalid character %q at position %d", s[i], i+1)
+		}
+		acc = acc<<5 | uint32(v)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			id[pos] = byte(acc >> bits)
+			pos++
+		}
+	}
+	return id, nil
+}
+
+func handleULID(ctx context.Context, req *mcp.CallToolRequest, args ULIDArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("ulid called: mode=%q count=%d", args.Mode, args.Count))
+
+	switch args.Mode {
+	case "", "generate":
+		if args.ULID != "" {
+			return errorResult("'ulid' cannot be used with generate mode"), nil, nil
+		}
+		count := args.Count
+		if count == 0 {
+			count = 1
+		}
+		if count < 1 || count > maxULIDCount {
+			return errorResult(fmt.Sprintf("Count must be between 1 and %d", maxULIDCount)), nil, nil
+		}
+
+		generated := make([]string, count)
+		for i := range generated {
+			id, err := ulids.next(time.Now())
+			if err != nil {
+				return errorResult(fmt.Sprintf("Error generating ULID: %v", err)), nil, nil
+			}
+			generated[i] = encodeULID(id)
+		}
+		return textResult(strings.Join(generated, "\n")), map[string]any{"ulids": generated}, nil
+
+	case "parse":
+		if args.Count != 0 {
+			return errorResult("'count' cannot be used with parse mode"), nil, nil
+		}
+		id, err := decodeULID(strings.TrimSpace(args.ULID))
+		if err != nil {
+			return errorResult(fmt.Sprintf("Invalid ULID: %v", err)), nil, nil
+		}
+
+		var ms uint64
+		for _, b := range id[:6] {
+			ms = ms<<8 | uint64(b)
+		}
+		ts := time.UnixMilli(int64(ms)).UTC().Format("2006-01-02T15:04:05.000Z07:00")
+		randomness := hex.EncodeToString(id[6:])
+
+		return textResult(fmt.Sprintf("Timestamp: %s (%d ms)\nRandomness: %s", ts, ms, randomness)),
+			map[string]any{
+				"ulid":         encodeULID(id),
+				"timestamp_ms": ms,
+				"timestamp":    ts,
+				"randomness":   randomness,
+			}, nil
+
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+}
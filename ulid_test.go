@@ -0,0 +1,112 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestULIDParse(t *testing.T) {
+	runToolCases(t, handleULID, []toolCase[ULIDArgs]{
+		{
+			name: "spec example",
+			args: ULIDArgs{Mode: "parse", ULID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+			text: "Timestamp: 2016-07-30T23:54:10.259Z (1469922850259 ms)\nRandomness: d6764c61efb99302bd5b",
+			out:  `{"ulid":"01ARZ3NDEKTSV4RRFFQ69G5FAV","timestamp_ms":1469922850259,"timestamp":"2016-07-30T23:54:10.259Z","randomness":"d6764c61efb99302bd5b"}`,
+		},
+		{name: "lowercase", args: ULIDArgs{Mode: "parse", ULID: " 01arz3ndektsv4rrffq69g5fav "}, out: `{"ulid":"01ARZ3NDEKTSV4RRFFQ69G5FAV"}`},
+		{name: "zero", args: ULIDArgs{Mode: "parse", ULID: strings.Repeat("0", 26)}, out: `{"timestamp_ms":0,"timestamp":"1970-01-01T00:00:00.000Z","randomness":"00000000000000000000"}`},
+		{name: "largest", args: ULIDArgs{Mode: "parse", ULID: "7" + strings.Repeat("Z", 25)}, contains: []string{"(281474976710655 ms)", "Randomness: ffffffffffffffffffff"}},
+		{name: "too short", args: ULIDArgs{Mode: "parse", ULID: "01ARZ3NDEK"}, err: true, text: "Invalid ULID: ULID must be 26 characters, got 10"},
+		{name: "overflow", args: ULIDArgs{Mode: "parse", ULID: "8" + strings.Repeat("0", 25)}, err: true, text: "Invalid ULID: ULID 80000000000000000000000000 overflows 128 bits (first character must be 0-7)"},
+		{name: "excluded letter", args: ULIDArgs{Mode: "parse", ULID: "01ARZ3NDEKTSV4RRFFQ69G5FAU"}, err: true, text: "Invalid ULID: invalid character 'U' at position 26"},
+		{name: "count with parse", args: ULIDArgs{Mode: "parse", ULID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Count: 2}, err: true, text: "'count' cannot be used with parse mode"},
+		{name: "ulid with generate", args: ULIDArgs{ULID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"}, err: true, text: "'ulid' cannot be used with generate mode"},
+		{name: "count too large", args: ULIDArgs{Count: 1001}, err: true, text: "Count must be between 1 and 1000"},
+		{name: "negative count", args: ULIDArgs{Count: -1}, err: true, text: "Count must be between 1 and 1000"},
+		{name: "unknown mode", args: ULIDArgs{Mode: "validate"}, err: true, text: "Unsupported mode: validate"},
+	})
+}
+
+func TestULIDGenerate(t *testing.T) {
+	before := time.Now().UnixMilli()
+	result, out := callTool(t, handleULID, ULIDArgs{Count: maxULIDCount})
+	after := time.Now().UnixMilli()
+	if result.IsError {
+		t.Fatal(resultText(result))
+	}
+
+	var ids []string
+	for _, v := range out.(map[string]any)["ulids"].([]any) {
+		ids = append(ids, v.(string))
+	}
+	if len(ids) != maxULIDCount {
+		t.Fatalf("got %d ULIDs, want %d", len(ids), maxULIDCount)
+	}
+	if resultText(result) != strings.Join(ids, "\n") {
+		t.Error("text does not list the generated ULIDs")
+	}
+	if !slices.IsSorted(ids) {
+		t.Error("ULIDs generated in order do not sort in order")
+	}
+	if len(slices.Compact(slices.Clone(ids))) != len(ids) {
+		t.Error("generated ULIDs are not unique")
+	}
+
+	for _, id := range []string{ids[0], ids[len(ids)-1]} {
+		result, out := callTool(t, handleULID, ULIDArgs{Mode: "parse", ULID: id})
+		if result.IsError {
+			t.Fatalf("parse %s: %s", id, resultText(result))
+		}
+		checkOutput(t, out, `{"ulid":"`+id+`"}`)
+		// Monotonic ULIDs may carry the timestamp of an earlier call in the
+		// same millisecond, so only an upper bound is exact.
+		if ms := int64(numberField(t, out, "timestamp_ms")); ms > after || ms < before-1000 {
+			t.Errorf("%s: timestamp %d outside %d..%d", id, ms, before, after)
+		}
+	}
+}
+
+func TestULIDGeneratorMonotonic(t *testing.T) {
+	g := &ulidGenerator{}
+	now := time.UnixMilli(1469922850259)
+
+	first, err := g.next(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := g.next(now)
+	earlier, _ := g.next(now.Add(-time.Second))
+	for _, id := range [][16]byte{second, earlier} {
+		if encodeULID(id) <= encodeULID(first) {
+			t.Errorf("%s does not sort after %s", encodeULID(id), encodeULID(first))
+		}
+		if string(id[:6]) != string(first[:6]) {
+			t.Errorf("%s changed the timestamp of %s", encodeULID(id), encodeULID(first))
+		}
+	}
+
+	later, _ := g.next(now.Add(time.Millisecond))
+	if encodeULID(later)[:10] <= encodeULID(first)[:10] {
+		t.Errorf("%s does not carry a later timestamp than %s", encodeULID(later), encodeULID(first))
+	}
+
+	g.lastMS = uint64(now.UnixMilli())
+	for i := range g.lastRand {
+		g.lastRand[i] = 0xff
+	}
+	if _, err := g.next(now); err == nil {
+		t.Error("exhausted randomness did not fail")
+	}
+}
+
+func TestULIDRoundTrip(t *testing.T) {
+	for _, id := range [][16]byte{{}, {0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}} {
+		s := encodeULID(id)
+		got, err := decodeULID(s)
+		if err != nil || got != id {
+			t.Errorf("decodeULID(%s) = %x, %v; want %x", s, got, err, id)
+		}
+	}
+}
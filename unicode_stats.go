@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type UnicodeStatsArgs struct {
+	Text string `json:"text" jsonschema:"The text to analyze"`
+}
+
+// scriptNames lists unicode.Scripts in a fixed order, with the most common
+// scripts first so that typical text is classified quickly.
+var scriptNames = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	first := []string{"Latin", "Common", "Inherited", "Cyrillic", "Greek"}
+	names = slices.DeleteFunc(names, func(n string) bool { return slices.Contains(first, n) })
+	return append(first, names...)
+}()
+
+// scriptOf returns the Unicode script of r, such as "Latin", "Cyrillic", or
+// "Han". Characters shared between scripts (digits, punctuation, emoji) are
+// "Common", combining marks are usually "Inherited", and unassigned code
+// points are "Unknown".
+func scriptOf(r rune) string {
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// runeCategory names the broad Unicode general category of r. Whitespace is
+// checked first so that tabs and newlines count as whitespace, not control.
+func runeCategory(r rune) string {
+	switch {
+	case unicode.IsSpace(r):
+		return "whitespace"
+	case unicode.IsLetter(r):
+		return "letter"
+	case unicode.IsMark(r):
+		return "mark"
+	case unicode.IsNumber(r):
+		return "number"
+	case unicode.IsPunct(r):
+		return "punctuation"
+	case unicode.IsSymbol(r):
+		return "symbol"
+	case unicode.IsControl(r):
+		return "control"
+	}
+	return "other"
+}
+
+func handleUnicodeStats(ctx context.Context, req *mcp.CallToolRequest, args UnicodeStatsArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("unicode_stats called with %d bytes", len(args.Text)))
+
+	categories := map[string]int{
+		"letter": 0, "mark": 0, "number": 0, "punctuation": 0,
+		"symbol": 0, "whitespace": 0, "control": 0, "other": 0,
+	}
+	scripts := map[string]int{}
+	invalid := 0
+	for i, r := range args.Text {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(args.Text[i:]); size == 1 {
+				invalid++
+				continue
+			}
+		}
+		categories[runeCategory(r)]++
+		scripts[scriptOf(r)]++
+	}
+
+	// Common and Inherited characters appear alongside any script, so only
+	// the others decide whether the text mixes scripts.
+	var distinct []string
+	for name := range scripts {
+		if name != "Common" && name != "Inherited" && name != "Unknown" {
+			distinct = append(distinct, name)
+		}
+	}
+	slices.Sort(distinct)
+
+	lines := []string{fmt.Sprintf("Runes: %d", utf8.RuneCountInString(args.Text))}
+	for _, name := range []string{"letter", "mark", "number", "punctuation", "symbol", "whitespace", "control", "other"} {
+		if categories[name] > 0 {
+			lines = append(lines, fmt.Sprintf("%s: %d", name, categories[name]))
+		}
+	}
+	if len(distinct) > 0 {
+		lines = append(lines, "Scripts: "+strings.Join(distinct, ", "))
+	}
+	if invalid > 0 {
+		lines = append(lines, fmt.Sprintf("Invalid UTF-8 bytes: %d", invalid))
+	}
+
+	return textResult(strings.Join(lines, "\n")), map[string]any{
+		"runes":            utf8.RuneCountInString(args.Text),
+		"categories":       categories,
+		"scripts":          scripts,
+		"distinct_scripts": distinct,
+		"mixed_scripts":    len(distinct) > 1,
+		"invalid_bytes":    invalid,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import "testing"
+
+func TestUnicodeStats(t *testing.T) {
+	runToolCases(t, handleUnicodeStats, []toolCase[UnicodeStatsArgs]{
+		{
+			name: "Latin, digits, punctuation, and emoji",
+			args: UnicodeStatsArgs{Text: "Hello, World 42! 🎉"},
+			text: "Runes: 18\nletter: 10\nnumber: 2\npunctuation: 2\nsymbol: 1\nwhitespace: 3\nScripts: Latin",
+			out: `{"runes":18,"categories":{"letter":10,"mark":0,"number":2,"punctuation":2,"symbol":1,"whitespace":3,"control":0,"other":0},
+				"scripts":{"Latin":10,"Common":8},"distinct_scripts":["Latin"],"mixed_scripts":false,"invalid_bytes":0}`,
+		},
+		{
+			name: "mixed-script lookalike",
+			args: UnicodeStatsArgs{Text: "p\u0430ypal"},
+			text: "Runes: 6\nletter: 6\nScripts: Cyrillic, Latin",
+			out:  `{"scripts":{"Latin":5,"Cyrillic":1},"distinct_scripts":["Cyrillic","Latin"],"mixed_scripts":true}`,
+		},
+		{
+			name: "Japanese",
+			args: UnicodeStatsArgs{Text: "東京です"},
+			out:  `{"scripts":{"Han":2,"Hiragana":2},"mixed_scripts":true}`,
+		},
+		{
+			name: "combining mark",
+			args: UnicodeStatsArgs{Text: "e\u0301"},
+			text: "Runes: 2\nletter: 1\nmark: 1\nScripts: Latin",
+			out:  `{"scripts":{"Latin":1,"Inherited":1}}`,
+		},
+		{
+			name: "tabs are whitespace, not control",
+			args: UnicodeStatsArgs{Text: "a\x00b\tc"},
+			text: "Runes: 5\nletter: 3\nwhitespace: 1\ncontrol: 1\nScripts: Latin",
+		},
+		{
+			name: "unassigned code point",
+			args: UnicodeStatsArgs{Text: "\u0378"},
+			text: "Runes: 1\nother: 1",
+			out:  `{"scripts":{"Unknown":1},"distinct_scripts":null,"mixed_scripts":false}`,
+		},
+		{
+			name: "invalid UTF-8",
+			args: UnicodeStatsArgs{Text: "a\xffb"},
+			text: "Runes: 3\nletter: 2\nScripts: Latin\nInvalid UTF-8 bytes: 1",
+			out:  `{"invalid_bytes":1}`,
+		},
+		{
+			name: "empty",
+			args: UnicodeStatsArgs{Text: ""},
+			text: "Runes: 0",
+			out:  `{"runes":0,"scripts":{},"mixed_scripts":false,"invalid_bytes":0}`,
+		},
+	})
+}
+
+func TestScriptOf(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'a', "Latin"},
+		{'7', "Common"},
+		{'!', "Common"},
+		{'\u0301', "Inherited"},
+		{'Ж', "Cyrillic"},
+		{'Ω', "Greek"},
+		{'א', "Hebrew"},
+		{'ا', "Arabic"},
+		{'中', "Han"},
+		{'\U0001F600', "Common"},
+		{'\u0378', "Unknown"},
+	}
+	for _, tt := range tests {
+		if got := scriptOf(tt.r); got != tt.want {
+			t.Errorf("scriptOf(%U) = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+}
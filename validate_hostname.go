@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ValidateHostnameArgs struct {
+	Hostname string `json:"hostname" jsonschema:"The hostname to check, optionally with a trailing dot"`
+	IDN      bool   `json:"idn,omitempty" jsonschema:"Punycode-encode labels with non-ASCII characters (xn--) before checking, instead of rejecting them"`
+}
+
+const (
+	maxHostnameLength = 253
+	maxLabelLength    = 63
+)
+
+// Punycode parameters from RFC 3492 section 5.
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > (punyBase-punyTMin)*punyTMax/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+// punycode encodes a label with the RFC 3492 Bootstring algorithm, without
+// the xn-- prefix: "bücher" becomes "bcher-kva".
+func punycode(label string) string {
+	runes := []rune(label)
+	var out []byte
+	for _, r := range runes {
+		if r < 0x80 {
+			out = append(out, byte(r))
+		}
+	}
+	basic := len(out)
+	if basic > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for handled := basic; handled < len(runes); {
+		next := int(utf8.MaxRune) + 1
+		for _, r := range runes {
+			if int(r) >= n && int(r) < next {
+				next = int(r)
+			}
+		}
+		delta += (next - n) * (handled + 1)
+		n = next
+
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+				continue
+			}
+			if int(r) > n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := k - bias
+				if t < punyTMin {
+					t = punyTMin
+				} else if t > punyTMax {
+					t = punyTMax
+				}
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return string(out)
+}
+
+// checkLabel reports why a single ASCII label breaks RFC 1123, if it does.
+func checkLabel(label string) []string {
+	var problems []string
+	if label == "" {
+		return []string{"empty label (consecutive, leading, or extra trailing dots)"}
+	}
+	if len(label) > maxLabelLength {
+		problems = append(problems, fmt.Sprintf("label %q is %d characters, more than %d", label, len(label), maxLabelLength))
+	}
+	for _, c := range label {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+			problems = append(problems, fmt.Sprintf("label %q contains %q; only letters, digits, and hyphens are allowed", label, c))
+			break
+		}
+	}
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		problems = append(problems, fmt.Sprintf("label %q starts or ends with a hyphen", label))
+	}
+	return problems
+}
+
+func handleValidateHostname(ctx context.Context, req *mcp.CallToolRequest, args ValidateHostnameArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("validate_hostname called with hostname: %s", args.Hostname))
+
+	// Hostnames are case-insensitive. Lowercasing is the only mapping applied
+	// to internationalized labels; full UTS #46 processing is out of scope.
+	host := strings.ToLower(strings.TrimSpace(args.Hostname))
+	host = strings.TrimSuffix(host, ".")
+
+	problems := []string{}
+	labels := []string{}
+	if host == "" {
+		problems = append(problems, "hostname is empty")
+	} else {
+		for _, label := range strings.Split(host, ".") {
+			if label != "" && !isASCII(label) {
+				if !args.IDN {
+					problems = append(problems, fmt.Sprintf("label %q contains non-ASCII characters; set idn to punycode-encode it", label))
+					labels = append(labels, label)
+					continue
+				}
+				// Encoding never shortens a label, and punycode's cost grows
+				// quadratically, so an overlong label is rejected unencoded.
+				if n := utf8.RuneCountInString(label); n > maxLabelLength {
+					problems = append(problems, fmt.Sprintf("label %q is %d characters, more than %d", label, n, maxLabelLength))
+					labels = append(labels, label)
+					continue
+				}
+				label = "xn--" + punycode(label)
+			}
+			problems = append(problems, checkLabel(label)...)
+			labels = append(labels, label)
+		}
+	}
+
+	ascii := strings.Join(labels, ".")
+	if len(ascii) > maxHostnameLength {
+		problems = append(problems, fmt.Sprintf("hostname is %d characters, more than %d", len(ascii), maxHostnameLength))
+	}
+	if len(labels) > 1 {
+		if tld := labels[len(labels)-1]; tld != "" && strings.Trim(tld, "0123456789") == "" {
+			problems = append(problems, fmt.Sprintf("top-level label %q is all digits", tld))
+		}
+	}
+
+	valid := len(problems) == 0
+	text := fmt.Sprintf("%s is a valid hostname", ascii)
+	if !valid {
+		text = fmt.Sprintf("Invalid hostname:\n- %s", strings.Join(problems, "\n- "))
+	}
+	return textResult(text), map[string]any{
+		"valid":    valid,
+		"hostname": ascii,
+		"labels":   labels,
+		"problems": problems,
+	}, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestValidateHostname(t *testing.T) {
+	long := strings.Repeat("a", 64)
+	label63 := strings.Repeat("b", 63)
+
+	runToolCases(t, handleValidateHostname, []toolCase[ValidateHostnameArgs]{
+		{
+			name: "valid",
+			args: ValidateHostnameArgs{Hostname: "Api-1.Example.COM."},
+			text: "api-1.example.com is a valid hostname",
+			out:  `{"valid":true,"hostname":"api-1.example.com","labels":["api-1","example","com"],"problems":[]}`,
+		},
+		{name: "single label", args: ValidateHostnameArgs{Hostname: "localhost"}, out: `{"valid":true}`},
+		{name: "63 character label", args: ValidateHostnameArgs{Hostname: label63 + ".com"}, out: `{"valid":true}`},
+		{
+			name: "label too long",
+			args: ValidateHostnameArgs{Hostname: long + ".com"},
+			text: "Invalid hostname:\n- label \"" + long + "\" is 64 characters, more than 63",
+			out:  `{"valid":false,"problems":["label \"` + long + `\" is 64 characters, more than 63"]}`,
+		},
+		{
+			name: "hostname too long",
+			args: ValidateHostnameArgs{Hostname: strings.Repeat(label63+".", 3) + label63},
+			out:  `{"valid":false,"problems":["hostname is 255 characters, more than 253"]}`,
+		},
+		{
+			name: "idn",
+			args: ValidateHostnameArgs{Hostname: "Bücher.example", IDN: true},
+			text: "xn--bcher-kva.example is a valid hostname",
+			out:  `{"valid":true,"hostname":"xn--bcher-kva.example","labels":["xn--bcher-kva","example"]}`,
+		},
+		{
+			name: "idn label too long to encode",
+			args: ValidateHostnameArgs{Hostname: strings.Repeat("日", 64) + ".example", IDN: true},
+			out:  `{"valid":false,"labels":["` + strings.Repeat("日", 64) + `","example"],"problems":["label \"` + strings.Repeat("日", 64) + `\" is 64 characters, more than 63"]}`,
+		},
+		{
+			name: "idn without flag",
+			args: ValidateHostnameArgs{Hostname: "münchen.de"},
+			out:  `{"valid":false,"problems":["label \"münchen\" contains non-ASCII characters; set idn to punycode-encode it"]}`,
+		},
+		{
+			name: "several problems",
+			args: ValidateHostnameArgs{Hostname: "-web_1..example.123"},
+			text: "Invalid hostname:\n" +
+				"- label \"-web_1\" contains '_'; only letters, digits, and hyphens are allowed\n" +
+				"- label \"-web_1\" starts or ends with a hyphen\n" +
+				"- empty label (consecutive, leading, or extra trailing dots)\n" +
+				"- top-level label \"123\" is all digits",
+		},
+		{name: "trailing hyphen", args: ValidateHostnameArgs{Hostname: "web-.example"}, out: `{"problems":["label \"web-\" starts or ends with a hyphen"]}`},
+		{name: "empty", args: ValidateHostnameArgs{Hostname: " . "}, out: `{"valid":false,"problems":["hostname is empty"]}`},
+	})
+}
+
+func TestPunycode(t *testing.T) {
+	// The last three are sample strings from RFC 3492, section 7.1.
+	tests := []struct{ label, want string }{
+		{"bücher", "bcher-kva"},
+		{"münchen", "mnchen-3ya"},
+		{"他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+		{"ليهمابتكلموشعربي؟", "egbpdaj6bu4bxfgehfvwxn"},
+		{"3年B組金八先生", "3B-ww4c5e180e575a65lsy2b"},
+	}
+	for _, tt := range tests {
+		if got := punycode(tt.label); got != tt.want {
+			t.Errorf("punycode(%q) = %q, want %q", tt.label, got, tt.want)
+		}
+	}
+}
+
+func TestValidateHostnameLongIDNLabel(t *testing.T) {
+	label := strings.Repeat("日本語中文한국어", 5000)
+	start := time.Now()
+	result, _ := callTool(t, handleValidateHostname, ValidateHostnameArgs{Hostname: label + ".example", IDN: true})
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("validating a %d-rune label took %v", utf8.RuneCountInString(label), elapsed)
+	}
+	if !strings.Contains(resultText(result), "is 40000 characters, more than 63") {
+		t.Errorf("text = %.200q, want the label length problem", resultText(result))
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	mrand "math/rand/v2"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type WeightedOption struct {
+	Value  string  `json:"value" jsonschema:"The option to return when drawn"`
+	Weight float64 `json:"weight" jsonschema:"Relative weight (non-negative); an option with weight 0 is never drawn"`
+}
+
+type WeightedChoiceArgs struct {
+	Options            []WeightedOption `json:"options" jsonschema:"The options to choose from (1-1000)"`
+	Count              *int             `json:"count,omitempty" jsonschema:"Number of draws (1-1000, default 1)"`
+	WithoutReplacement bool             `json:"without_replacement,omitempty" jsonschema:"Never draw the same option twice; count may not exceed the number of options with positive weight"`
+	Seed               *uint64          `json:"seed,omitempty" jsonschema:"Seed for a deterministic pseudo-random sequence; without it draws use crypto/rand"`
+}
+
+// cryptoFloat64 returns a uniformly distributed float64 in [0, 1) from
+// crypto/rand.
+func cryptoFloat64() float64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("crypto/rand failed: %v", err))
+	}
+	return float64(binary.BigEndian.Uint64(b[:])>>11) / (1 << 53)
+}
+
+// drawWeighted picks an index with probability proportional to its weight.
+// The total must be positive.
+func drawWeighted(weights []float64, total float64, random func() float64) int {
+	target := random() * total
+	last := 0
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if target < w {
+			return i
+		}
+		target -= w
+		last = i
+	}
+	// Rounding can leave target a hair above the last weight.
+	return last
+}
+
+func handleWeightedChoice(ctx context.Context, req *mcp.CallToolRequest, args WeightedChoiceArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("weighted_choice called: %d options, without_replacement=%t seeded=%t", len(args.Options), args.WithoutReplacement, args.Seed != nil))
+
+	if len(args.Options) == 0 || len(args.Options) > 1000 {
+		return errorResult("Provide between 1 and 1000 options"), nil, nil
+	}
+	count := 1
+	if args.Count != nil {
+		count = *args.Count
+		if count < 1 || count > 1000 {
+			return errorResult("Count must be between 1 and 1000"), nil, nil
+		}
+	}
+
+	largest := 0.0
+	positive := 0
+	for i, o := range args.Options {
+		if math.IsNaN(o.Weight) || math.IsInf(o.Weight, 0) || o.Weight < 0 {
+			return errorResult(fmt.Sprintf("Option %d (%q) has an invalid weight: weights must be finite and non-negative", i+1, o.Value)), nil, nil
+		}
+		largest = max(largest, o.Weight)
+		if o.Weight > 0 {
+			positive++
+		}
+	}
+	// Only the ratios matter, so scaling by the largest weight keeps the
+	// total finite however large the weights are.
+	weights := make([]float64, len(args.Options))
+	total := 0.0
+	if largest > 0 {
+		for i, o := range args.Options {
+			weights[i] = o.Weight / largest
+			total += weights[i]
+		}
+	}
+	if total <= 0 {
+		return errorResult("Weights must sum to a positive total"), nil, nil
+	}
+	if args.WithoutReplacement && count > positive {
+		return errorResult(fmt.Sprintf("Cannot draw %d options without replacement: only %d have a positive weight", count, positive)), nil, nil
+	}
+
+	random := cryptoFloat64
+	if args.Seed != nil {
+		random = mrand.New(mrand.NewPCG(*args.Seed, 0)).Float64
+	}
+
+	picks := make([]string, count)
+	indexes := make([]int, count)
+	for n := range count {
+		i := drawWeighted(weights, total, random)
+		picks[n], indexes[n] = args.Options[i].Value, i
+		if args.WithoutReplacement {
+			total -= weights[i]
+			weights[i] = 0
+		}
+	}
+
+	return textResult(strings.Join(picks, "\n")), map[string]any{
+		"picks":   picks,
+		"indexes": indexes,
+		"seeded":  args.Seed != nil,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"math"
+	mrand "math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestWeightedChoice(t *testing.T) {
+	runToolCases(t, handleWeightedChoice, []toolCase[WeightedChoiceArgs]{
+		{
+			name: "only positive weight drawn",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"never", 0}, {"always", 2}}, Count: ptr(3)},
+			text: "always\nalways\nalways",
+			out:  `{"picks":["always","always","always"],"indexes":[1,1,1],"seeded":false}`,
+		},
+		{
+			name: "seeded",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"only", 1}}, Seed: ptr[uint64](7)},
+			text: "only",
+			out:  `{"picks":["only"],"indexes":[0],"seeded":true}`,
+		},
+		{
+			name: "no options",
+			args: WeightedChoiceArgs{},
+			err:  true,
+			text: "Provide between 1 and 1000 options",
+		},
+		{
+			name: "count out of range",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"a", 1}}, Count: ptr(0)},
+			err:  true,
+			text: "Count must be between 1 and 1000",
+		},
+		{
+			name: "negative weight",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"a", 1}, {"b", -1}}},
+			err:  true,
+			text: `Option 2 ("b") has an invalid weight: weights must be finite and non-negative`,
+		},
+		{
+			name: "infinite weight",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"a", math.Inf(1)}}},
+			err:  true,
+			text: `Option 1 ("a") has an invalid weight: weights must be finite and non-negative`,
+		},
+		{
+			name: "zero total",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"a", 0}, {"b", 0}}},
+			err:  true,
+			text: "Weights must sum to a positive total",
+		},
+		{
+			name: "too many without replacement",
+			args: WeightedChoiceArgs{Options: []WeightedOption{{"a", 1}, {"b", 0}, {"c", 1}}, Count: ptr(3), WithoutReplacement: true},
+			err:  true,
+			text: "Cannot draw 3 options without replacement: only 2 have a positive weight",
+		},
+	})
+}
+
+func TestWeightedChoiceSeedDeterministic(t *testing.T) {
+	args := WeightedChoiceArgs{
+		Options: []WeightedOption{{"a", 1}, {"b", 2}, {"c", 3}},
+		Count:   ptr(20),
+		Seed:    ptr[uint64](42),
+	}
+	first, _ := callTool(t, handleWeightedChoice, args)
+	second, _ := callTool(t, handleWeightedChoice, args)
+	if resultText(first) != resultText(second) {
+		t.Errorf("same seed gave %q and %q", resultText(first), resultText(second))
+	}
+	args.Seed = ptr[uint64](43)
+	other, _ := callTool(t, handleWeightedChoice, args)
+	if resultText(other) == resultText(first) {
+		t.Errorf("different seeds gave the same draws %q", resultText(first))
+	}
+}
+
+func TestWeightedChoiceWithoutReplacement(t *testing.T) {
+	options := []WeightedOption{{"a", 5}, {"b", 1}, {"c", 0}, {"d", 0.5}}
+	for seed := range uint64(50) {
+		_, out := callTool(t, handleWeightedChoice, WeightedChoiceArgs{Options: options, Count: ptr(3), WithoutReplacement: true, Seed: ptr(seed)})
+		picks := out.(map[string]any)["picks"].([]any)
+		got := make([]string, len(picks))
+		for i, p := range picks {
+			got[i] = p.(string)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, []string{"a", "b", "d"}) {
+			t.Fatalf("seed %d: picks = %q, want a, b, and d once each", seed, got)
+		}
+	}
+}
+
+func TestWeightedChoiceDistribution(t *testing.T) {
+	weights := []float64{1, 2, 0, 7}
+	const draws = 100000
+	sources := []struct {
+		name   string
+		random func() float64
+	}{
+		{"crypto", cryptoFloat64},
+		{"seeded", mrand.New(mrand.NewPCG(1, 0)).Float64},
+	}
+	for _, source := range sources {
+		counts := make([]int, len(weights))
+		for range draws {
+			counts[drawWeighted(weights, 10, source.random)]++
+		}
+		for i, w := range weights {
+			got, want := float64(counts[i])/draws, w/10
+			if math.Abs(got-want) > 0.01 {
+				t.Errorf("%s: option %d drawn %.3f of the time, want about %.3f", source.name, i, got, want)
+			}
+		}
+	}
+}
+
+func TestWeightedChoiceDrawsTrackWeights(t *testing.T) {
+	options := []WeightedOption{{"rare", 1}, {"common", 3}, {"frequent", 6}}
+	counts := map[string]int{}
+	for seed := range uint64(10) {
+		_, out := callTool(t, handleWeightedChoice, WeightedChoiceArgs{Options: options, Count: ptr(1000), Seed: ptr(seed)})
+		for _, p := range out.(map[string]any)["picks"].([]any) {
+			counts[p.(string)]++
+		}
+	}
+	for _, o := range options {
+		got, want := float64(counts[o.Value])/10000, o.Weight/10
+		if math.Abs(got-want) > 0.02 {
+			t.Errorf("%s drawn %.3f of the time, want about %.3f", o.Value, got, want)
+		}
+	}
+}
+
+func TestWeightedChoiceHugeWeights(t *testing.T) {
+	options := []WeightedOption{{"a", math.MaxFloat64}, {"b", math.MaxFloat64}, {"c", 1e308}}
+	counts := map[string]int{}
+	for seed := range uint64(200) {
+		_, out := callTool(t, handleWeightedChoice, WeightedChoiceArgs{Options: options, Count: ptr(10), Seed: ptr(seed)})
+		for _, p := range out.(map[string]any)["picks"].([]any) {
+			counts[p.(string)]++
+		}
+	}
+	// The weights are in the ratio of about 1.8 : 1.8 : 1 out of 2000 draws.
+	for value, want := range map[string]float64{"a": 0.39, "b": 0.39, "c": 0.22} {
+		if got := float64(counts[value]) / 2000; math.Abs(got-want) > 0.05 {
+			t.Errorf("%s drawn %.3f of the time, want about %.2f", value, got, want)
+		}
+	}
+}
+
+func TestDrawWeightedRoundingSkipsZeroWeights(t *testing.T) {
+	// A total a hair above the sum of the weights leaves the target past the
+	// last weight; the draw must still land on a positive weight.
+	almostOne := func() float64 { return math.Nextafter(1, 0) }
+	if got := drawWeighted([]float64{1, 1, 0}, 2.000001, almostOne); got != 1 {
+		t.Errorf("drawWeighted = %d, want 1", got)
+	}
+}
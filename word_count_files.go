@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type WordCountFilesArgs struct {
+	Paths []string `json:"paths" jsonschema:"Paths of the files to analyze"`
+}
+
+// maxWordCountFileSize caps how much of a file word_count_files reads, so a
+// huge file or a device such as /dev/zero cannot exhaust memory.
+const maxWordCountFileSize = 32 << 20
+
+// wordCountChunkSize is how much of a file is read and counted between
+// cancellation checks.
+const wordCountChunkSize = 64 << 10
+
+// wordCounter accumulates the same counts as countWords over text fed to it
+// in pieces, so a file is counted without holding all of it in memory.
+type wordCounter struct {
+	stats   wordStats
+	inWord  bool
+	partial []byte // an incomplete UTF-8 sequence left at the end of the last piece
+}
+
+func (c *wordCounter) write(p []byte) {
+	spaces, newlines := bytes.Count(p, []byte(" ")), bytes.Count(p, []byte("\n"))
+	c.stats.Characters += len(p)
+	c.stats.CharactersNoWhitespace += len(p) - spaces - newlines
+	c.stats.Lines += newlines
+
+	if len(c.partial) > 0 {
+		p = append(c.partial, p...)
+		c.partial = nil
+	}
+	for len(p) > 0 {
+		if !utf8.FullRune(p) {
+			c.partial = append([]byte(nil), p...)
+			return
+		}
+		r, size := utf8.DecodeRune(p)
+		space := unicode.IsSpace(r)
+		if !space && !c.inWord {
+			c.stats.Words++
+		}
+		c.inWord = !space
+		p = p[size:]
+	}
+}
+
+func (c *wordCounter) finish() wordStats {
+	// Like strings.Fields, a truncated sequence at the very end counts as part
+	// of a word.
+	if len(c.partial) > 0 && !c.inWord {
+		c.stats.Words++
+	}
+	if c.stats.Characters > 0 {
+		c.stats.Lines++
+	}
+	return c.stats
+}
+
+// countWordsFrom counts r in chunks, checking ctx between them, and reads at
+// most limit bytes.
+func countWordsFrom(ctx context.Context, r io.Reader, limit int64) (wordStats, error) {
+	var counter wordCounter
+	buf := make([]byte, wordCountChunkSize)
+	r = io.LimitReader(r, limit+1)
+	for {
+		if err := ctx.Err(); err != nil {
+			return wordStats{}, err
+		}
+		n, err := r.Read(buf)
+		counter.write(buf[:n])
+		if int64(counter.stats.Characters) > limit {
+			return wordStats{}, errReadLimit
+		}
+		if err == io.EOF {
+			return counter.finish(), nil
+		}
+		if err != nil {
+			return wordStats{}, err
+		}
+	}
+}
+
+// errReadLimit reports that a reader held more than the limit given to
+// countWordsFrom.
+var errReadLimit = errors.New("read limit exceeded")
+
+func fileTooLargeError(path string) error {
+	return fmt.Errorf("%s is larger than the %d MiB limit", path, maxWordCountFileSize>>20)
+}
+
+// countWordsInFile counts a regular file of at most maxWordCountFileSize
+// bytes.
+func countWordsInFile(ctx context.Context, path string) (wordStats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return wordStats{}, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return wordStats{}, err
+	}
+	if !info.Mode().IsRegular() {
+		return wordStats{}, fmt.Errorf("%s is not a regular file", path)
+	}
+	// The file may grow after Stat, so countWordsFrom caps the read as well.
+	if info.Size() > maxWordCountFileSize {
+		return wordStats{}, fileTooLargeError(path)
+	}
+
+	stats, err := countWordsFrom(ctx, f, maxWordCountFileSize)
+	if err == errReadLimit {
+		err = fileTooLargeError(path)
+	}
+	return stats, err
+}
+
+type fileWordStats struct {
+	Path string `json:"path"`
+	wordStats
+	Error string `json:"error,omitempty"`
+}
+
+func handleWordCountFiles(ctx context.Context, req *mcp.CallToolRequest, args WordCountFilesArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("word_count_files called with %d paths", len(args.Paths)))
+
+	if len(args.Paths) == 0 {
+		return errorResult("Please provide at least one path"), nil, nil
+	}
+
+	total := float64(len(args.Paths))
+	files := make([]fileWordStats, 0, len(args.Paths))
+	var totals wordStats
+	var lines []string
+
+	for i, path := range args.Paths {
+		if err := ctx.Err(); err != nil {
+			return cancelledResult(err), nil, nil
+		}
+
+		stats, err := countWordsInFile(ctx, path)
+		if err != nil && ctx.Err() != nil {
+			return cancelledResult(ctx.Err()), nil, nil
+		}
+		if err != nil {
+			files = append(files, fileWordStats{Path: path, Error: err.Error()})
+			lines = append(lines, fmt.Sprintf("%s: error: %v", path, err))
+		} else {
+			files = append(files, fileWordStats{Path: path, wordStats: stats})
+			lines = append(lines, fmt.Sprintf("%s: %d words, %d characters, %d lines",
+				path, stats.Words, stats.Characters, stats.Lines))
+			totals.add(stats)
+		}
+
+		notifyProgress(ctx, req, float64(i+1), total, fmt.Sprintf("Processed %s", path))
+	}
+
+	lines = append(lines, fmt.Sprintf("Total: %d words, %d characters, %d lines",
+		totals.Words, totals.Characters, totals.Lines))
+
+	return textResult(strings.Join(lines, "\n")), map[string]any{"files": files, "totals": totals}, nil
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// writeFiles creates files with the given contents in a temporary directory
+// and returns their paths.
+func writeFiles(t *testing.T, contents ...string) []string {
+	t.Helper()
+	dir := t.TempDir()
+	paths := make([]string, len(contents))
+	for i, content := range contents {
+		paths[i] = filepath.Join(dir, string(rune('a'+i))+".txt")
+		if err := os.WriteFile(paths[i], []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return paths
+}
+
+func TestWordCountFiles(t *testing.T) {
+	paths := writeFiles(t, "one two three", "four five")
+	missing := filepath.Join(t.TempDir(), "missing.txt")
+	large := filepath.Join(t.TempDir(), "large.txt")
+	if err := os.WriteFile(large, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// A sparse file is enough; nothing is read past the size check.
+	if err := os.Truncate(large, maxWordCountFileSize+1); err != nil {
+		t.Fatal(err)
+	}
+
+	runToolCases(t, handleWordCountFiles, []toolCase[WordCountFilesArgs]{
+		{
+			name: "two files",
+			args: WordCountFilesArgs{Paths: paths},
+			text: paths[0] + ": 3 words, 13 characters, 1 lines\n" + paths[1] + ": 2 words, 9 characters, 1 lines\nTotal: 5 words, 22 characters, 2 lines",
+			out:  `{"totals":{"words":5,"characters":22,"characters_no_whitespace":19,"lines":2}}`,
+		},
+		{
+			name:     "missing file",
+			args:     WordCountFilesArgs{Paths: []string{missing, paths[1]}},
+			contains: []string{missing + ": error: ", "Total: 2 words, 9 characters, 1 lines"},
+			out:      `{"totals":{"words":2,"characters":9,"characters_no_whitespace":8,"lines":1}}`,
+		},
+		{
+			name:     "directory",
+			args:     WordCountFilesArgs{Paths: []string{filepath.Dir(paths[0])}},
+			contains: []string{filepath.Dir(paths[0]) + ": error: " + filepath.Dir(paths[0]) + " is not a regular file"},
+		},
+		{
+			name:     "device",
+			args:     WordCountFilesArgs{Paths: []string{os.DevNull}},
+			contains: []string{os.DevNull + ": error: " + os.DevNull + " is not a regular file"},
+		},
+		{
+			name:     "too large",
+			args:     WordCountFilesArgs{Paths: []string{large}},
+			contains: []string{large + ": error: " + large + " is larger than the 32 MiB limit", "Total: 0 words"},
+		},
+		{name: "no paths", args: WordCountFilesArgs{}, err: true, text: "Please provide at least one path"},
+	})
+}
+
+func TestWordCountFilesProgress(t *testing.T) {
+	paths := writeFiles(t, "a", "b c", "d e f")
+
+	var mu sync.Mutex
+	var progress []*mcp.ProgressNotificationParams
+	session := connectServer(t, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(ctx context.Context, req *mcp.ProgressNotificationClientRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			progress = append(progress, req.Params)
+		},
+	})
+
+	params := &mcp.CallToolParams{
+		Name:      "word_count_files",
+		Arguments: map[string]any{"paths": paths},
+		Meta:      mcp.Meta{"progressToken": "count-1"},
+	}
+	if result, _ := callRemote(t, session, params); result.IsError {
+		t.Fatalf("unexpected error: %s", resultText(result))
+	}
+
+	// Notifications are delivered independently of the response.
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		mu.Lock()
+		n := len(progress)
+		mu.Unlock()
+		if n >= len(paths) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(progress) != len(paths) {
+		t.Fatalf("got %d progress notifications, want %d", len(progress), len(paths))
+	}
+	seen := map[float64]bool{}
+	for _, p := range progress {
+		if p.ProgressToken != "count-1" || p.Total != 3 {
+			t.Errorf("notification %+v has the wrong token or total", p)
+		}
+		seen[p.Progress] = true
+	}
+	for i := 1; i <= len(paths); i++ {
+		if !seen[float64(i)] {
+			t.Errorf("no notification for progress %d", i)
+		}
+	}
+}
+
+func TestWordCountFilesCancel(t *testing.T) {
+	paths := writeFiles(t, "some words")
+	many := make([]string, 200000)
+	for i := range many {
+		many[i] = paths[0]
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	result, out, err := handleWordCountFiles(ctx, nil, WordCountFilesArgs{Paths: many})
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if !result.IsError || resultText(result) != "Operation cancelled: context canceled" {
+		t.Fatalf("got %q (error %t), want the cancellation error", resultText(result), result.IsError)
+	}
+	if out != nil {
+		t.Errorf("cancelled result has structured output %v", out)
+	}
+	if elapsed > time.Second {
+		t.Errorf("handler took %v to stop after cancellation", elapsed)
+	}
+}
+
+func TestWordCountFilesCancelledBeforeStart(t *testing.T) {
+	paths := writeFiles(t, "some words")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, _, _ := handleWordCountFiles(ctx, nil, WordCountFilesArgs{Paths: paths})
+	if !result.IsError || resultText(result) != "Operation cancelled: context canceled" {
+		t.Errorf("got %q (error %t), want the cancellation error", resultText(result), result.IsError)
+	}
+}
+
+func TestWordCounterMatchesCountWords(t *testing.T) {
+	texts := []string{
+		"",
+		"one",
+		"  leading and trailing  ",
+		"line one\nline two\n",
+		"tabs\tand\r\nwindows lines",
+		"café naïve 日本語\u3000テキスト",
+		"no\u00a0break\u2003em space",
+		"bad \xff\xfe bytes \xe6\x97",
+	}
+	for _, text := range texts {
+		want := countWords(text)
+		for _, piece := range []int{1, 2, 3, 7, len(text) + 1} {
+			var c wordCounter
+			for start := 0; start < len(text); start += piece {
+				c.write([]byte(text[start:min(start+piece, len(text))]))
+			}
+			if got := c.finish(); got != want {
+				t.Errorf("%q in pieces of %d: got %+v, want %+v", text, piece, got, want)
+			}
+		}
+	}
+}
+
+// cancellingReader returns endless words and cancels its context after a few
+// reads, standing in for a file that is still being read when the client
+// cancels.
+type cancellingReader struct {
+	cancel context.CancelFunc
+	reads  int
+}
+
+func (r *cancellingReader) Read(p []byte) (int, error) {
+	r.reads++
+	if r.reads == 3 {
+		r.cancel()
+	}
+	for i := range p {
+		p[i] = "word "[i%5]
+	}
+	return len(p), nil
+}
+
+func TestCountWordsFromCancelledMidRead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := &cancellingReader{cancel: cancel}
+
+	_, err := countWordsFrom(ctx, r, 1<<40)
+	if err != context.Canceled {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if r.reads != 3 {
+		t.Errorf("read %d chunks, want the read to stop right after cancellation (3)", r.reads)
+	}
+}
+
+func TestCountWordsFromLimit(t *testing.T) {
+	if _, err := countWordsFrom(context.Background(), &cancellingReader{cancel: func() {}}, 100); err != errReadLimit {
+		t.Errorf("err = %v, want errReadLimit", err)
+	}
+	stats, err := countWordsFrom(context.Background(), strings.NewReader("exactly ten"), 11)
+	if err != nil || stats.Words != 2 {
+		t.Errorf("countWordsFrom at the limit = %+v, %v; want 2 words", stats, err)
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type XMLFormatArgs struct {
+	XML    string `json:"xml" jsonschema:"The XML document to validate and format"`
+	Mode   string `json:"mode,omitempty" jsonschema:"Output style: pretty or minify (default pretty)"`
+	Indent *int   `json:"indent,omitempty" jsonschema:"Spaces per indentation level in pretty mode (0-8, default 2)"`
+}
+
+// xmlNode is one node of a parsed document. Names keep the prefixes written
+// in the source so that namespace declarations and prefixed names are
+// reproduced exactly.
+type xmlNode struct {
+	kind     xmlNodeKind
+	name     string
+	attrs    []xml.Attr
+	text     string
+	children []*xmlNode
+}
+
+type xmlNodeKind int
+
+const (
+	xmlElement xmlNodeKind = iota
+	xmlText
+	xmlComment
+	xmlProcInst
+	xmlDirective
+)
+
+func xmlName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+	return n.Space + ":" + n.Local
+}
+
+// xmlSyntaxError describes a decoding error with its line and column.
+func xmlSyntaxError(d *xml.Decoder, err error) error {
+	line, col := d.InputPos()
+	var syntax *xml.SyntaxError
+	if errors.As(err, &syntax) {
+		return fmt.Errorf("line %d, column %d: %s", line, col, syntax.Msg)
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("line %d, column %d: unexpected end of document", line, col)
+	}
+	return fmt.Errorf("line %d, column %d: %v", line, col, err)
+}
+
+// parseXMLDocument checks that s is a well-formed document with a single root
+// element and returns its top-level nodes. The document is decoded twice:
+// Token verifies that start and end tags match, and RawToken keeps the names
+// as written for output.
+func parseXMLDocument(s string) ([]*xmlNode, error) {
+	check := xml.NewDecoder(strings.NewReader(s))
+	roots := 0
+	depth := 0
+	for {
+		tok, err := check.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xmlSyntaxError(check, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				roots++
+				if roots > 1 {
+					return nil, xmlSyntaxError(check, fmt.Errorf("more than one root element (<%s>)", t.Name.Local))
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				return nil, xmlSyntaxError(check, errors.New("text outside the root element"))
+			}
+		}
+	}
+	if roots == 0 {
+		return nil, errors.New("document has no root element")
+	}
+
+	raw := xml.NewDecoder(strings.NewReader(s))
+	top := &xmlNode{}
+	stack := []*xmlNode{top}
+	for {
+		tok, err := raw.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xmlSyntaxError(raw, err)
+		}
+		parent := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			node := &xmlNode{kind: xmlElement, name: xmlName(t.Name), attrs: t.Attr}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			parent.children = append(parent.children, &xmlNode{kind: xmlText, text: string(t)})
+		case xml.Comment:
+			parent.children = append(parent.children, &xmlNode{kind: xmlComment, text: string(t)})
+		case xml.ProcInst:
+			parent.children = append(parent.children, &xmlNode{kind: xmlProcInst, name: t.Target, text: string(t.Inst)})
+		case xml.Directive:
+			parent.children = append(parent.children, &xmlNode{kind: xmlDirective, text: string(t)})
+		}
+	}
+	return top.children, nil
+}
+
+var (
+	xmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	xmlAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;",
+		"\n", "&#xA;", "\r", "&#xD;", "\t", "&#x9;")
+)
+
+// xmlFormatter writes nodes either minified or, when pretty is set, with one
+// child node per line indented by depth.
+type xmlFormatter struct {
+	b      strings.Builder
+	pretty bool
+	indent string
+}
+
+func (f *xmlFormatter) newline(depth int) {
+	if f.pretty {
+		f.b.WriteByte('\n')
+		f.b.WriteString(strings.Repeat(f.indent, depth))
+	}
+}
+
+// significant drops whitespace-only text, which pretty-printing and
+// minifying both replace.
+func significant(nodes []*xmlNode) []*xmlNode {
+	var kept []*xmlNode
+	for _, n := range nodes {
+		if n.kind == xmlText && strings.TrimSpace(n.text) == "" {
+			continue
+		}
+		kept = append(kept, n)
+	}
+	return kept
+}
+
+func (f *xmlFormatter) write(n *xmlNode, depth int) {
+	switch n.kind {
+	case xmlText:
+		text := n.text
+		if f.pretty {
+			text = strings.TrimSpace(text)
+		}
+		f.b.WriteString(xmlTextEscaper.Replace(text))
+	case xmlComment:
+		f.b.WriteString("<!--" + n.text + "-->")
+	case xmlProcInst:
+		f.b.WriteString("<?" + n.name)
+		if n.text != "" {
+			f.b.WriteString(" " + n.text)
+		}
+		f.b.WriteString("?>")
+	case xmlDirective:
+		f.b.WriteString("<!" + n.text + ">")
+	case xmlElement:
+		f.b.WriteString("<" + n.name)
+		for _, a := range n.attrs {
+			fmt.Fprintf(&f.b, ` %s="%s"`, xmlName(a.Name), xmlAttrEscaper.Replace(a.Value))
+		}
+
+		children := significant(n.children)
+		if len(children) == 0 {
+			f.b.WriteString("/>")
+			return
+		}
+		f.b.WriteString(">")
+
+		if len(children) == 1 && children[0].kind == xmlText {
+			// Text-only elements stay on one line with their text as is.
+			f.b.WriteString(xmlTextEscaper.Replace(children[0].text))
+		} else {
+			for _, c := range children {
+				f.newline(depth + 1)
+				f.write(c, depth+1)
+			}
+			f.newline(depth)
+		}
+		f.b.WriteString("</" + n.name + ">")
+	}
+}
+
+func handleXMLFormat(ctx context.Context, req *mcp.CallToolRequest, args XMLFormatArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("xml_format called with %d bytes, mode=%q", len(args.XML), args.Mode))
+
+	mode := args.Mode
+	if mode == "" {
+		mode = "pretty"
+	}
+	if mode != "pretty" && mode != "minify" {
+		return errorResult(fmt.Sprintf("Unsupported mode: %s (use pretty or minify)", args.Mode)), nil, nil
+	}
+
+	indent := 2
+	if args.Indent != nil {
+		indent = *args.Indent
+		if indent < 0 || indent > 8 {
+			return errorResult("Indent must be between 0 and 8"), nil, nil
+		}
+	}
+
+	nodes, err := parseXMLDocument(args.XML)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Invalid XML: %v", err)), nil, nil
+	}
+
+	f := &xmlFormatter{pretty: mode == "pretty", indent: strings.Repeat(" ", indent)}
+	for i, n := range significant(nodes) {
+		if i > 0 {
+			f.newline(0)
+		}
+		f.write(n, 0)
+	}
+
+	return textResult(f.b.String()), nil, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import "testing"
+
+func TestXMLFormat(t *testing.T) {
+	const doc = `<?xml version="1.0"?>
+<library xmlns:dc="http://purl.org/dc/elements/1.1/"><book id="b1" lang="en">
+      <dc:title>Go &amp; XML</dc:title><!-- first -->
+  <tags><tag>a</tag><tag>b</tag></tags><empty/></book></library>`
+
+	runToolCases(t, handleXMLFormat, []toolCase[XMLFormatArgs]{
+		{
+			name: "pretty nested with attributes",
+			args: XMLFormatArgs{XML: doc},
+			text: `<?xml version="1.0"?>
+<library xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <book id="b1" lang="en">
+    <dc:title>Go &amp; XML</dc:title>
+    <!-- first -->
+    <tags>
+      <tag>a</tag>
+      <tag>b</tag>
+    </tags>
+    <empty/>
+  </book>
+</library>`,
+		},
+		{
+			name: "minify",
+			args: XMLFormatArgs{XML: doc, Mode: "minify"},
+			text: `<?xml version="1.0"?><library xmlns:dc="http://purl.org/dc/elements/1.1/"><book id="b1" lang="en"><dc:title>Go &amp; XML</dc:title><!-- first --><tags><tag>a</tag><tag>b</tag></tags><empty/></book></library>`,
+		},
+		{
+			name: "custom indent",
+			args: XMLFormatArgs{XML: "<a><b>1</b></a>", Indent: ptr(4)},
+			text: "<a>\n    <b>1</b>\n</a>",
+		},
+		{
+			name: "attribute escaping",
+			args: XMLFormatArgs{XML: `<a title="x &lt; y &quot;q&quot;" note='it&apos;s'/>`},
+			text: `<a title="x &lt; y &quot;q&quot;" note="it's"/>`,
+		},
+		{name: "malformed", args: XMLFormatArgs{XML: "<a><b></a>"}, err: true, text: "Invalid XML: line 1, column 11: element <b> closed by </a>"},
+		{name: "truncated", args: XMLFormatArgs{XML: "<a><b>1</b>\n<c/></a"}, err: true, text: "Invalid XML: line 2, column 8: unexpected EOF"},
+		{name: "two roots", args: XMLFormatArgs{XML: "<a/><b/>"}, err: true, text: "Invalid XML: line 1, column 9: more than one root element (<b>)"},
+		{name: "no root", args: XMLFormatArgs{XML: "   "}, err: true, text: "Invalid XML: document has no root element"},
+		{name: "unknown mode", args: XMLFormatArgs{XML: "<a/>", Mode: "compact"}, err: true, text: "Unsupported mode: compact (use pretty or minify)"},
+		{name: "indent too large", args: XMLFormatArgs{XML: "<a/>", Indent: ptr(9)}, err: true, text: "Indent must be between 0 and 8"},
+	})
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type ZeroWidthArgs struct {
+	Mode   string `json:"mode" jsonschema:"encode to hide secret in cover, or decode to recover it from text"`
+	Cover  string `json:"cover,omitempty" jsonschema:"Visible cover text to hide the secret in (encode)"`
+	Secret string `json:"secret,omitempty" jsonschema:"The message to hide (encode)"`
+	Text   string `json:"text,omitempty" jsonschema:"Text containing a hidden message (decode)"`
+}
+
+// Each bit of the secret is one invisible character: zero width space for 0
+// and zero width non-joiner for 1.
+const (
+	zeroWidthZero = '\u200b'
+	zeroWidthOne  = '\u200c'
+)
+
+// hideInCover interleaves secret into cover one byte (eight invisible
+// characters) per gap between grapheme clusters, so a cover of n clusters
+// holds n-1 bytes and never has a combining mark or emoji sequence split.
+func hideInCover(cover, secret string) (string, error) {
+	if strings.ContainsAny(cover, string(zeroWidthZero)+string(zeroWidthOne)) {
+		return "", fmt.Errorf("cover already contains zero-width characters")
+	}
+	clusters := graphemeClusters(cover)
+	capacity := max(len(clusters)-1, 0)
+	if len(secret) > capacity {
+		return "", fmt.Errorf("secret is %d bytes but the cover only has room for %d (one byte per gap between characters)", len(secret), capacity)
+	}
+
+	var b strings.Builder
+	for i, cluster := range clusters {
+		b.WriteString(cluster)
+		if i >= len(secret) {
+			continue
+		}
+		for bit := 7; bit >= 0; bit-- {
+			if secret[i]>>bit&1 == 1 {
+				b.WriteRune(zeroWidthOne)
+			} else {
+				b.WriteRune(zeroWidthZero)
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// revealFromText collects the invisible characters in text back into the
+// hidden message and also returns the visible text with them removed.
+func revealFromText(text string) (string, string, error) {
+	var bits []byte
+	var visible strings.Builder
+	for _, r := range text {
+		switch r {
+		case zeroWidthZero:
+			bits = append(bits, 0)
+		case zeroWidthOne:
+			bits = append(bits, 1)
+		default:
+			visible.WriteRune(r)
+		}
+	}
+	if len(bits) == 0 {
+		return "", "", fmt.Errorf("no hidden message found")
+	}
+	if len(bits)%8 != 0 {
+		return "", "", fmt.Errorf("hidden payload is %d bits, not a whole number of bytes; the text may have been truncated or edited", len(bits))
+	}
+
+	secret := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		secret[i/8] = secret[i/8]<<1 | bit
+	}
+	if !utf8.Valid(secret) {
+		return "", "", fmt.Errorf("hidden payload is not valid UTF-8")
+	}
+	return string(secret), visible.String(), nil
+}
+
+func handleZeroWidth(ctx context.Context, req *mcp.CallToolRequest, args ZeroWidthArgs) (*mcp.CallToolResult, any, error) {
+	logMsg("[TOOL]", fmt.Sprintf("zero_width called: mode=%s", args.Mode))
+
+	switch args.Mode {
+	case "encode":
+		if args.Text != "" {
+			return errorResult("'text' cannot be used with encode mode"), nil, nil
+		}
+		if args.Secret == "" {
+			return errorResult("'secret' is required for encode mode"), nil, nil
+		}
+		stego, err := hideInCover(args.Cover, args.Secret)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Cannot hide secret: %v", err)), nil, nil
+		}
+		return textResult(stego), map[string]any{
+			"text":         stego,
+			"secret_bytes": len(args.Secret),
+			"capacity":     max(len(graphemeClusters(args.Cover))-1, 0),
+		}, nil
+
+	case "decode":
+		if args.Cover != "" || args.Secret != "" {
+			return errorResult("'cover' and 'secret' cannot be used with decode mode"), nil, nil
+		}
+		secret, visible, err := revealFromText(args.Text)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Cannot decode: %v", err)), nil, nil
+		}
+		return textResult(secret), map[string]any{
+			"secret": secret,
+			"cover":  visible,
+		}, nil
+
+	default:
+		return errorResult(fmt.Sprintf("Unsupported mode: %s", args.Mode)), nil, nil
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestZeroWidth(t *testing.T) {
+	// "A" is 0x41, 01000001 in bits.
+	const hiddenA = "\u200b\u200c\u200b\u200b\u200b\u200b\u200b\u200c"
+
+	runToolCases(t, handleZeroWidth, []toolCase[ZeroWidthArgs]{
+		{
+			name: "encode",
+			args: ZeroWidthArgs{Mode: "encode", Cover: "hi", Secret: "A"},
+			text: "h" + hiddenA + "i",
+			out:  `{"text":"h` + hiddenA + `i","secret_bytes":1,"capacity":1}`,
+		},
+		{
+			name: "encode keeps clusters whole",
+			args: ZeroWidthArgs{Mode: "encode", Cover: "a\u0301b", Secret: "A"},
+			text: "a\u0301" + hiddenA + "b",
+		},
+		{
+			name: "decode",
+			args: ZeroWidthArgs{Mode: "decode", Text: "h" + hiddenA + "i"},
+			text: "A",
+			out:  `{"secret":"A","cover":"hi"}`,
+		},
+		{name: "secret too long", args: ZeroWidthArgs{Mode: "encode", Cover: "hi", Secret: "AB"}, err: true, text: "Cannot hide secret: secret is 2 bytes but the cover only has room for 1 (one byte per gap between characters)"},
+		{name: "empty cover", args: ZeroWidthArgs{Mode: "encode", Secret: "A"}, err: true, text: "Cannot hide secret: secret is 1 bytes but the cover only has room for 0 (one byte per gap between characters)"},
+		{name: "cover already hides something", args: ZeroWidthArgs{Mode: "encode", Cover: "h" + hiddenA + "ii", Secret: "A"}, err: true, text: "Cannot hide secret: cover already contains zero-width characters"},
+		{name: "missing secret", args: ZeroWidthArgs{Mode: "encode", Cover: "hi"}, err: true, text: "'secret' is required for encode mode"},
+		{name: "text with encode", args: ZeroWidthArgs{Mode: "encode", Cover: "hi", Secret: "A", Text: "x"}, err: true, text: "'text' cannot be used with encode mode"},
+		{name: "nothing hidden", args: ZeroWidthArgs{Mode: "decode", Text: "plain"}, err: true, text: "Cannot decode: no hidden message found"},
+		{name: "truncated payload", args: ZeroWidthArgs{Mode: "decode", Text: "h" + hiddenA[:9] + "i"}, err: true, text: "Cannot decode: hidden payload is 3 bits, not a whole number of bytes; the text may have been truncated or edited"},
+		{name: "invalid utf-8", args: ZeroWidthArgs{Mode: "decode", Text: strings.Repeat("\u200c", 8)}, err: true, text: "Cannot decode: hidden payload is not valid UTF-8"},
+		{name: "cover with decode", args: ZeroWidthArgs{Mode: "decode", Cover: "hi", Text: "x"}, err: true, text: "'cover' and 'secret' cannot be used with decode mode"},
+		{name: "unknown mode", args: ZeroWidthArgs{Mode: "hide"}, err: true, text: "Unsupported mode: hide"},
+	})
+}
+
+func TestZeroWidthRoundTrip(t *testing.T) {
+	tests := []struct{ cover, secret string }{
+		{"The quick brown fox jumps over the lazy dog.", "meet at noon"},
+		{"Flags \U0001F1EF\U0001F1F5 and cafés", "café"},
+		{"ab", "x"},
+	}
+	for _, tt := range tests {
+		result, out := callTool(t, handleZeroWidth, ZeroWidthArgs{Mode: "encode", Cover: tt.cover, Secret: tt.secret})
+		if result.IsError {
+			t.Fatalf("encode %q: %s", tt.secret, resultText(result))
+		}
+		stego := out.(map[string]any)["text"].(string)
+
+		result, out = callTool(t, handleZeroWidth, ZeroWidthArgs{Mode: "decode", Text: stego})
+		if result.IsError {
+			t.Fatalf("decode %q: %s", stego, resultText(result))
+		}
+		checkOutput(t, out, compactJSON(map[string]any{"secret": tt.secret, "cover": tt.cover}))
+	}
+}